@@ -4,9 +4,11 @@ import (
 	database "challenge-travel-api/config"
 	"challenge-travel-api/internal/infrastructure/container"
 	"challenge-travel-api/internal/interface/router"
+	"context"
 	"log"
 	"os"
 	"os/exec"
+	_ "time/tzdata"
 
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -54,9 +56,11 @@ func main() {
 
 	db := database.GetDB()
 
-	authController, travelController := container.Container(db)
+	controllers := container.Container(db)
 
-	r := router.SetupRouter(authController, travelController)
+	controllers.Scheduler.Start(context.Background())
+
+	r := router.SetupRouter(controllers)
 
 	port := os.Getenv("PORT")
 
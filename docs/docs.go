@@ -24,39 +24,33 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/login": {
-            "post": {
-                "description": "Autentica um usuário e retorna um token JWT",
-                "consumes": [
-                    "application/json"
+        "/admin/notification-templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
                 ],
+                "description": "Retorna os modelos de e-mail e os idiomas disponíveis para cada um (somente administradores)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
-                ],
-                "summary": "Autenticar usuário",
-                "parameters": [
-                    {
-                        "description": "Credenciais do usuário",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.LoginRequestDTO"
-                        }
-                    }
+                    "admin"
                 ],
+                "summary": "Listar modelos de notificação",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginResponseDTO"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.NotificationTemplateDTO"
+                            }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -67,9 +61,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
+        "/admin/notification-templates/preview": {
             "post": {
-                "description": "Registra um novo usuário no sistema",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Renderiza o modelo no idioma informado (ou no do administrador) com uma solicitação da organização ou, sem travel_request_id, com uma solicitação de exemplo (somente administradores)",
                 "consumes": [
                     "application/json"
                 ],
@@ -77,23 +76,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Registrar um novo usuário",
+                "summary": "Pré-visualizar modelo de notificação",
                 "parameters": [
                     {
-                        "description": "Dados do usuário",
+                        "description": "Modelo, idioma e solicitação",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.RegisterRequestDTO"
+                            "$ref": "#/definitions/dto.PreviewNotificationDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.NotificationPreviewDTO"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -107,62 +109,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/travels": {
+        "/admin/outbox": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retorna uma lista de solicitações de viagem com filtros opcionais",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retorna as mensagens de notificação da organização com o status informado, as mais recentes primeiro. Sem status, lista a fila de falhas (DEAD) (somente administradores)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "travels"
+                    "admin"
                 ],
-                "summary": "Listar solicitações de viagem",
+                "summary": "Listar mensagens do outbox",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filtrar por status (PENDING, APPROVED, CANCELED)",
+                        "description": "PENDING, DELIVERED ou DEAD (padrão DEAD)",
                         "name": "status",
                         "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data inicial (YYYY-MM-DD)",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Data final (YYYY-MM-DD)",
-                        "name": "end_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Nome do destino",
-                        "name": "destination",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Número da página",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Tamanho da página",
-                        "name": "page_size",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -171,46 +138,9 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/entity.TravelRequest"
+                                "$ref": "#/definitions/entity.OutboxMessage"
                             }
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Cria uma nova solicitação de viagem para o usuário autenticado",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "travels"
-                ],
-                "summary": "Criar uma nova solicitação de viagem",
-                "parameters": [
-                    {
-                        "description": "Dados da solicitação de viagem",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateTravelRequestDTO"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/entity.TravelRequest"
-                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -224,38 +154,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/travels/{id}": {
-            "get": {
+        "/admin/outbox/{id}/retry": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retorna os detalhes de uma solicitação de viagem específica",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Devolve à fila uma mensagem DEAD, com as tentativas zeradas (somente administradores)",
                 "tags": [
-                    "travels"
+                    "admin"
                 ],
-                "summary": "Obter detalhes de uma solicitação de viagem",
+                "summary": "Reenviar mensagem do outbox",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da solicitação de viagem",
+                        "description": "ID da mensagem",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/entity.TravelRequest"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
@@ -268,47 +198,144 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/travels": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Atualiza os dados de uma solicitação de viagem existente",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Listagem administrativa paginada, das solicitações mais recentes para as mais antigas, com o total de registros que atendem aos filtros. Datas aceitam YYYY-MM-DD ou RFC3339; um limite final só com a data inclui o dia inteiro",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "travels"
+                    "admin"
                 ],
-                "summary": "Atualizar uma solicitação de viagem",
+                "summary": "Listar solicitações de todos os usuários",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da solicitação de viagem",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Solicitante",
+                        "name": "user_id",
+                        "in": "query"
                     },
                     {
-                        "description": "Dados atualizados da solicitação",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdateTravelRequestDTO"
-                        }
+                        "type": "string",
+                        "description": "Aprovador",
+                        "name": "approved_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Um ou mais status (SOLICITED, APPROVED, CANCELED), repetidos ou separados por vírgula",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departamento",
+                        "name": "department",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Centro de custo responsável ou com parcela no rateio",
+                        "name": "cost_center_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do destino",
+                        "name": "destination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ida a partir de",
+                        "name": "departure_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ida até",
+                        "name": "departure_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registro a partir de",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registro até",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aprovação a partir de",
+                        "name": "approved_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aprovação até",
+                        "name": "approved_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca textual em viajantes, destino, motivo e comentários, sem distinção de acentos; aceita \\",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamanho da página (máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (departure_date, created_at, status, destination ou relevance, quando há q), opcionalmente com :asc ou :desc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valor de next_cursor de uma resposta anterior; ativa a paginação por cursor (somente com sort departure_date ou created_at)",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.TravelRequest"
+                            "$ref": "#/definitions/entity.TravelRequestPage"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last"
+                            }
                         }
                     },
                     "400": {
@@ -323,45 +350,227 @@ const docTemplate = `{
                 }
             }
         },
-        "/travels/{id}/status": {
-            "patch": {
+        "/admin/travels/export": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Atualiza o status de uma solicitação de viagem (aprovado/cancelado)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gera um CSV com as solicitações que atendem aos filtros da listagem administrativa, uma linha por parcela do rateio de centro de custo (somente administradores)",
                 "produces": [
-                    "application/json"
+                    "text/csv"
                 ],
                 "tags": [
-                    "travels"
+                    "admin"
                 ],
-                "summary": "Atualizar status da solicitação de viagem",
+                "summary": "Exportar solicitações para o financeiro",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID da solicitação de viagem",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Solicitante",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aprovador",
+                        "name": "approved_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Um ou mais status (SOLICITED, APPROVED, CANCELED), repetidos ou separados por vírgula",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Departamento",
+                        "name": "department",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Centro de custo responsável ou com parcela no rateio",
+                        "name": "cost_center_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do destino",
+                        "name": "destination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ida a partir de",
+                        "name": "departure_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ida até",
+                        "name": "departure_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registro a partir de",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Registro até",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aprovação a partir de",
+                        "name": "approved_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Aprovação até",
+                        "name": "approved_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca textual em viajantes, destino, motivo e comentários",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação, opcionalmente com :asc ou :desc",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Arquivo CSV",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/reporting-lines/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Aplica um CSV com as colunas email, department_code e manager_email, na ordem do arquivo. Cada linha é aplicada isoladamente e o relatório indica o resultado de cada uma; valores vazios removem o departamento ou o gestor (somente administradores)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Importar hierarquia via CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV da hierarquia",
+                        "name": "file",
+                        "in": "formData",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReportingLineImportResponseDTO"
+                        }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{id}/reporting-line": {
+            "put": {
+                "security": [
                     {
-                        "description": "Novo status da solicitação",
+                        "Bearer": []
+                    }
+                ],
+                "description": "Substitui o departamento e o gestor do usuário; campos omitidos são removidos e gestores que criariam um ciclo na hierarquia são recusados (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Definir departamento e gestor do usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Departamento e gestor",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateStatusTravelRequestDTO"
+                            "$ref": "#/definitions/dto.AssignReportingLineDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserOrgDTO"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -374,151 +583,5111 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CreateTravelRequestDTO": {
-            "type": "object",
-            "required": [
-                "departure_date",
-                "destination_name",
-                "traveler_name"
-            ],
-            "properties": {
-                "departure_date": {
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Autentica um usuário e retorna um token JWT",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Autenticar usuário",
+                "parameters": [
+                    {
+                        "description": "Credenciais do usuário",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginResponseDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Registra um novo usuário no sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Registrar um novo usuário",
+                "parameters": [
+                    {
+                        "description": "Dados do usuário",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os orçamentos de viagem por departamento, opcionalmente filtrados por ano",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Listar orçamentos por departamento",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Ano do orçamento",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.DepartmentBudget"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cria ou atualiza o orçamento anual de viagens de um departamento (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Definir orçamento de um departamento",
+                "parameters": [
+                    {
+                        "description": "Orçamento do departamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveDepartmentBudgetDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.DepartmentBudget"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cost-centers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os centros de custo e projetos aceitos nas solicitações, ordenados pelo código",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Listar centros de custo",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Incluir centros inativos",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.CostCenter"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cadastra um centro de custo ou projeto; o código é único e gravado em maiúsculas (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Cadastrar centro de custo",
+                "parameters": [
+                    {
+                        "description": "Centro de custo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveCostCenterDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CostCenter"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cost-centers/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Altera código, nome, tipo ou situação de um centro de custo; centros inativos não podem ser usados em novas solicitações (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Atualizar centro de custo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do centro de custo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Centro de custo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveCostCenterDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.CostCenter"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/departments": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os departamentos ordenados pelo código",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Listar departamentos",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Incluir departamentos inativos",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Department"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cadastra um departamento e, opcionalmente, seu responsável; o código é único e gravado em maiúsculas (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Cadastrar departamento",
+                "parameters": [
+                    {
+                        "description": "Departamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveDepartmentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Department"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/departments/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Altera código, nome, responsável ou situação de um departamento (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Atualizar departamento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do departamento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Departamento",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveDepartmentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Department"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Busca no catálogo por cidade, país, apelido ou código IATA, ignorando acentos",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destinations"
+                ],
+                "summary": "Autocompletar destinos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Termo de busca",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Quantidade máxima de resultados",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Destination"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna uma entrada do catálogo com os aeroportos e o fuso horário",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "destinations"
+                ],
+                "summary": "Obter destino do catálogo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do destino",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Destination"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna as taxas de câmbio usadas para converter custos para a moeda base",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Listar taxas de câmbio",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.ExchangeRate"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/exchange-rates/{currency}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Define quantas unidades da moeda base equivalem a uma unidade da moeda informada (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "costs"
+                ],
+                "summary": "Cadastrar ou atualizar taxa de câmbio",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Código ISO 4217 da moeda",
+                        "name": "currency",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Taxa de câmbio",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveExchangeRateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ExchangeRate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/locale": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Define o idioma (pt-BR, en-US ou es) em que o usuário autenticado recebe as notificações",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Alterar idioma das notificações",
+                "parameters": [
+                    {
+                        "description": "Idioma",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateLocaleDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notification-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna, para cada tipo de notificação, se o usuário autenticado a recebe por e-mail e na caixa de entrada",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Preferências de notificação",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NotificationPreference"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Define os canais (e-mail e caixa de entrada) de cada tipo informado (STATUS_CHANGE, COMMENT); os demais tipos não mudam",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Alterar preferências de notificação",
+                "parameters": [
+                    {
+                        "description": "Preferências",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateNotificationPreferencesDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.NotificationPreference"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lista as notificações do usuário autenticado, das mais recentes para as mais antigas, com o total de não lidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Caixa de entrada",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Somente não lidas",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamanho da página (máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NotificationPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Marca como lidas todas as notificações do usuário autenticado",
+                "tags": [
+                    "me"
+                ],
+                "summary": "Marcar todas as notificações como lidas",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna quantas notificações do usuário autenticado ainda não foram lidas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Notificações não lidas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UnreadNotificationsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Marca como lida uma notificação do usuário autenticado",
+                "tags": [
+                    "me"
+                ],
+                "summary": "Marcar notificação como lida",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da notificação",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/organization": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna a organização do usuário autenticado e suas configurações",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organization"
+                ],
+                "summary": "Consultar organização",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Organization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Altera nome, moeda base e fuso horário padrão da organização. A moeda base vale para as próximas estimativas; as cotações cadastradas precisam ser atualizadas para a nova moeda (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "organization"
+                ],
+                "summary": "Atualizar configurações da organização",
+                "parameters": [
+                    {
+                        "description": "Configurações",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateOrganizationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Organization"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/policy-rules": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna as regras ativas avaliadas na criação e alteração das solicitações",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "policies"
+                ],
+                "summary": "Listar regras da política de viagens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.TravelPolicyRule"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-groups": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cria uma solicitação por viajante com o mesmo roteiro, aprovadas em conjunto (UNIT) ou individualmente (PER_TRAVELER)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-groups"
+                ],
+                "summary": "Criar solicitação de viagem em grupo",
+                "parameters": [
+                    {
+                        "description": "Dados do grupo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTravelGroupDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-groups/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna o grupo com o status de cada viajante",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-groups"
+                ],
+                "summary": "Obter grupo de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do grupo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelGroup"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-groups/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Aprova ou cancela todas as solicitações pendentes do grupo (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-groups"
+                ],
+                "summary": "Atualizar status do grupo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do grupo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTravelGroupStatusDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TravelGroupStatusResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-series": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lista as séries do usuário autenticado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Listar séries recorrentes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.TravelSeries"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Gera solicitações a partir de um modelo seguindo uma RRULE (FREQ=DAILY/WEEKLY/MONTHLY, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY). As ocorrências são criadas com horizon_days de antecedência (padrão 30)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Criar série recorrente",
+                "parameters": [
+                    {
+                        "description": "Modelo, regra e início da série",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTravelSeriesDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-series/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna a série com as ocorrências já geradas",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Obter série recorrente",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da série",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelSeries"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Aplica a nova regra ou modelo às ocorrências futuras pendentes, que são canceladas e geradas de novo. Ocorrências aprovadas ou editadas individualmente (PUT /travels/{id}) são mantidas",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Alterar a série inteira",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da série",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a alterar",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTravelSeriesDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Encerra a série e cancela as ocorrências futuras ainda pendentes",
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Cancelar a série inteira",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da série",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-series/{id}/occurrences/{travelId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cancela uma única ocorrência pendente da série, que não é gerada de novo",
+                "tags": [
+                    "travel-series"
+                ],
+                "summary": "Cancelar uma ocorrência",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da série",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "travelId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-templates": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lista os modelos do usuário autenticado",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Listar modelos de viagem",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.TravelTemplate"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Salva um roteiro recorrente (destino, viajantes, duração e custos) para gerar novas solicitações",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Criar modelo de viagem",
+                "parameters": [
+                    {
+                        "description": "Dados do modelo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveTravelTemplateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Obter modelo de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do modelo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelTemplate"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Substitui todos os dados do modelo, inclusive viajantes e itens de custo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Atualizar modelo de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do modelo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do modelo",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.SaveTravelTemplateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelTemplate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Excluir modelo de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do modelo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travel-templates/{id}/instantiate": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cria uma nova solicitação com o roteiro do modelo; sem data de volta, usa a duração do modelo",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travel-templates"
+                ],
+                "summary": "Gerar solicitação a partir do modelo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do modelo",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Datas da viagem",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.InstantiateTravelTemplateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travelers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os viajantes que o usuário pode gerenciar (todos para administradores)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Listar viajantes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.Traveler"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cadastra o perfil de uma pessoa que viaja, com ou sem usuário no sistema",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Cadastrar viajante",
+                "parameters": [
+                    {
+                        "description": "Dados do viajante",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTravelerDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Traveler"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travelers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna o perfil de um viajante que o usuário pode gerenciar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Obter viajante",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do viajante",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Traveler"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Atualiza o perfil de um viajante",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Atualizar viajante",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do viajante",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados do viajante",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTravelerDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Traveler"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travelers/{id}/delegates": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Autoriza um usuário a solicitar viagens em nome do viajante",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Adicionar delegado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do viajante",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Usuário delegado",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TravelerDelegateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travelers/{id}/delegates/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revoga a autorização de um usuário para solicitar viagens em nome do viajante",
+                "tags": [
+                    "travelers"
+                ],
+                "summary": "Remover delegado",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do viajante",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do usuário delegado",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna uma lista de solicitações de viagem com filtros opcionais",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Listar solicitações de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filtrar por status (PENDING, APPROVED, CANCELED)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data inicial (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data final (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Nome do destino",
+                        "name": "destination",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca textual em viajantes, destino, motivo e comentários, sem distinção de acentos; aceita \\",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Tamanho da página (máximo 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Campo de ordenação (departure_date, created_at, status, destination ou relevance, quando há q), opcionalmente com :asc ou :desc",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Valor de next_cursor de uma resposta anterior; ativa a paginação por cursor (somente com sort departure_date ou created_at)",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "expanded (padrão) lista cada viajante; collapsed lista cada grupo uma única vez",
+                        "name": "group_view",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequestPage"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Links para as páginas first, prev, next e last"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cria uma nova solicitação de viagem para o usuário autenticado",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Criar uma nova solicitação de viagem",
+                "parameters": [
+                    {
+                        "description": "Dados da solicitação de viagem",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTravelRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/inbox": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lista as solicitações pendentes de outros usuários que aguardam a decisão do administrador, da ida mais próxima para a mais distante, com a contagem por status, os dias até a ida e as horas desde o registro",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Caixa de entrada do aprovador",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Número da página",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Tamanho da página",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ApproverInbox"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/status": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Aplica o mesmo status a várias solicitações (até 100). Cada item é processado de forma independente e o relatório traz o resultado de cada um (SUCCESS, NOT_FOUND, UNAUTHORIZED, INVALID_TRANSITION ou FAILED). Os envolvidos recebem uma única notificação com todas as decisões",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Aprovar ou cancelar solicitações em lote",
+                "parameters": [
+                    {
+                        "description": "Solicitações e novo status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkUpdateStatusDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BulkUpdateStatusResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os detalhes de uma solicitação de viagem específica",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Obter detalhes de uma solicitação de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Atualiza os dados de uma solicitação de viagem existente",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Atualizar uma solicitação de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados atualizados da solicitação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateTravelRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/attachments": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna os anexos da solicitação de viagem",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Listar anexos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.TravelRequestAttachment"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Anexa um arquivo (PDF, imagem, texto ou documento do Office) à solicitação. O campo opcional checksum (SHA-256 em hexadecimal) é conferido com o arquivo recebido",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Enviar anexo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "SHA-256 do arquivo",
+                        "name": "checksum",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequestAttachment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/attachments/{attachmentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna o conteúdo do anexo após conferir o checksum gravado no envio",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Baixar anexo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove um anexo (quem enviou ou administradores)",
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Excluir anexo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/clone": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cria um rascunho com o roteiro, viajantes e custos de outra solicitação, deslocando as datas; o rascunho vai para aprovação em POST /travels/{id}/submit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Clonar solicitação de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nova data de ida ou deslocamento em dias",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CloneTravelRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/comments": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna a conversa da solicitação em ordem cronológica; comentários internos aparecem apenas para aprovadores",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Listar comentários",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.TravelRequestComment"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Publica um comentário na solicitação e notifica a outra parte",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Adicionar comentário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comentário",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateCommentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequestComment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/comments/{commentId}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Altera o texto de um comentário (somente o autor)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Editar comentário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do comentário",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo texto",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateCommentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequestComment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove um comentário (autor ou administradores)",
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Excluir comentário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do comentário",
+                        "name": "commentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Atualiza o status de uma solicitação de viagem (aprovado/cancelado)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Atualizar status da solicitação de viagem",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da solicitação de viagem",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Novo status da solicitação",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateStatusTravelRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/travels/{id}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Envia um rascunho do usuário, como a cópia de outra solicitação, para aprovação. A sobreposição e as políticas são conferidas no envio.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "travels"
+                ],
+                "summary": "Enviar um rascunho para aprovação",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do rascunho",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Opções do envio",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubmitTravelRequestDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TravelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/approvers": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna o gestor (pulando gestores inativos) e o responsável pelo departamento a quem as aprovações do usuário são encaminhadas; usuários comuns só consultam a própria hierarquia",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "org"
+                ],
+                "summary": "Consultar aprovadores do usuário",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do usuário",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ApproversDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna as assinaturas de webhook da organização (somente administradores)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Listar webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.WebhookSubscription"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Cadastra uma URL que recebe os eventos escolhidos (travel.created, travel.approved, travel.canceled) assinados com HMAC-SHA256. Sem secret, um segredo é gerado; ele só é exibido nesta resposta (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Cadastrar webhook",
+                "parameters": [
+                    {
+                        "description": "Assinatura",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWebhookSubscriptionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookSubscriptionCreatedDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Envia de novo a entrega, com o mesmo ID e corpo; o resultado aparece no histórico de entregas (somente administradores)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Reenviar entrega do webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da entrega",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Altera a URL, os eventos ou a situação da assinatura; o segredo é mantido (somente administradores)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Atualizar webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da assinatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Assinatura",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWebhookSubscriptionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Remove a assinatura e o histórico de entregas dela (somente administradores)",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Remover webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da assinatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retorna as 100 entregas mais recentes da assinatura, com o resultado da última tentativa (somente administradores)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Listar entregas do webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da assinatura",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/entity.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.ApproversDTO": {
+            "type": "object",
+            "properties": {
+                "department_head": {
+                    "$ref": "#/definitions/dto.UserOrgDTO"
+                },
+                "manager": {
+                    "$ref": "#/definitions/dto.UserOrgDTO"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AssignReportingLineDTO": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "manager_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BulkStatusItemDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/dto.BulkStatusResult"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.BulkStatusResult": {
+            "type": "string",
+            "enum": [
+                "SUCCESS",
+                "NOT_FOUND",
+                "UNAUTHORIZED",
+                "INVALID_TRANSITION",
+                "CONFLICT",
+                "FAILED"
+            ],
+            "x-enum-varnames": [
+                "BulkStatusResultSuccess",
+                "BulkStatusResultNotFound",
+                "BulkStatusResultUnauthorized",
+                "BulkStatusResultInvalidTransition",
+                "BulkStatusResultConflict",
+                "BulkStatusResultFailed"
+            ]
+        },
+        "dto.BulkUpdateStatusDTO": {
+            "type": "object",
+            "required": [
+                "status",
+                "travel_request_ids"
+            ],
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                },
+                "travel_request_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.BulkUpdateStatusResponseDTO": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkStatusItemDTO"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CloneTravelRequestDTO": {
+            "type": "object",
+            "properties": {
+                "departure_date": {
+                    "type": "string"
+                },
+                "shift_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CostAllocationDTO": {
+            "type": "object",
+            "required": [
+                "cost_center_id",
+                "percentage"
+            ],
+            "properties": {
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number",
+                    "maximum": 100
+                }
+            }
+        },
+        "dto.CostItemDTO": {
+            "type": "object",
+            "required": [
+                "category",
+                "currency"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "category": {
+                    "$ref": "#/definitions/enums.CostCategory"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateCommentDTO": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "internal": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.CreateTravelGroupDTO": {
+            "type": "object",
+            "required": [
+                "departure_date",
+                "name",
+                "traveler_ids"
+            ],
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostAllocationDTO"
+                    }
+                },
+                "approval_mode": {
+                    "$ref": "#/definitions/enums.GroupApprovalMode"
+                },
+                "business_purpose": {
+                    "type": "string"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostItemDTO"
+                    }
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "override_overlap": {
+                    "type": "boolean"
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "traveler_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.CreateTravelRequestDTO": {
+            "type": "object",
+            "required": [
+                "departure_date"
+            ],
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostAllocationDTO"
+                    }
+                },
+                "business_purpose": {
+                    "type": "string"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostItemDTO"
+                    }
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "override_overlap": {
+                    "type": "boolean"
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "traveler_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "traveler_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateTravelSeriesDTO": {
+            "type": "object",
+            "required": [
+                "rrule",
+                "starts_at",
+                "template_id"
+            ],
+            "properties": {
+                "horizon_days": {
+                    "type": "integer"
+                },
+                "rrule": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "template_id": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateTravelerDTO": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string"
+                },
+                "passport_country": {
+                    "type": "string"
+                },
+                "passport_expires_at": {
+                    "type": "string"
+                },
+                "passport_number": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateWebhookSubscriptionDTO": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/enums.WebhookEvent"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.InstantiateTravelTemplateDTO": {
+            "type": "object",
+            "required": [
+                "departure_date"
+            ],
+            "properties": {
+                "departure_date": {
+                    "type": "string"
+                },
+                "override_overlap": {
+                    "type": "boolean"
+                },
+                "return_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginRequestDTO": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "dto.LoginResponseDTO": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationPreferenceDTO": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "in_app": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/enums.NotificationType"
+                }
+            }
+        },
+        "dto.NotificationPreviewDTO": {
+            "type": "object",
+            "properties": {
+                "html_body": {
+                    "type": "string"
+                },
+                "locale": {
+                    "$ref": "#/definitions/enums.Locale"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "text_body": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.NotificationTemplateDTO": {
+            "type": "object",
+            "properties": {
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/enums.Locale"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PreviewNotificationDTO": {
+            "type": "object",
+            "required": [
+                "template"
+            ],
+            "properties": {
+                "locale": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RegisterRequestDTO": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "dto.ReportingLineImportItemDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ReportingLineImportResponseDTO": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReportingLineImportItemDTO"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SaveCostCenterDTO": {
+            "type": "object",
+            "required": [
+                "code",
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "kind": {
+                    "$ref": "#/definitions/enums.CostCenterKind"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SaveDepartmentBudgetDTO": {
+            "type": "object",
+            "required": [
+                "department",
+                "year"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "department": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.SaveDepartmentDTO": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "head_user_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SaveExchangeRateDTO": {
+            "type": "object",
+            "required": [
+                "rate"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "dto.SaveTravelTemplateDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "business_purpose": {
+                    "type": "string"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostItemDTO"
+                    }
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "duration_days": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "traveler_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "traveler_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.SubmitTravelRequestDTO": {
+            "type": "object",
+            "properties": {
+                "override_overlap": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dto.TravelGroupStatusResponseDTO": {
+            "type": "object",
+            "properties": {
+                "approval_mode": {
+                    "$ref": "#/definitions/enums.GroupApprovalMode"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelRequest"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BulkStatusItemDTO"
+                    }
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "status_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TravelerDelegateDTO": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UnreadNotificationsDTO": {
+            "type": "object",
+            "properties": {
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.UpdateCommentDTO": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateLocaleDTO": {
+            "type": "object",
+            "required": [
+                "locale"
+            ],
+            "properties": {
+                "locale": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateNotificationPreferencesDTO": {
+            "type": "object",
+            "required": [
+                "preferences"
+            ],
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.NotificationPreferenceDTO"
+                    }
+                }
+            }
+        },
+        "dto.UpdateOrganizationDTO": {
+            "type": "object",
+            "required": [
+                "base_currency",
+                "default_timezone",
+                "name"
+            ],
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "default_locale": {
+                    "type": "string"
+                },
+                "default_timezone": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateStatusTravelRequestDTO": {
+            "type": "object",
+            "required": [
+                "status",
+                "travel_request_id"
+            ],
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateTravelGroupStatusDTO": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                }
+            }
+        },
+        "dto.UpdateTravelRequestDTO": {
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostAllocationDTO"
+                    }
+                },
+                "business_purpose": {
+                    "type": "string"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CostItemDTO"
+                    }
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "override_overlap": {
+                    "type": "boolean"
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "traveler_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "traveler_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateTravelSeriesDTO": {
+            "type": "object",
+            "properties": {
+                "horizon_days": {
+                    "type": "integer"
+                },
+                "rrule": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "template_id": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateTravelerDTO": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nationality": {
+                    "type": "string"
+                },
+                "passport_country": {
+                    "type": "string"
+                },
+                "passport_expires_at": {
+                    "type": "string"
+                },
+                "passport_number": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UpdateWebhookSubscriptionDTO": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/enums.WebhookEvent"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.UserOrgDTO": {
+            "type": "object",
+            "properties": {
+                "department_id": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/enums.UserType"
+                }
+            }
+        },
+        "dto.WebhookSubscriptionCreatedDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ApproverInbox": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ApproverInboxItem"
+                    }
+                },
+                "status_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entity.ApproverInboxItem": {
+            "type": "object",
+            "properties": {
+                "age_hours": {
+                    "type": "integer"
+                },
+                "days_until_departure": {
+                    "type": "integer"
+                },
+                "travel_request": {
+                    "$ref": "#/definitions/entity.TravelRequest"
+                }
+            }
+        },
+        "entity.CostCenter": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/enums.CostCenterKind"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Department": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "head_user_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DepartmentBudget": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "department": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Destination": {
+            "type": "object",
+            "properties": {
+                "airports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DestinationAirport"
+                    }
+                },
+                "aliases": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "country_code": {
+                    "type": "string"
+                },
+                "country_name": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "region": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DestinationAirport": {
+            "type": "object",
+            "properties": {
+                "destination_id": {
+                    "type": "string"
+                },
+                "iata_code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ExchangeRate": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Notification": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/enums.NotificationType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.NotificationPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Notification"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.NotificationPreference": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "in_app": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/enums.NotificationType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Organization": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "base_currency": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "default_locale": {
+                    "$ref": "#/definitions/enums.Locale"
+                },
+                "default_timezone": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.OutboxMessage": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "available_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.OutboxStatus"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelCostAllocation": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "cost_center": {
+                    "$ref": "#/definitions/entity.CostCenter"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelCostItem": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "base_amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/enums.CostCategory"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelGroup": {
+            "type": "object",
+            "properties": {
+                "approval_mode": {
+                    "$ref": "#/definitions/enums.GroupApprovalMode"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelRequest"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "status_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelPolicyRule": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "field": {
+                    "$ref": "#/definitions/enums.PolicyField"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "operator": {
+                    "$ref": "#/definitions/enums.PolicyOperator"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/enums.PolicySeverity"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelPolicyViolation": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "rule_name": {
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/enums.PolicySeverity"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelRequest": {
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelCostAllocation"
+                    }
+                },
+                "approved_at": {
+                    "type": "string"
+                },
+                "approved_by": {
+                    "type": "string"
+                },
+                "business_purpose": {
+                    "type": "string"
+                },
+                "canceled_at": {
+                    "type": "string"
+                },
+                "canceled_by": {
+                    "type": "string"
+                },
+                "cost_center": {
+                    "$ref": "#/definitions/entity.CostCenter"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelCostItem"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_date": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination": {
+                    "$ref": "#/definitions/entity.Destination"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "estimated_total": {
+                    "type": "number"
+                },
+                "group": {
+                    "$ref": "#/definitions/entity.TravelGroup"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurrence_date": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "policy_violations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelPolicyViolation"
+                    }
+                },
+                "return_date": {
+                    "type": "string"
+                },
+                "return_timezone": {
+                    "type": "string"
+                },
+                "search_highlight": {
+                    "type": "string"
+                },
+                "search_rank": {
+                    "description": "Preenchidos apenas nas listagens com busca textual (parâmetro q).",
+                    "type": "number"
+                },
+                "series_exception": {
+                    "type": "boolean"
+                },
+                "series_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                },
+                "traveler_name": {
+                    "type": "string"
+                },
+                "travelers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Traveler"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/entity.User"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelRequestAttachment": {
+            "type": "object",
+            "properties": {
+                "checksum": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                },
+                "uploaded_by": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelRequestComment": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "author_name": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "internal": {
+                    "type": "boolean"
+                },
+                "travel_request_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelRequestPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelRequest"
+                    }
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.TravelSeries": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "generated_until": {
+                    "type": "string"
+                },
+                "horizon_days": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelRequest"
+                    }
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "rrule": {
                     "type": "string"
                 },
-                "destination_name": {
+                "starts_at": {
                     "type": "string"
                 },
-                "return_date": {
+                "status": {
+                    "$ref": "#/definitions/enums.TravelSeriesStatus"
+                },
+                "template": {
+                    "$ref": "#/definitions/entity.TravelTemplate"
+                },
+                "template_id": {
                     "type": "string"
                 },
-                "traveler_name": {
+                "timezone": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.LoginRequestDTO": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
+                },
+                "updated_at": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
-                }
-            }
-        },
-        "dto.LoginResponseDTO": {
-            "type": "object",
-            "properties": {
-                "access_token": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.RegisterRequestDTO": {
+        "entity.TravelTemplate": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password",
-                "role"
-            ],
             "properties": {
-                "email": {
+                "business_purpose": {
+                    "type": "string"
+                },
+                "cost_center_id": {
+                    "type": "string"
+                },
+                "cost_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelTemplateCostItem"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "department": {
+                    "type": "string"
+                },
+                "departure_timezone": {
+                    "type": "string"
+                },
+                "destination_id": {
+                    "type": "string"
+                },
+                "destination_name": {
+                    "type": "string"
+                },
+                "duration_days": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "organization_id": {
+                    "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/enums.UserType"
-                }
-            }
-        },
-        "dto.UpdateStatusTravelRequestDTO": {
-            "type": "object",
-            "required": [
-                "travel_request_id"
-            ],
-            "properties": {
-                "status": {
-                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                "traveler_name": {
+                    "type": "string"
                 },
-                "travel_request_id": {
+                "travelers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Traveler"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.UpdateTravelRequestDTO": {
+        "entity.TravelTemplateCostItem": {
             "type": "object",
             "properties": {
-                "departure_date": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/enums.CostCategory"
+                },
+                "currency": {
                     "type": "string"
                 },
-                "destination_name": {
+                "description": {
                     "type": "string"
                 },
-                "return_date": {
+                "id": {
                     "type": "string"
                 },
-                "traveler_name": {
+                "template_id": {
                     "type": "string"
                 }
             }
         },
-        "entity.TravelRequest": {
+        "entity.Traveler": {
             "type": "object",
             "properties": {
-                "approved_at": {
+                "created_at": {
                     "type": "string"
                 },
-                "approved_by": {
+                "created_by": {
                     "type": "string"
                 },
-                "canceled_at": {
+                "delegates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TravelerDelegate"
+                    }
+                },
+                "email": {
                     "type": "string"
                 },
-                "canceled_by": {
+                "employee_id": {
                     "type": "string"
                 },
-                "created_at": {
+                "id": {
                     "type": "string"
                 },
-                "departure_date": {
+                "name": {
                     "type": "string"
                 },
-                "destination_name": {
+                "nationality": {
                     "type": "string"
                 },
-                "id": {
+                "organization_id": {
                     "type": "string"
                 },
-                "return_date": {
+                "passport_country": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/enums.TravelRequestStatus"
+                "passport_expires_at": {
+                    "type": "string"
                 },
-                "traveler_name": {
+                "passport_number": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/entity.User"
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TravelerDelegate": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "traveler_id": {
+                    "type": "string"
                 },
                 "user_id": {
                     "type": "string"
@@ -534,6 +5703,9 @@ const docTemplate = `{
                 "deleted_at": {
                     "type": "string"
                 },
+                "department_id": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -543,9 +5715,18 @@ const docTemplate = `{
                 "is_active": {
                     "type": "boolean"
                 },
+                "locale": {
+                    "$ref": "#/definitions/enums.Locale"
+                },
+                "manager_id": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "organization_id": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 },
@@ -557,19 +5738,237 @@ const docTemplate = `{
                 }
             }
         },
+        "entity.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/enums.WebhookEvent"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_attempt_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/enums.WebhookDeliveryStatus"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "entity.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "organization_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "enums.CostCategory": {
+            "type": "string",
+            "enum": [
+                "AIRFARE",
+                "LODGING",
+                "PER_DIEM",
+                "GROUND_TRANSPORT"
+            ],
+            "x-enum-varnames": [
+                "CostCategoryAirfare",
+                "CostCategoryLodging",
+                "CostCategoryPerDiem",
+                "CostCategoryGroundTransport"
+            ]
+        },
+        "enums.CostCenterKind": {
+            "type": "string",
+            "enum": [
+                "COST_CENTER",
+                "PROJECT"
+            ],
+            "x-enum-varnames": [
+                "CostCenterKindCostCenter",
+                "CostCenterKindProject"
+            ]
+        },
+        "enums.GroupApprovalMode": {
+            "type": "string",
+            "enum": [
+                "UNIT",
+                "PER_TRAVELER"
+            ],
+            "x-enum-varnames": [
+                "GroupApprovalModeUnit",
+                "GroupApprovalModePerTraveler"
+            ]
+        },
+        "enums.Locale": {
+            "type": "string",
+            "enum": [
+                "pt-BR",
+                "en-US",
+                "es",
+                "pt-BR"
+            ],
+            "x-enum-varnames": [
+                "LocalePtBR",
+                "LocaleEnUS",
+                "LocaleEs",
+                "DefaultLocale"
+            ]
+        },
+        "enums.NotificationType": {
+            "type": "string",
+            "enum": [
+                "STATUS_CHANGE",
+                "COMMENT"
+            ],
+            "x-enum-varnames": [
+                "NotificationTypeStatusChange",
+                "NotificationTypeComment"
+            ]
+        },
+        "enums.OutboxStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "DELIVERED",
+                "DEAD"
+            ],
+            "x-enum-varnames": [
+                "OutboxStatusPending",
+                "OutboxStatusDelivered",
+                "OutboxStatusDead"
+            ]
+        },
+        "enums.PolicyField": {
+            "type": "string",
+            "enum": [
+                "advance_days",
+                "nights",
+                "destination_name",
+                "department",
+                "estimated_total",
+                "destination_country"
+            ],
+            "x-enum-varnames": [
+                "PolicyFieldAdvanceDays",
+                "PolicyFieldNights",
+                "PolicyFieldDestinationName",
+                "PolicyFieldDepartment",
+                "PolicyFieldEstimatedTotal",
+                "PolicyFieldDestinationCountry"
+            ]
+        },
+        "enums.PolicyOperator": {
+            "type": "string",
+            "enum": [
+                "LT",
+                "LTE",
+                "GT",
+                "GTE",
+                "EQ",
+                "NEQ",
+                "IN",
+                "NOT_IN"
+            ],
+            "x-enum-varnames": [
+                "PolicyOperatorLessThan",
+                "PolicyOperatorLessThanOrEqual",
+                "PolicyOperatorGreaterThan",
+                "PolicyOperatorGreaterThanOrEqual",
+                "PolicyOperatorEqual",
+                "PolicyOperatorNotEqual",
+                "PolicyOperatorIn",
+                "PolicyOperatorNotIn"
+            ]
+        },
+        "enums.PolicySeverity": {
+            "type": "string",
+            "enum": [
+                "BLOCK",
+                "WARN"
+            ],
+            "x-enum-varnames": [
+                "PolicySeverityBlock",
+                "PolicySeverityWarn"
+            ]
+        },
         "enums.TravelRequestStatus": {
             "type": "string",
             "enum": [
+                "DRAFT",
                 "SOLICITED",
                 "APPROVED",
                 "CANCELED"
             ],
             "x-enum-varnames": [
+                "TravelRequestStatusDraft",
                 "TravelRequestStatusSolicited",
                 "TravelRequestStatusApproved",
                 "TravelRequestStatusCanceled"
             ]
         },
+        "enums.TravelSeriesStatus": {
+            "type": "string",
+            "enum": [
+                "ACTIVE",
+                "CANCELED"
+            ],
+            "x-enum-varnames": [
+                "TravelSeriesStatusActive",
+                "TravelSeriesStatusCanceled"
+            ]
+        },
         "enums.UserType": {
             "type": "string",
             "enum": [
@@ -580,6 +5979,32 @@ const docTemplate = `{
                 "UserTypeCommon",
                 "UserTypeAdmin"
             ]
+        },
+        "enums.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "PENDING",
+                "DELIVERED",
+                "FAILED"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryStatusPending",
+                "WebhookDeliveryStatusDelivered",
+                "WebhookDeliveryStatusFailed"
+            ]
+        },
+        "enums.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "travel.created",
+                "travel.approved",
+                "travel.canceled"
+            ],
+            "x-enum-varnames": [
+                "WebhookEventTravelCreated",
+                "WebhookEventTravelApproved",
+                "WebhookEventTravelCanceled"
+            ]
         }
     },
     "securityDefinitions": {
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+)
+
+// ApproverInbox reúne as solicitações de outros usuários que aguardam a decisão do aprovador,
+// da ida mais próxima para a mais distante, e a contagem por status dessas solicitações.
+type ApproverInbox struct {
+	StatusCounts map[enums.TravelRequestStatus]int `json:"status_counts"`
+	Items        []ApproverInboxItem               `json:"items"`
+}
+
+// ApproverInboxItem é uma solicitação pendente com a urgência e o tempo de espera calculados.
+type ApproverInboxItem struct {
+	TravelRequest      TravelRequest `json:"travel_request"`
+	DaysUntilDeparture int           `json:"days_until_departure"`
+	AgeHours           int           `json:"age_hours"`
+}
+
+// NewApproverInbox calcula, em relação a now, quantos dias faltam para a ida (no fuso da origem)
+// e há quantas horas cada solicitação foi registrada. A ordem de requests é mantida.
+func NewApproverInbox(requests []TravelRequest, statusCounts map[enums.TravelRequestStatus]int, now time.Time) *ApproverInbox {
+	items := make([]ApproverInboxItem, 0, len(requests))
+	for _, request := range requests {
+		departure := request.LocalDepartureDate()
+
+		age := 0
+		if now.After(request.CreatedAt) {
+			age = int(now.Sub(request.CreatedAt).Hours())
+		}
+
+		items = append(items, ApproverInboxItem{
+			TravelRequest:      request,
+			DaysUntilDeparture: DaysBetween(now.In(departure.Location()), departure),
+			AgeHours:           age,
+		})
+	}
+
+	if statusCounts == nil {
+		statusCounts = make(map[enums.TravelRequestStatus]int)
+	}
+
+	return &ApproverInbox{StatusCounts: statusCounts, Items: items}
+}
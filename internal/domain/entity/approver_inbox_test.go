@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApproverInbox(t *testing.T) {
+	t.Run("should compute urgency in the departure time zone and the age since submission", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2030, 1, 10, 23, 30, 0, 0, time.UTC)
+		requests := []TravelRequest{
+			{
+				Id: uuid.New(),
+				// Em Tóquio, a ida (01:00) e o momento atual (08:30) caem no mesmo dia 11.
+				DepartureDate:     time.Date(2030, 1, 10, 16, 0, 0, 0, time.UTC),
+				DepartureTimezone: "Asia/Tokyo",
+				CreatedAt:         now.Add(-50 * time.Hour),
+			},
+			{
+				Id:                uuid.New(),
+				DepartureDate:     time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC),
+				DepartureTimezone: "UTC",
+				CreatedAt:         now.Add(time.Minute),
+			},
+		}
+		counts := map[enums.TravelRequestStatus]int{enums.TravelRequestStatusSolicited: 2}
+
+		// Act
+		inbox := NewApproverInbox(requests, counts, now)
+
+		// Assert
+		assert.Equal(t, counts, inbox.StatusCounts)
+		assert.Equal(t, requests[0].Id, inbox.Items[0].TravelRequest.Id)
+		assert.Equal(t, 0, inbox.Items[0].DaysUntilDeparture)
+		assert.Equal(t, 50, inbox.Items[0].AgeHours)
+		assert.Equal(t, 5, inbox.Items[1].DaysUntilDeparture)
+		assert.Equal(t, 0, inbox.Items[1].AgeHours)
+	})
+}
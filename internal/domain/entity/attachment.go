@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TravelRequestAttachment descreve um arquivo anexado à solicitação. O conteúdo fica no
+// armazenamento configurado, identificado por StorageKey, e é conferido pelo Checksum (SHA-256).
+type TravelRequestAttachment struct {
+	Id              uuid.UUID `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelRequestId uuid.UUID `json:"travel_request_id" gorm:"type:uuid;not null"`
+	UploadedBy      uuid.UUID `json:"uploaded_by" gorm:"type:uuid;not null"`
+	FileName        string    `json:"file_name" gorm:"type:varchar(255);not null"`
+	ContentType     string    `json:"content_type" gorm:"type:varchar(127);not null"`
+	Size            int64     `json:"size" gorm:"type:bigint;not null"`
+	Checksum        string    `json:"checksum" gorm:"type:char(64);not null"`
+	StorageKey      string    `json:"-" gorm:"type:varchar(255);not null"`
+	CreatedAt       time.Time `json:"created_at" gorm:"type:timestamp;not null"`
+}
+
+// AttachmentStorageKey monta a chave do arquivo agrupando os anexos por solicitação.
+func AttachmentStorageKey(travelRequestID uuid.UUID, attachmentID uuid.UUID) string {
+	return fmt.Sprintf("travel-requests/%s/%s", travelRequestID, attachmentID)
+}
+
+// CanBeDeletedBy permite a exclusão por quem enviou o arquivo ou por administradores.
+func (a *TravelRequestAttachment) CanBeDeletedBy(user *User) bool {
+	return a.UploadedBy == user.Id || user.Role == enums.UserTypeAdmin
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TravelRequestComment é uma mensagem da conversa entre solicitante, viajantes e aprovadores.
+// Comentários internos são visíveis apenas para os aprovadores.
+type TravelRequestComment struct {
+	Id              uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelRequestId uuid.UUID  `json:"travel_request_id" gorm:"type:uuid;not null"`
+	AuthorId        uuid.UUID  `json:"author_id" gorm:"type:uuid;not null"`
+	AuthorName      string     `json:"author_name" gorm:"type:varchar(255);not null"`
+	Body            string     `json:"body" gorm:"type:text;not null"`
+	Internal        bool       `json:"internal" gorm:"type:boolean;not null;default:false"`
+	CreatedAt       time.Time  `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt       *time.Time `json:"updated_at" gorm:"type:timestamp"`
+}
+
+func (c *TravelRequestComment) IsVisibleTo(user *User) bool {
+	return !c.Internal || user.Role == enums.UserTypeAdmin
+}
+
+// CanBeEditedBy permite a edição apenas pelo autor.
+func (c *TravelRequestComment) CanBeEditedBy(user *User) bool {
+	return c.AuthorId == user.Id
+}
+
+// CanBeDeletedBy permite a exclusão pelo autor ou por administradores (moderação).
+func (c *TravelRequestComment) CanBeDeletedBy(user *User) bool {
+	return c.AuthorId == user.Id || user.Role == enums.UserTypeAdmin
+}
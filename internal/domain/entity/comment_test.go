@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelRequestComment_Permissions(t *testing.T) {
+	author := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+	admin := &User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+	other := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+
+	t.Run("should restrict internal comments to approvers", func(t *testing.T) {
+		comment := &TravelRequestComment{AuthorId: admin.Id, Internal: true}
+
+		assert.True(t, comment.IsVisibleTo(admin))
+		assert.False(t, comment.IsVisibleTo(author))
+	})
+
+	t.Run("should let only the author edit and the author or admins delete", func(t *testing.T) {
+		comment := &TravelRequestComment{AuthorId: author.Id}
+
+		assert.True(t, comment.CanBeEditedBy(author))
+		assert.False(t, comment.CanBeEditedBy(admin))
+		assert.True(t, comment.CanBeDeletedBy(author))
+		assert.True(t, comment.CanBeDeletedBy(admin))
+		assert.False(t, comment.CanBeDeletedBy(other))
+	})
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TravelCostItem struct {
+	Id              uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelRequestId uuid.UUID          `json:"travel_request_id" gorm:"type:uuid;not null"`
+	Category        enums.CostCategory `json:"category" gorm:"type:travel_cost_category;not null"`
+	Description     string             `json:"description" gorm:"type:varchar(255)"`
+	Amount          float64            `json:"amount" gorm:"type:numeric(14,2);not null"`
+	Currency        string             `json:"currency" gorm:"type:char(3);not null"`
+	BaseAmount      float64            `json:"base_amount" gorm:"type:numeric(14,2);not null"`
+	CreatedAt       time.Time          `json:"created_at" gorm:"type:timestamp;not null"`
+}
+
+// ExchangeRate guarda quantas unidades da moeda base da organização equivalem a uma unidade de
+// Currency.
+type ExchangeRate struct {
+	OrganizationId uuid.UUID `json:"organization_id" gorm:"type:uuid;primary_key"`
+	Currency       string    `json:"currency" gorm:"type:char(3);primary_key"`
+	Rate           float64   `json:"rate" gorm:"type:numeric(18,8);not null"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"type:timestamp;not null"`
+}
+
+func (e *ExchangeRate) Convert(amount float64) float64 {
+	return RoundMoney(amount * e.Rate)
+}
+
+type DepartmentBudget struct {
+	Id             uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID  `json:"organization_id" gorm:"type:uuid;not null"`
+	Department     string     `json:"department" gorm:"type:varchar(100);not null"`
+	Year           int        `json:"year" gorm:"type:integer;not null"`
+	Amount         float64    `json:"amount" gorm:"type:numeric(14,2);not null"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt      *time.Time `json:"updated_at" gorm:"type:timestamp"`
+}
+
+// Exceeds informa se aprovar mais requested, somado ao já aprovado, estoura o orçamento.
+func (e *DepartmentBudget) Exceeds(approved, requested float64) bool {
+	return RoundMoney(approved+requested) > e.Amount
+}
+
+func RoundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
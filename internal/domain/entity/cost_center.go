@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidAllocation   = errors.New("os percentuais do rateio devem ser positivos e somar 100%")
+	ErrDuplicateAllocation = errors.New("o mesmo centro de custo aparece mais de uma vez no rateio")
+)
+
+// CostCenter é um centro de custo ou projeto ao qual o financeiro atribui as despesas de viagem.
+// Centros inativos continuam nas solicitações antigas, mas não podem ser usados em novas.
+type CostCenter struct {
+	Id             uuid.UUID            `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID            `json:"organization_id" gorm:"type:uuid;not null"`
+	Code           string               `json:"code" gorm:"type:varchar(30);not null"`
+	Name           string               `json:"name" gorm:"type:varchar(255);not null"`
+	Kind           enums.CostCenterKind `json:"kind" gorm:"type:cost_center_kind;not null"`
+	Active         bool                 `json:"active" gorm:"not null;default:true"`
+	CreatedAt      time.Time            `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt      *time.Time           `json:"updated_at" gorm:"type:timestamp"`
+}
+
+// TravelCostAllocation é a parcela do custo estimado de uma solicitação atribuída a um centro de custo.
+type TravelCostAllocation struct {
+	Id              uuid.UUID   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelRequestId uuid.UUID   `json:"travel_request_id" gorm:"type:uuid;not null"`
+	CostCenterId    uuid.UUID   `json:"cost_center_id" gorm:"type:uuid;not null"`
+	Percentage      float64     `json:"percentage" gorm:"type:numeric(5,2);not null"`
+	Amount          float64     `json:"amount" gorm:"type:numeric(14,2);not null"`
+	CostCenter      *CostCenter `json:"cost_center,omitempty" gorm:"foreignKey:CostCenterId"`
+}
+
+// SetCostCenter define o centro de custo responsável e o rateio opcional entre centros. Sem rateio,
+// todo o custo fica com o centro responsável; com rateio, os percentuais devem somar 100%.
+func (e *TravelRequest) SetCostCenter(costCenter *CostCenter, allocations []TravelCostAllocation) error {
+	total := 0.0
+	seen := make(map[uuid.UUID]bool, len(allocations))
+	for _, allocation := range allocations {
+		if allocation.Percentage <= 0 || allocation.Percentage > 100 {
+			return ErrInvalidAllocation
+		}
+
+		if seen[allocation.CostCenterId] {
+			return ErrDuplicateAllocation
+		}
+		seen[allocation.CostCenterId] = true
+
+		total += allocation.Percentage
+	}
+
+	if len(allocations) > 0 && math.Abs(total-100) > 0.001 {
+		return ErrInvalidAllocation
+	}
+
+	for i := range allocations {
+		if allocations[i].Id == uuid.Nil {
+			allocations[i].Id = uuid.New()
+		}
+		allocations[i].TravelRequestId = e.Id
+	}
+
+	e.CostCenterId = &costCenter.Id
+	e.CostCenter = costCenter
+	e.Allocations = allocations
+	e.distributeAllocations()
+
+	return nil
+}
+
+// distributeAllocations reparte o total estimado pelos percentuais do rateio. A última parcela
+// recebe a sobra do arredondamento para que a soma seja exatamente o total.
+func (e *TravelRequest) distributeAllocations() {
+	remaining := e.EstimatedTotal
+	for i := range e.Allocations {
+		if i == len(e.Allocations)-1 {
+			e.Allocations[i].Amount = RoundMoney(remaining)
+			break
+		}
+
+		amount := RoundMoney(e.EstimatedTotal * e.Allocations[i].Percentage / 100)
+		e.Allocations[i].Amount = amount
+		remaining -= amount
+	}
+}
+
+// AllocationLines devolve o rateio efetivo: as parcelas informadas ou, sem rateio, uma única
+// parcela de 100% para o centro responsável. Solicitações sem centro de custo não têm parcelas.
+func (e *TravelRequest) AllocationLines() []TravelCostAllocation {
+	if len(e.Allocations) > 0 {
+		return e.Allocations
+	}
+
+	if e.CostCenterId == nil {
+		return nil
+	}
+
+	return []TravelCostAllocation{{
+		TravelRequestId: e.Id,
+		CostCenterId:    *e.CostCenterId,
+		Percentage:      100,
+		Amount:          e.EstimatedTotal,
+		CostCenter:      e.CostCenter,
+	}}
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelRequest_SetCostCenter(t *testing.T) {
+	commercial := &CostCenter{Id: uuid.New(), Code: "CC-100", Kind: enums.CostCenterKindCostCenter, Active: true}
+	project := &CostCenter{Id: uuid.New(), Code: "PRJ-7", Kind: enums.CostCenterKindProject, Active: true}
+
+	t.Run("should split the total and give the rounding remainder to the last allocation", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New(), EstimatedTotal: 100}
+		allocations := []TravelCostAllocation{
+			{CostCenterId: commercial.Id, Percentage: 33.33},
+			{CostCenterId: project.Id, Percentage: 33.33},
+			{CostCenterId: uuid.New(), Percentage: 33.34},
+		}
+
+		// Act
+		err := travelRequest.SetCostCenter(commercial, allocations)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &commercial.Id, travelRequest.CostCenterId)
+		assert.Equal(t, 33.33, travelRequest.Allocations[0].Amount)
+		assert.Equal(t, 33.33, travelRequest.Allocations[1].Amount)
+		assert.Equal(t, 33.34, travelRequest.Allocations[2].Amount)
+		for _, allocation := range travelRequest.Allocations {
+			assert.Equal(t, travelRequest.Id, allocation.TravelRequestId)
+			assert.NotEqual(t, uuid.Nil, allocation.Id)
+		}
+	})
+
+	t.Run("should reject percentages that do not sum to 100", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New()}
+		allocations := []TravelCostAllocation{
+			{CostCenterId: commercial.Id, Percentage: 50},
+			{CostCenterId: project.Id, Percentage: 49.99},
+		}
+
+		// Act
+		err := travelRequest.SetCostCenter(commercial, allocations)
+
+		// Assert
+		assert.Equal(t, ErrInvalidAllocation, err)
+		assert.Nil(t, travelRequest.CostCenterId)
+	})
+
+	t.Run("should reject the same cost center twice", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New()}
+		allocations := []TravelCostAllocation{
+			{CostCenterId: project.Id, Percentage: 50},
+			{CostCenterId: project.Id, Percentage: 50},
+		}
+
+		// Act
+		err := travelRequest.SetCostCenter(commercial, allocations)
+
+		// Assert
+		assert.Equal(t, ErrDuplicateAllocation, err)
+	})
+
+	t.Run("should recompute allocation amounts when cost items change", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New()}
+		err := travelRequest.SetCostCenter(commercial, []TravelCostAllocation{
+			{CostCenterId: commercial.Id, Percentage: 75},
+			{CostCenterId: project.Id, Percentage: 25},
+		})
+		assert.NoError(t, err)
+
+		// Act
+		travelRequest.SetCostItems([]TravelCostItem{{BaseAmount: 1000}}, "BRL")
+
+		// Assert
+		assert.Equal(t, 750.0, travelRequest.Allocations[0].Amount)
+		assert.Equal(t, 250.0, travelRequest.Allocations[1].Amount)
+	})
+}
+
+func TestTravelRequest_AllocationLines(t *testing.T) {
+	t.Run("should charge the whole total to the responsible cost center without a split", func(t *testing.T) {
+		// Arrange
+		costCenter := &CostCenter{Id: uuid.New(), Code: "CC-100"}
+		travelRequest := &TravelRequest{Id: uuid.New(), EstimatedTotal: 480.5}
+		assert.NoError(t, travelRequest.SetCostCenter(costCenter, nil))
+
+		// Act
+		lines := travelRequest.AllocationLines()
+
+		// Assert
+		assert.Len(t, lines, 1)
+		assert.Equal(t, costCenter.Id, lines[0].CostCenterId)
+		assert.Equal(t, 100.0, lines[0].Percentage)
+		assert.Equal(t, 480.5, lines[0].Amount)
+		assert.Equal(t, costCenter, lines[0].CostCenter)
+	})
+
+	t.Run("should have no lines without a cost center", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New(), EstimatedTotal: 100}
+
+		// Act
+		lines := travelRequest.AllocationLines()
+
+		// Assert
+		assert.Empty(t, lines)
+	})
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelRequest_SetCostItems(t *testing.T) {
+	t.Run("should link items and sum base amounts", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New()}
+		items := []TravelCostItem{
+			{Category: enums.CostCategoryAirfare, Amount: 100, Currency: "USD", BaseAmount: 540.1},
+			{Category: enums.CostCategoryGroundTransport, Amount: 60.2, Currency: "BRL", BaseAmount: 60.2},
+		}
+
+		// Act
+		travelRequest.SetCostItems(items, "BRL")
+
+		// Assert
+		assert.Equal(t, 600.3, travelRequest.EstimatedTotal)
+		assert.Equal(t, "BRL", travelRequest.Currency)
+		for _, item := range travelRequest.CostItems {
+			assert.Equal(t, travelRequest.Id, item.TravelRequestId)
+		}
+	})
+
+	t.Run("should reset total when items are cleared", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{Id: uuid.New(), EstimatedTotal: 999}
+
+		// Act
+		travelRequest.SetCostItems([]TravelCostItem{}, "BRL")
+
+		// Assert
+		assert.Equal(t, 0.0, travelRequest.EstimatedTotal)
+		assert.Empty(t, travelRequest.CostItems)
+	})
+}
+
+func TestDepartmentBudget_Exceeds(t *testing.T) {
+	budget := &DepartmentBudget{Department: "SALES", Year: 2030, Amount: 1000}
+
+	assert.False(t, budget.Exceeds(400, 600))
+	assert.True(t, budget.Exceeds(400, 600.01))
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	rate := &ExchangeRate{Currency: "USD", Rate: 5.4321}
+
+	assert.Equal(t, 543.21, rate.Convert(100))
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrManagerCycle = errors.New("a atribuição de gestor criaria um ciclo na hierarquia")
+)
+
+// Department é a unidade organizacional do usuário. O responsável (HeadUserId) é quem responde
+// pelo departamento quando a aprovação precisa subir além do gestor direto.
+type Department struct {
+	Id             uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID  `json:"organization_id" gorm:"type:uuid;not null"`
+	Code           string     `json:"code" gorm:"type:varchar(30);not null"`
+	Name           string     `json:"name" gorm:"type:varchar(255);not null"`
+	HeadUserId     *uuid.UUID `json:"head_user_id,omitempty" gorm:"type:uuid"`
+	Active         bool       `json:"active" gorm:"type:boolean;not null;default:true"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt      *time.Time `json:"updated_at" gorm:"type:timestamp"`
+}
+
+// ReportingLines mapeia cada usuário ao seu gestor direto e permite validar novas atribuições
+// antes de gravá-las.
+type ReportingLines map[uuid.UUID]uuid.UUID
+
+func NewReportingLines(users []User) ReportingLines {
+	lines := make(ReportingLines, len(users))
+	for _, user := range users {
+		if user.ManagerId != nil {
+			lines[user.Id] = *user.ManagerId
+		}
+	}
+	return lines
+}
+
+// Assign define (ou remove, com managerID nil) o gestor do usuário. A atribuição é recusada
+// quando o usuário aparece na cadeia de gestores acima do novo gestor, inclusive ele mesmo.
+func (l ReportingLines) Assign(userID uuid.UUID, managerID *uuid.UUID) error {
+	if managerID == nil {
+		delete(l, userID)
+		return nil
+	}
+
+	visited := map[uuid.UUID]bool{}
+	for current, ok := *managerID, true; ok && !visited[current]; current, ok = l[current] {
+		if current == userID {
+			return ErrManagerCycle
+		}
+		visited[current] = true
+	}
+
+	l[userID] = *managerID
+	return nil
+}
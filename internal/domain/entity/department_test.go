@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportingLines_Assign(t *testing.T) {
+	director, manager, analyst := uuid.New(), uuid.New(), uuid.New()
+
+	newLines := func() ReportingLines {
+		return NewReportingLines([]User{
+			{Id: manager, ManagerId: &director},
+			{Id: analyst, ManagerId: &manager},
+			{Id: director},
+		})
+	}
+
+	t.Run("should assign a manager outside the user's reporting chain", func(t *testing.T) {
+		// Arrange
+		lines := newLines()
+		newcomer := uuid.New()
+
+		// Act
+		err := lines.Assign(newcomer, &analyst)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, analyst, lines[newcomer])
+	})
+
+	t.Run("should reject a manager that reports to the user", func(t *testing.T) {
+		// Arrange
+		lines := newLines()
+
+		// Act
+		err := lines.Assign(director, &analyst)
+
+		// Assert
+		assert.Equal(t, ErrManagerCycle, err)
+		_, hasManager := lines[director]
+		assert.False(t, hasManager)
+	})
+
+	t.Run("should reject the user as their own manager", func(t *testing.T) {
+		// Arrange
+		lines := newLines()
+
+		// Act
+		err := lines.Assign(analyst, &analyst)
+
+		// Assert
+		assert.Equal(t, ErrManagerCycle, err)
+		assert.Equal(t, manager, lines[analyst])
+	})
+
+	t.Run("should remove the manager when none is given", func(t *testing.T) {
+		// Arrange
+		lines := newLines()
+
+		// Act
+		err := lines.Assign(analyst, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		_, hasManager := lines[analyst]
+		assert.False(t, hasManager)
+	})
+
+	t.Run("should terminate on a cycle already present in the data", func(t *testing.T) {
+		// Arrange
+		first, second := uuid.New(), uuid.New()
+		lines := ReportingLines{first: second, second: first}
+		newcomer := uuid.New()
+
+		// Act
+		err := lines.Assign(newcomer, &first)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
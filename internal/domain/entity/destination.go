@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Destination é uma entrada do catálogo de destinos (cidade, país, aeroportos e fuso horário).
+type Destination struct {
+	Id          uuid.UUID            `json:"id" gorm:"type:uuid;primary_key"`
+	Code        string               `json:"code" gorm:"type:varchar(20);not null;unique"`
+	City        string               `json:"city" gorm:"type:varchar(120);not null"`
+	Region      string               `json:"region" gorm:"type:varchar(120);not null"`
+	CountryCode string               `json:"country_code" gorm:"type:char(2);not null"`
+	CountryName string               `json:"country_name" gorm:"type:varchar(120);not null"`
+	Timezone    string               `json:"timezone" gorm:"type:varchar(64);not null"`
+	Aliases     string               `json:"aliases" gorm:"type:varchar(255);not null"`
+	CreatedAt   time.Time            `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt   *time.Time           `json:"updated_at" gorm:"type:timestamp"`
+	Airports    []DestinationAirport `json:"airports" gorm:"foreignKey:DestinationId"`
+}
+
+type DestinationAirport struct {
+	IataCode      string    `json:"iata_code" gorm:"type:char(3);primary_key"`
+	DestinationId uuid.UUID `json:"destination_id" gorm:"type:uuid;not null"`
+	Name          string    `json:"name" gorm:"type:varchar(255);not null"`
+}
+
+// destinationNamespace gera IDs estáveis a partir do código, para que o catálogo possa ser
+// reimportado sem alterar as referências das solicitações.
+var destinationNamespace = uuid.MustParse("6f1f8f56-3c0e-4d1a-9a43-3f5f8d0c2b71")
+
+func DestinationIDFromCode(code string) uuid.UUID {
+	return uuid.NewSHA1(destinationNamespace, []byte(strings.ToUpper(code)))
+}
+
+// DisplayName é o nome canônico gravado em DestinationName das solicitações.
+func (e *Destination) DisplayName() string {
+	return e.City + ", " + e.CountryName
+}
+
+func (e *Destination) IataCodes() []string {
+	codes := make([]string, 0, len(e.Airports))
+	for _, airport := range e.Airports {
+		codes = append(codes, airport.IataCode)
+	}
+
+	return codes
+}
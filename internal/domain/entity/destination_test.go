@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestinationIDFromCode(t *testing.T) {
+	t.Run("should derive the same id regardless of case", func(t *testing.T) {
+		assert.Equal(t, DestinationIDFromCode("BR-SAO"), DestinationIDFromCode("br-sao"))
+		assert.NotEqual(t, DestinationIDFromCode("BR-SAO"), DestinationIDFromCode("BR-RIO"))
+	})
+}
+
+func TestTravelRequest_SetDestination(t *testing.T) {
+	destination := &Destination{Id: uuid.New(), City: "Lisboa", CountryCode: "PT", CountryName: "Portugal"}
+
+	t.Run("should link the catalog entry and expose its country to policies", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{DestinationName: "lisbon", DepartureDate: time.Now().AddDate(0, 1, 0)}
+
+		// Act
+		travelRequest.SetDestination(destination)
+		facts := NewPolicyFacts(travelRequest, time.Now())
+
+		// Assert
+		assert.Equal(t, "Lisboa, Portugal", travelRequest.DestinationName)
+		assert.Equal(t, &destination.Id, travelRequest.DestinationId)
+		assert.Equal(t, "PT", facts[enums.PolicyFieldDestinationCountry])
+	})
+
+	t.Run("should keep free text when the link is removed", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{}
+		travelRequest.SetDestination(destination)
+		travelRequest.DestinationName = "Sintra"
+
+		// Act
+		travelRequest.SetDestination(nil)
+
+		// Assert
+		assert.Nil(t, travelRequest.DestinationId)
+		assert.Equal(t, "Sintra", travelRequest.DestinationName)
+		assert.Equal(t, "", NewPolicyFacts(travelRequest, time.Now())[enums.PolicyFieldDestinationCountry])
+	})
+}
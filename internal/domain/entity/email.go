@@ -0,0 +1,12 @@
+package entity
+
+// EmailMessage é um e-mail para um único destinatário, com as versões em texto e em HTML do
+// mesmo conteúdo. IdempotencyKey identifica a mensagem entre novas tentativas de envio: os
+// envios repetidos usam o mesmo Message-ID.
+type EmailMessage struct {
+	To             NotificationRecipient `json:"to"`
+	Subject        string                `json:"subject"`
+	TextBody       string                `json:"text_body"`
+	HTMLBody       string                `json:"html_body"`
+	IdempotencyKey string                `json:"idempotency_key,omitempty"`
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification é um aviso da caixa de entrada do usuário na aplicação. Payload traz, em JSON, os
+// dados do aviso conforme o tipo; ReadAt vazio indica um aviso não lido.
+type Notification struct {
+	Id             uuid.UUID              `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID              `json:"-" gorm:"type:uuid;not null"`
+	UserId         uuid.UUID              `json:"user_id" gorm:"type:uuid;not null"`
+	Type           enums.NotificationType `json:"type" gorm:"type:varchar(30);not null"`
+	Payload        json.RawMessage        `json:"payload" gorm:"type:jsonb;not null" swaggertype:"object"`
+	ReadAt         *time.Time             `json:"read_at" gorm:"type:timestamptz"`
+	CreatedAt      time.Time              `json:"created_at" gorm:"type:timestamptz;not null"`
+}
+
+// NewNotification serializa o conteúdo de um novo aviso, ainda não lido.
+func NewNotification(organizationID uuid.UUID, userID uuid.UUID, notificationType enums.NotificationType, payload any, now time.Time) (*Notification, error) {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notification{
+		Id:             uuid.New(),
+		OrganizationId: organizationID,
+		UserId:         userID,
+		Type:           notificationType,
+		Payload:        content,
+		CreatedAt:      now,
+	}, nil
+}
+
+// NotificationPage é uma página da caixa de entrada, das notificações mais recentes para as mais
+// antigas. UnreadCount considera a caixa inteira, não só a página.
+type NotificationPage struct {
+	Items       []Notification `json:"items"`
+	Page        int            `json:"page"`
+	PageSize    int            `json:"page_size"`
+	Total       int64          `json:"total"`
+	UnreadCount int64          `json:"unread_count"`
+}
+
+// NotificationPreference define por quais canais o usuário recebe as notificações de um tipo.
+// Tipos sem preferência gravada são enviados por todos os canais.
+type NotificationPreference struct {
+	UserId    uuid.UUID              `json:"-" gorm:"type:uuid;primary_key"`
+	Type      enums.NotificationType `json:"type" gorm:"type:varchar(30);primary_key"`
+	Email     bool                   `json:"email" gorm:"not null;default:true"`
+	InApp     bool                   `json:"in_app" gorm:"not null;default:true"`
+	UpdatedAt *time.Time             `json:"updated_at,omitempty" gorm:"type:timestamptz"`
+}
+
+// DefaultNotificationPreference é a preferência de quem nunca alterou o tipo.
+func DefaultNotificationPreference(userID uuid.UUID, notificationType enums.NotificationType) NotificationPreference {
+	return NotificationPreference{UserId: userID, Type: notificationType, Email: true, InApp: true}
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization é uma empresa (subsidiária) hospedada na instalação. Usuários e solicitações
+// pertencem a uma única organização, e as configurações abaixo substituem os padrões da
+// instalação para ela. DefaultLocale é o idioma das notificações de viajantes sem conta.
+type Organization struct {
+	Id              uuid.UUID    `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Code            string       `json:"code" gorm:"type:varchar(30);not null;unique"`
+	Name            string       `json:"name" gorm:"type:varchar(255);not null"`
+	BaseCurrency    string       `json:"base_currency" gorm:"type:char(3);not null"`
+	DefaultTimezone string       `json:"default_timezone" gorm:"type:varchar(64);not null"`
+	DefaultLocale   enums.Locale `json:"default_locale" gorm:"type:varchar(10);not null;default:'pt-BR'"`
+	Active          bool         `json:"active" gorm:"type:boolean;not null;default:true"`
+	CreatedAt       time.Time    `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt       *time.Time   `json:"updated_at" gorm:"type:timestamp"`
+}
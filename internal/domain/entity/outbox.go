@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	outboxFirstRetryDelay = 30 * time.Second
+	outboxMaxRetryDelay   = time.Hour
+)
+
+// OutboxMessage é uma entrega gravada na mesma transação da alteração que a originou e feita
+// depois, em segundo plano. Topic indica quem a entrega (por exemplo, "email") e Payload traz o
+// conteúdo em JSON esperado por ele. Mensagens que esgotam as tentativas ficam com o status
+// DEAD até serem reenfileiradas por um administrador.
+type OutboxMessage struct {
+	Id             uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID          `json:"organization_id" gorm:"type:uuid;not null"`
+	Topic          string             `json:"topic" gorm:"type:varchar(50);not null"`
+	Payload        json.RawMessage    `json:"payload" gorm:"type:jsonb;not null" swaggertype:"object"`
+	Status         enums.OutboxStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Attempts       int                `json:"attempts" gorm:"not null;default:0"`
+	AvailableAt    time.Time          `json:"available_at" gorm:"type:timestamptz;not null"`
+	LockedUntil    *time.Time         `json:"-" gorm:"type:timestamptz"`
+	LastError      *string            `json:"last_error,omitempty" gorm:"type:text"`
+	DeliveredAt    *time.Time         `json:"delivered_at,omitempty" gorm:"type:timestamptz"`
+	CreatedAt      time.Time          `json:"created_at" gorm:"type:timestamptz;not null"`
+	UpdatedAt      *time.Time         `json:"updated_at" gorm:"type:timestamptz"`
+}
+
+// NewOutboxMessage serializa o conteúdo de uma nova entrega, disponível imediatamente.
+func NewOutboxMessage(organizationID uuid.UUID, topic string, payload any, now time.Time) (*OutboxMessage, error) {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxMessage{
+		Id:             uuid.New(),
+		OrganizationId: organizationID,
+		Topic:          topic,
+		Payload:        content,
+		Status:         enums.OutboxStatusPending,
+		AvailableAt:    now,
+		CreatedAt:      now,
+	}, nil
+}
+
+// RecordFailure registra uma tentativa malsucedida. A mensagem volta para a fila com espera
+// exponencial (30s, 1min, 2min... até 1h) ou, esgotadas as tentativas, passa a DEAD.
+func (m *OutboxMessage) RecordFailure(cause error, now time.Time, maxAttempts int) {
+	m.Attempts++
+	message := cause.Error()
+	m.LastError = &message
+	m.LockedUntil = nil
+	m.UpdatedAt = &now
+
+	if m.Attempts >= maxAttempts {
+		m.Status = enums.OutboxStatusDead
+		return
+	}
+
+	m.AvailableAt = now.Add(OutboxRetryDelay(m.Attempts))
+}
+
+// OutboxRetryDelay é a espera antes da próxima tentativa, dobrando a cada falha.
+func OutboxRetryDelay(attempts int) time.Duration {
+	delay := outboxFirstRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= outboxMaxRetryDelay {
+			return outboxMaxRetryDelay
+		}
+	}
+	return delay
+}
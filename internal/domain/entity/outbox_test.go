@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxMessage_RecordFailure(t *testing.T) {
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should reschedule with exponential backoff", func(t *testing.T) {
+		message, err := NewOutboxMessage(uuid.New(), "email", map[string]string{"to": "ana@empresa.com"}, now)
+		require.NoError(t, err)
+
+		message.RecordFailure(errors.New("timeout"), now, 5)
+		assert.Equal(t, enums.OutboxStatusPending, message.Status)
+		assert.Equal(t, 1, message.Attempts)
+		assert.Equal(t, now.Add(30*time.Second), message.AvailableAt)
+		assert.Equal(t, "timeout", *message.LastError)
+
+		message.RecordFailure(errors.New("timeout"), now, 5)
+		assert.Equal(t, now.Add(time.Minute), message.AvailableAt)
+	})
+
+	t.Run("should move to DEAD after the last attempt", func(t *testing.T) {
+		message := &OutboxMessage{Status: enums.OutboxStatusPending, Attempts: 2, AvailableAt: now}
+
+		message.RecordFailure(errors.New("recusado"), now.Add(time.Hour), 3)
+
+		assert.Equal(t, enums.OutboxStatusDead, message.Status)
+		assert.Equal(t, 3, message.Attempts)
+		assert.Equal(t, now, message.AvailableAt)
+	})
+
+	t.Run("should cap the retry delay at one hour", func(t *testing.T) {
+		assert.Equal(t, 30*time.Second, OutboxRetryDelay(1))
+		assert.Equal(t, 16*time.Minute, OutboxRetryDelay(6))
+		assert.Equal(t, time.Hour, OutboxRetryDelay(8))
+		assert.Equal(t, time.Hour, OutboxRetryDelay(50))
+	})
+}
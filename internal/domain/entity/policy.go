@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidPolicyRule = errors.New("regra de política inválida")
+)
+
+// TravelPolicyRule descreve uma condição sobre a solicitação que, quando verdadeira, gera uma violação.
+// Para os operadores IN e NOT_IN, Value é uma lista separada por vírgulas. Regras sem OrganizationId
+// valem para todas as organizações.
+type TravelPolicyRule struct {
+	Id             uuid.UUID            `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId *uuid.UUID           `json:"organization_id,omitempty" gorm:"type:uuid"`
+	Name           string               `json:"name" gorm:"type:varchar(100);not null"`
+	Description    string               `json:"description" gorm:"type:text"`
+	Field          enums.PolicyField    `json:"field" gorm:"type:varchar(50);not null"`
+	Operator       enums.PolicyOperator `json:"operator" gorm:"type:varchar(10);not null"`
+	Value          string               `json:"value" gorm:"type:varchar(255);not null"`
+	Severity       enums.PolicySeverity `json:"severity" gorm:"type:travel_policy_severity;not null"`
+	Message        string               `json:"message" gorm:"type:varchar(255);not null"`
+	IsActive       bool                 `json:"is_active" gorm:"type:boolean;not null;default:true"`
+	CreatedAt      time.Time            `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt      *time.Time           `json:"updated_at" gorm:"type:timestamp"`
+}
+
+type TravelPolicyViolation struct {
+	Id              uuid.UUID            `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelRequestId uuid.UUID            `json:"travel_request_id" gorm:"type:uuid;not null"`
+	RuleId          *uuid.UUID           `json:"rule_id" gorm:"type:uuid"`
+	RuleName        string               `json:"rule_name" gorm:"type:varchar(100);not null"`
+	Severity        enums.PolicySeverity `json:"severity" gorm:"type:travel_policy_severity;not null"`
+	Message         string               `json:"message" gorm:"type:varchar(255);not null"`
+	CreatedAt       time.Time            `json:"created_at" gorm:"type:timestamp;not null"`
+}
+
+// PolicyFacts são os atributos da solicitação disponíveis para as regras.
+type PolicyFacts map[enums.PolicyField]interface{}
+
+// NewPolicyFacts calcula os fatos usando as datas locais da viagem, para que a antecedência
+// e as noites considerem o fuso de cada trecho e não o do servidor.
+func NewPolicyFacts(travelRequest *TravelRequest, now time.Time) PolicyFacts {
+	departure := travelRequest.LocalDepartureDate()
+
+	facts := PolicyFacts{
+		enums.PolicyFieldAdvanceDays:        DaysBetween(now.In(departure.Location()), departure),
+		enums.PolicyFieldNights:             travelRequest.Nights(),
+		enums.PolicyFieldDestinationName:    travelRequest.DestinationName,
+		enums.PolicyFieldEstimatedTotal:     travelRequest.EstimatedTotal,
+		enums.PolicyFieldDepartment:         "",
+		enums.PolicyFieldDestinationCountry: "",
+	}
+
+	if travelRequest.Destination != nil {
+		facts[enums.PolicyFieldDestinationCountry] = travelRequest.Destination.CountryCode
+	}
+
+	if travelRequest.Department != nil {
+		facts[enums.PolicyFieldDepartment] = *travelRequest.Department
+	}
+
+	return facts
+}
+
+func (r *TravelPolicyRule) Validate() error {
+	if r.Name == "" || r.Message == "" || !r.Severity.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidPolicyRule, r.Name)
+	}
+
+	switch r.Operator {
+	case enums.PolicyOperatorLessThan, enums.PolicyOperatorLessThanOrEqual,
+		enums.PolicyOperatorGreaterThan, enums.PolicyOperatorGreaterThanOrEqual,
+		enums.PolicyOperatorEqual, enums.PolicyOperatorNotEqual,
+		enums.PolicyOperatorIn, enums.PolicyOperatorNotIn:
+	default:
+		return fmt.Errorf("%w: operador %q desconhecido em %q", ErrInvalidPolicyRule, r.Operator, r.Name)
+	}
+
+	return nil
+}
+
+// Matches informa se a condição da regra é verdadeira para os fatos informados.
+func (r *TravelPolicyRule) Matches(facts PolicyFacts) (bool, error) {
+	fact, ok := facts[r.Field]
+	if !ok {
+		return false, fmt.Errorf("%w: campo %q desconhecido em %q", ErrInvalidPolicyRule, r.Field, r.Name)
+	}
+
+	switch r.Operator {
+	case enums.PolicyOperatorIn, enums.PolicyOperatorNotIn:
+		found := false
+		for _, candidate := range strings.Split(r.Value, ",") {
+			if equalFact(fact, strings.TrimSpace(candidate)) {
+				found = true
+				break
+			}
+		}
+		return found == (r.Operator == enums.PolicyOperatorIn), nil
+	case enums.PolicyOperatorEqual:
+		return equalFact(fact, r.Value), nil
+	case enums.PolicyOperatorNotEqual:
+		return !equalFact(fact, r.Value), nil
+	}
+
+	current, ok := numericFact(fact)
+	if !ok {
+		return false, fmt.Errorf("%w: campo %q não é numérico em %q", ErrInvalidPolicyRule, r.Field, r.Name)
+	}
+
+	expected, err := strconv.ParseFloat(strings.TrimSpace(r.Value), 64)
+	if err != nil {
+		return false, fmt.Errorf("%w: valor %q não é numérico em %q", ErrInvalidPolicyRule, r.Value, r.Name)
+	}
+
+	switch r.Operator {
+	case enums.PolicyOperatorLessThan:
+		return current < expected, nil
+	case enums.PolicyOperatorLessThanOrEqual:
+		return current <= expected, nil
+	case enums.PolicyOperatorGreaterThan:
+		return current > expected, nil
+	case enums.PolicyOperatorGreaterThanOrEqual:
+		return current >= expected, nil
+	}
+
+	return false, fmt.Errorf("%w: operador %q desconhecido em %q", ErrInvalidPolicyRule, r.Operator, r.Name)
+}
+
+func (r *TravelPolicyRule) NewViolation(travelRequestID uuid.UUID, now time.Time) TravelPolicyViolation {
+	violation := TravelPolicyViolation{
+		Id:              uuid.New(),
+		TravelRequestId: travelRequestID,
+		RuleName:        r.Name,
+		Severity:        r.Severity,
+		Message:         r.Message,
+		CreatedAt:       now,
+	}
+
+	if r.Id != uuid.Nil {
+		ruleID := r.Id
+		violation.RuleId = &ruleID
+	}
+
+	return violation
+}
+
+func equalFact(fact interface{}, value string) bool {
+	if current, ok := numericFact(fact); ok {
+		expected, err := strconv.ParseFloat(value, 64)
+		return err == nil && current == expected
+	}
+
+	return strings.EqualFold(fmt.Sprint(fact), value)
+}
+
+func numericFact(fact interface{}) (float64, bool) {
+	switch v := fact.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
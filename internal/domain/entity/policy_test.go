@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelPolicyRule_Matches(t *testing.T) {
+	facts := PolicyFacts{
+		enums.PolicyFieldAdvanceDays:     10,
+		enums.PolicyFieldNights:          3,
+		enums.PolicyFieldDestinationName: "Buenos Aires",
+		enums.PolicyFieldDepartment:      "SALES",
+		enums.PolicyFieldEstimatedTotal:  4500.0,
+	}
+
+	tests := []struct {
+		name     string
+		rule     TravelPolicyRule
+		expected bool
+	}{
+		{"less than", TravelPolicyRule{Field: enums.PolicyFieldAdvanceDays, Operator: enums.PolicyOperatorLessThan, Value: "14"}, true},
+		{"less than or equal", TravelPolicyRule{Field: enums.PolicyFieldNights, Operator: enums.PolicyOperatorLessThanOrEqual, Value: "2"}, false},
+		{"greater than", TravelPolicyRule{Field: enums.PolicyFieldEstimatedTotal, Operator: enums.PolicyOperatorGreaterThan, Value: "4000"}, true},
+		{"greater than or equal", TravelPolicyRule{Field: enums.PolicyFieldNights, Operator: enums.PolicyOperatorGreaterThanOrEqual, Value: "3"}, true},
+		{"equal ignores case", TravelPolicyRule{Field: enums.PolicyFieldDepartment, Operator: enums.PolicyOperatorEqual, Value: "sales"}, true},
+		{"not equal", TravelPolicyRule{Field: enums.PolicyFieldDepartment, Operator: enums.PolicyOperatorNotEqual, Value: "SALES"}, false},
+		{"in list", TravelPolicyRule{Field: enums.PolicyFieldDestinationName, Operator: enums.PolicyOperatorIn, Value: "Santiago, Buenos Aires"}, true},
+		{"not in list", TravelPolicyRule{Field: enums.PolicyFieldDestinationName, Operator: enums.PolicyOperatorNotIn, Value: "Santiago,Lima"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			matches, err := tt.rule.Matches(facts)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+
+	t.Run("should return error for non numeric comparison", func(t *testing.T) {
+		rule := TravelPolicyRule{Name: "x", Field: enums.PolicyFieldDestinationName, Operator: enums.PolicyOperatorGreaterThan, Value: "1"}
+
+		_, err := rule.Matches(facts)
+
+		assert.ErrorIs(t, err, ErrInvalidPolicyRule)
+	})
+
+	t.Run("should return error for unknown field", func(t *testing.T) {
+		rule := TravelPolicyRule{Name: "x", Field: "purpose", Operator: enums.PolicyOperatorEqual, Value: "1"}
+
+		_, err := rule.Matches(facts)
+
+		assert.ErrorIs(t, err, ErrInvalidPolicyRule)
+	})
+}
+
+func TestNewPolicyFacts(t *testing.T) {
+	now := time.Date(2030, time.May, 1, 12, 0, 0, 0, time.UTC)
+	returnDate := time.Date(2030, time.May, 20, 8, 0, 0, 0, time.UTC)
+	department := "FINANCE"
+
+	travel := &TravelRequest{
+		DestinationName: "Lisboa",
+		DepartureDate:   time.Date(2030, time.May, 15, 22, 0, 0, 0, time.UTC),
+		ReturnDate:      &returnDate,
+		Department:      &department,
+		EstimatedTotal:  1234.5,
+	}
+
+	facts := NewPolicyFacts(travel, now)
+
+	assert.Equal(t, 14, facts[enums.PolicyFieldAdvanceDays])
+	assert.Equal(t, 5, facts[enums.PolicyFieldNights])
+	assert.Equal(t, "Lisboa", facts[enums.PolicyFieldDestinationName])
+	assert.Equal(t, "FINANCE", facts[enums.PolicyFieldDepartment])
+	assert.Equal(t, 1234.5, facts[enums.PolicyFieldEstimatedTotal])
+}
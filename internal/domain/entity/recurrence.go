@@ -0,0 +1,357 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRecurrenceRule = errors.New("regra de recorrência inválida")
+)
+
+const (
+	RecurrenceDaily   = "DAILY"
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+
+	// maxRecurrencePeriods limita a expansão de regras que nunca produzem ocorrências
+	// (por exemplo, BYMONTHDAY=31 com INTERVAL=2 a partir de um mês curto).
+	maxRecurrencePeriods = 10000
+)
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceWeekday é um item de BYDAY; Ordinal (1..5 ou -1..-5) só é aceito em regras
+// mensais, como em 1MO (primeira segunda-feira) ou -1FR (última sexta-feira).
+type RecurrenceWeekday struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// RecurrenceRule é o subconjunto suportado da RRULE da RFC 5545: FREQ (DAILY, WEEKLY,
+// MONTHLY), INTERVAL, COUNT, UNTIL (data ou data-hora UTC), BYDAY, BYMONTHDAY e WKST=MO.
+// As ocorrências são calculadas no fuso do início da série, mantendo o horário local.
+type RecurrenceRule struct {
+	Frequency  string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	UntilDate  bool
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+}
+
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("%w: regra vazia", ErrInvalidRecurrenceRule)
+	}
+
+	rule := &RecurrenceRule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || val == "" {
+			return nil, fmt.Errorf("%w: parte %q", ErrInvalidRecurrenceRule, part)
+		}
+
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %s repetido", ErrInvalidRecurrenceRule, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			if val != RecurrenceDaily && val != RecurrenceWeekly && val != RecurrenceMonthly {
+				return nil, fmt.Errorf("%w: FREQ=%s não suportado", ErrInvalidRecurrenceRule, val)
+			}
+			rule.Frequency = val
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(val, 1000)
+		case "COUNT":
+			rule.Count, err = parsePositive(val, 1000)
+		case "UNTIL":
+			err = rule.parseUntil(val)
+		case "BYDAY":
+			rule.ByDay, err = parseByDay(val)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseByMonthDay(val)
+		case "WKST":
+			if val != "MO" {
+				err = errors.New("apenas WKST=MO é suportado")
+			}
+		default:
+			return nil, fmt.Errorf("%w: %s não suportado", ErrInvalidRecurrenceRule, key)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidRecurrenceRule, key, err)
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecurrenceRule, err)
+	}
+
+	return rule, nil
+}
+
+func (r *RecurrenceRule) validate() error {
+	if r.Frequency == "" {
+		return errors.New("FREQ é obrigatório")
+	}
+
+	if r.Count > 0 && r.Until != nil {
+		return errors.New("COUNT e UNTIL não podem ser usados juntos")
+	}
+
+	if len(r.ByMonthDay) > 0 && r.Frequency != RecurrenceMonthly {
+		return errors.New("BYMONTHDAY só é suportado com FREQ=MONTHLY")
+	}
+
+	if len(r.ByMonthDay) > 0 && len(r.ByDay) > 0 {
+		return errors.New("BYDAY e BYMONTHDAY não podem ser combinados")
+	}
+
+	for _, day := range r.ByDay {
+		if day.Ordinal != 0 && r.Frequency != RecurrenceMonthly {
+			return errors.New("BYDAY com posição só é suportado com FREQ=MONTHLY")
+		}
+	}
+
+	return nil
+}
+
+func (r *RecurrenceRule) parseUntil(value string) error {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		r.Until = &until
+		return nil
+	}
+
+	if until, err := time.Parse("20060102", value); err == nil {
+		r.Until = &until
+		r.UntilDate = true
+		return nil
+	}
+
+	return errors.New("use AAAAMMDD ou AAAAMMDDTHHMMSSZ")
+}
+
+// Between devolve as ocorrências no intervalo (after, before], em ordem, no máximo limit.
+// COUNT e UNTIL são contados a partir de start, que só é ocorrência se satisfizer a regra.
+func (r *RecurrenceRule) Between(start, after, before time.Time, limit int) []time.Time {
+	var until *time.Time
+	if r.Until != nil {
+		value := *r.Until
+		if r.UntilDate {
+			// Uma data em UNTIL inclui o dia inteiro no fuso da série.
+			value = time.Date(value.Year(), value.Month(), value.Day(), 23, 59, 59, 0, start.Location())
+		}
+		until = &value
+	}
+
+	occurrences := make([]time.Time, 0)
+	count := 0
+
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range r.periodCandidates(start, period) {
+			if candidate.Before(start) {
+				continue
+			}
+
+			if until != nil && candidate.After(*until) {
+				return occurrences
+			}
+
+			count++
+			if r.Count > 0 && count > r.Count {
+				return occurrences
+			}
+
+			if candidate.After(before) {
+				return occurrences
+			}
+
+			if candidate.After(after) {
+				occurrences = append(occurrences, candidate)
+				if len(occurrences) >= limit {
+					return occurrences
+				}
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// periodCandidates lista, em ordem, as datas do período (dia, semana ou mês) de índice period.
+func (r *RecurrenceRule) periodCandidates(start time.Time, period int) []time.Time {
+	location := start.Location()
+	hour, minute, second := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, 0, location)
+	}
+
+	switch r.Frequency {
+	case RecurrenceDaily:
+		day := at(start.Year(), start.Month(), start.Day()+period*r.Interval)
+		if len(r.ByDay) > 0 && !r.matchesWeekday(day.Weekday()) {
+			return nil
+		}
+		return []time.Time{day}
+	case RecurrenceWeekly:
+		offset := (int(start.Weekday()) + 6) % 7
+		monday := start.Day() - offset + period*r.Interval*7
+
+		weekdays := []time.Weekday{start.Weekday()}
+		if len(r.ByDay) > 0 {
+			weekdays = weekdays[:0]
+			for _, day := range r.ByDay {
+				weekdays = append(weekdays, day.Weekday)
+			}
+		}
+
+		candidates := make([]time.Time, 0, len(weekdays))
+		for _, weekday := range weekdays {
+			candidates = append(candidates, at(start.Year(), start.Month(), monday+(int(weekday)+6)%7))
+		}
+		return sortedUnique(candidates)
+	case RecurrenceMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(period*r.Interval), 1, 0, 0, 0, 0, location)
+		year, month := first.Year(), first.Month()
+		daysInMonth := time.Date(year, month+1, 0, 0, 0, 0, 0, location).Day()
+
+		var days []int
+		switch {
+		case len(r.ByMonthDay) > 0:
+			for _, day := range r.ByMonthDay {
+				if day < 0 {
+					day = daysInMonth + day + 1
+				}
+				if day >= 1 && day <= daysInMonth {
+					days = append(days, day)
+				}
+			}
+		case len(r.ByDay) > 0:
+			for _, byDay := range r.ByDay {
+				days = append(days, weekdaysInMonth(year, month, daysInMonth, byDay, location)...)
+			}
+		case start.Day() <= daysInMonth:
+			days = []int{start.Day()}
+		}
+
+		candidates := make([]time.Time, 0, len(days))
+		for _, day := range days {
+			candidates = append(candidates, at(year, month, day))
+		}
+		return sortedUnique(candidates)
+	}
+
+	return nil
+}
+
+func (r *RecurrenceRule) matchesWeekday(weekday time.Weekday) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// weekdaysInMonth devolve os dias do mês que caem no dia da semana pedido, ou apenas o
+// n-ésimo (contando do fim quando negativo).
+func weekdaysInMonth(year int, month time.Month, daysInMonth int, byDay RecurrenceWeekday, location *time.Location) []int {
+	firstWeekday := time.Date(year, month, 1, 0, 0, 0, 0, location).Weekday()
+	firstDay := 1 + (int(byDay.Weekday)-int(firstWeekday)+7)%7
+
+	var days []int
+	for day := firstDay; day <= daysInMonth; day += 7 {
+		days = append(days, day)
+	}
+
+	switch {
+	case byDay.Ordinal == 0:
+		return days
+	case byDay.Ordinal > 0 && byDay.Ordinal <= len(days):
+		return []int{days[byDay.Ordinal-1]}
+	case byDay.Ordinal < 0 && -byDay.Ordinal <= len(days):
+		return []int{days[len(days)+byDay.Ordinal]}
+	}
+
+	return nil
+}
+
+func sortedUnique(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	unique := times[:0]
+	for i, value := range times {
+		if i == 0 || !value.Equal(times[i-1]) {
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func parsePositive(value string, max int) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 || number > max {
+		return 0, fmt.Errorf("deve ser um número entre 1 e %d", max)
+	}
+	return number, nil
+}
+
+func parseByDay(value string) ([]RecurrenceWeekday, error) {
+	var days []RecurrenceWeekday
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("dia %q inválido", item)
+		}
+
+		weekday, ok := recurrenceWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("dia %q inválido", item)
+		}
+
+		ordinal := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			number, err := strconv.Atoi(strings.TrimPrefix(prefix, "+"))
+			if err != nil || number == 0 || number < -5 || number > 5 {
+				return nil, fmt.Errorf("posição %q inválida", prefix)
+			}
+			ordinal = number
+		}
+
+		days = append(days, RecurrenceWeekday{Ordinal: ordinal, Weekday: weekday})
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		day, err := strconv.Atoi(item)
+		if err != nil || day == 0 || day < -31 || day > 31 {
+			return nil, fmt.Errorf("dia %q inválido", item)
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Run("should parse the supported parts", func(t *testing.T) {
+		// Act
+		rule, err := ParseRecurrenceRule("RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=1MO,-1FR;UNTIL=20301231;WKST=MO")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, RecurrenceMonthly, rule.Frequency)
+		assert.Equal(t, 2, rule.Interval)
+		assert.True(t, rule.UntilDate)
+		assert.Equal(t, []RecurrenceWeekday{{Ordinal: 1, Weekday: time.Monday}, {Ordinal: -1, Weekday: time.Friday}}, rule.ByDay)
+	})
+
+	t.Run("should reject unsupported or inconsistent rules", func(t *testing.T) {
+		rules := []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=YEARLY",
+			"FREQ=WEEKLY;BYHOUR=9",
+			"FREQ=WEEKLY;COUNT=3;UNTIL=20301231",
+			"FREQ=WEEKLY;BYDAY=1MO",
+			"FREQ=WEEKLY;BYMONTHDAY=1",
+			"FREQ=MONTHLY;BYDAY=MO;BYMONTHDAY=1",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=DAILY;FREQ=WEEKLY",
+			"FREQ=DAILY;UNTIL=20301231T090000",
+			"FREQ=MONTHLY;BYMONTHDAY=32",
+			"FREQ=WEEKLY;WKST=SU",
+		}
+
+		for _, value := range rules {
+			// Act
+			rule, err := ParseRecurrenceRule(value)
+
+			// Assert
+			assert.Nil(t, rule, value)
+			assert.ErrorIs(t, err, ErrInvalidRecurrenceRule, value)
+		}
+	})
+}
+
+func TestRecurrenceRule_Between(t *testing.T) {
+	saoPaulo := LoadTimezone("America/Sao_Paulo")
+	newYork := LoadTimezone("America/New_York")
+	farFuture := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expand := func(t *testing.T, value string, start time.Time, limit int) []time.Time {
+		rule, err := ParseRecurrenceRule(value)
+		require.NoError(t, err)
+		return rule.Between(start, start.Add(-time.Second), farFuture, limit)
+	}
+
+	t.Run("should repeat on the listed weekdays every other week", func(t *testing.T) {
+		// Arrange
+		// Quarta-feira, 2 de janeiro de 2030.
+		start := time.Date(2030, 1, 2, 8, 0, 0, 0, saoPaulo)
+
+		// Act
+		occurrences := expand(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4", start, 10)
+
+		// Assert
+		// A segunda-feira anterior ao início não conta como ocorrência.
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 2, 8, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 14, 8, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 16, 8, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 28, 8, 0, 0, 0, saoPaulo),
+		}, occurrences)
+	})
+
+	t.Run("should pick ordinal weekdays and skip months without the day", func(t *testing.T) {
+		// Arrange
+		start := time.Date(2030, 1, 1, 9, 30, 0, 0, saoPaulo)
+
+		// Act
+		lastFriday := expand(t, "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", start, 10)
+		thirtyFirst := expand(t, "FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3", start, 10)
+		lastDay := expand(t, "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=2", start, 10)
+
+		// Assert
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 25, 9, 30, 0, 0, saoPaulo),
+			time.Date(2030, 2, 22, 9, 30, 0, 0, saoPaulo),
+			time.Date(2030, 3, 29, 9, 30, 0, 0, saoPaulo),
+		}, lastFriday)
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 31, 9, 30, 0, 0, saoPaulo),
+			time.Date(2030, 3, 31, 9, 30, 0, 0, saoPaulo),
+			time.Date(2030, 5, 31, 9, 30, 0, 0, saoPaulo),
+		}, thirtyFirst)
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 31, 9, 30, 0, 0, saoPaulo),
+			time.Date(2030, 2, 28, 9, 30, 0, 0, saoPaulo),
+		}, lastDay)
+	})
+
+	t.Run("should keep the local time across daylight saving changes", func(t *testing.T) {
+		// Arrange
+		// O horário de verão de Nova York começa em 10 de março de 2030.
+		start := time.Date(2030, 3, 8, 7, 0, 0, 0, newYork)
+
+		// Act
+		occurrences := expand(t, "FREQ=DAILY;COUNT=4", start, 10)
+
+		// Assert
+		for _, occurrence := range occurrences {
+			assert.Equal(t, 7, occurrence.Hour())
+		}
+		assert.Equal(t, 23*time.Hour, occurrences[2].Sub(occurrences[1]))
+	})
+
+	t.Run("should include the whole UNTIL day and filter daily rules by weekday", func(t *testing.T) {
+		// Arrange
+		// Sexta-feira, 4 de janeiro de 2030.
+		start := time.Date(2030, 1, 4, 18, 0, 0, 0, saoPaulo)
+
+		// Act
+		occurrences := expand(t, "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;UNTIL=20300108", start, 10)
+
+		// Assert
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 4, 18, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 7, 18, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 8, 18, 0, 0, 0, saoPaulo),
+		}, occurrences)
+	})
+
+	t.Run("should only return occurrences inside the window while counting from the start", func(t *testing.T) {
+		// Arrange
+		start := time.Date(2030, 1, 1, 8, 0, 0, 0, saoPaulo)
+		rule, err := ParseRecurrenceRule("FREQ=WEEKLY;COUNT=5")
+		require.NoError(t, err)
+
+		// Act
+		occurrences := rule.Between(start, time.Date(2030, 1, 10, 0, 0, 0, 0, saoPaulo), farFuture, 10)
+		limited := rule.Between(start, start.Add(-time.Second), farFuture, 2)
+
+		// Assert
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 15, 8, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 22, 8, 0, 0, 0, saoPaulo),
+			time.Date(2030, 1, 29, 8, 0, 0, 0, saoPaulo),
+		}, occurrences)
+		assert.Len(t, limited, 2)
+	})
+}
+
+func TestTravelSeries_DueOccurrences(t *testing.T) {
+	t.Run("should resume after the last generated occurrence up to the horizon", func(t *testing.T) {
+		// Arrange
+		start := time.Date(2030, 1, 7, 8, 0, 0, 0, time.UTC)
+		generatedUntil := time.Date(2030, 1, 20, 0, 0, 0, 0, time.UTC)
+		now := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+		series := &TravelSeries{StartsAt: start, Timezone: "UTC", HorizonDays: 21, GeneratedUntil: &generatedUntil}
+		rule, err := ParseRecurrenceRule("FREQ=WEEKLY")
+		require.NoError(t, err)
+
+		// Act
+		occurrences, windowEnd := series.DueOccurrences(rule, now, 10)
+
+		// Assert
+		assert.Equal(t, []time.Time{
+			time.Date(2030, 1, 21, 8, 0, 0, 0, time.UTC),
+			time.Date(2030, 1, 28, 8, 0, 0, 0, time.UTC),
+		}, occurrences)
+		assert.Equal(t, now.AddDate(0, 0, 21), windowEnd)
+	})
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"time"
+)
+
+// LoadTimezone retorna o fuso IANA informado, ou UTC quando vazio ou desconhecido.
+func LoadTimezone(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// DaysBetween conta os dias de calendário entre duas datas, cada uma em seu próprio fuso.
+func DaysBetween(from, to time.Time) int {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(end.Sub(start).Hours() / 24)
+}
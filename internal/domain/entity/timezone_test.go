@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelRequest_LocalDates(t *testing.T) {
+	t.Run("should count nights using each leg's local calendar date", func(t *testing.T) {
+		// Arrange
+		// Sai de São Paulo às 23h do dia 14 (02h UTC do dia 15) e volta de Tóquio às 08h do dia 21.
+		departureDate := time.Date(2030, 5, 15, 2, 0, 0, 0, time.UTC)
+		returnDate := time.Date(2030, 5, 20, 23, 0, 0, 0, time.UTC)
+		travelRequest := &TravelRequest{
+			DepartureDate:     departureDate,
+			ReturnDate:        &returnDate,
+			DepartureTimezone: "America/Sao_Paulo",
+			ReturnTimezone:    "Asia/Tokyo",
+		}
+
+		// Act
+		nights := travelRequest.Nights()
+
+		// Assert
+		assert.Equal(t, 14, travelRequest.LocalDepartureDate().Day())
+		assert.Equal(t, 21, travelRequest.LocalReturnDate().Day())
+		assert.Equal(t, 7, nights)
+	})
+
+	t.Run("should fall back to the departure zone and then to UTC", func(t *testing.T) {
+		// Arrange
+		returnDate := time.Date(2030, 5, 20, 1, 0, 0, 0, time.UTC)
+		withZone := &TravelRequest{DepartureTimezone: "America/Sao_Paulo", ReturnDate: &returnDate}
+		withoutZone := &TravelRequest{DepartureTimezone: "Invalid/Zone", ReturnDate: &returnDate}
+
+		// Act & Assert
+		assert.Equal(t, "America/Sao_Paulo", withZone.LocalReturnDate().Location().String())
+		assert.Equal(t, 19, withZone.LocalReturnDate().Day())
+		assert.Equal(t, time.UTC, withoutZone.LocalReturnDate().Location())
+	})
+}
+
+func TestNewPolicyFacts_AdvanceDaysInDepartureZone(t *testing.T) {
+	t.Run("should measure advance days on the origin calendar", func(t *testing.T) {
+		// Arrange
+		now := time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)
+		travelRequest := &TravelRequest{
+			DepartureDate:     time.Date(2030, 5, 15, 22, 0, 0, 0, time.UTC),
+			DepartureTimezone: "Asia/Tokyo",
+		}
+
+		// Act
+		facts := NewPolicyFacts(travelRequest, now)
+
+		// Assert
+		assert.Equal(t, 15, facts["advance_days"])
+	})
+}
@@ -2,27 +2,52 @@ package entity
 
 import (
 	"challenge-travel-api/internal/domain/enums"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type TravelRequest struct {
-	Id              uuid.UUID                 `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	TravelerName    string                    `json:"traveler_name" gorm:"type:varchar(255);not null"`
-	UserId          uuid.UUID                 `json:"user_id" gorm:"type:uuid;not null"`
-	DestinationName string                    `json:"destination_name" gorm:"type:varchar(255);not null"`
-	DepartureDate   time.Time                 `json:"departure_date" gorm:"type:timestamp;not null"`
-	ReturnDate      *time.Time                `json:"return_date" gorm:"type:timestamp;"`
-	Status          enums.TravelRequestStatus `json:"status" gorm:"type:travel_request_status;not null"`
-	CanceledBy      *uuid.UUID                `json:"canceled_by" gorm:"type:uuid"`
-	ApprovedBy      *uuid.UUID                `json:"approved_by" gorm:"type:uuid"`
-	CreatedAt       time.Time                 `json:"created_at" gorm:"type:timestamp;not null"`
-	UpdatedAt       *time.Time                `json:"updated_at" gorm:"type:timestamp"`
-	CanceledAt      *time.Time                `json:"canceled_at" gorm:"type:timestamp"`
-	ApprovedAt      *time.Time                `json:"approved_at" gorm:"type:timestamp"`
-
-	User User `json:"user" gorm:"foreignkey:user_id"`
+	Id                uuid.UUID                 `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TravelerName      string                    `json:"traveler_name" gorm:"type:varchar(255);not null"`
+	UserId            uuid.UUID                 `json:"user_id" gorm:"type:uuid;not null"`
+	OrganizationId    uuid.UUID                 `json:"organization_id" gorm:"type:uuid;not null"`
+	DestinationName   string                    `json:"destination_name" gorm:"type:varchar(255);not null"`
+	DepartureDate     time.Time                 `json:"departure_date" gorm:"type:timestamptz;not null"`
+	ReturnDate        *time.Time                `json:"return_date" gorm:"type:timestamptz;"`
+	DepartureTimezone string                    `json:"departure_timezone" gorm:"type:varchar(64);not null"`
+	ReturnTimezone    string                    `json:"return_timezone" gorm:"type:varchar(64);not null"`
+	Status            enums.TravelRequestStatus `json:"status" gorm:"type:travel_request_status;not null"`
+	CanceledBy        *uuid.UUID                `json:"canceled_by" gorm:"type:uuid"`
+	ApprovedBy        *uuid.UUID                `json:"approved_by" gorm:"type:uuid"`
+	CreatedAt         time.Time                 `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt         *time.Time                `json:"updated_at" gorm:"type:timestamp"`
+	CanceledAt        *time.Time                `json:"canceled_at" gorm:"type:timestamp"`
+	ApprovedAt        *time.Time                `json:"approved_at" gorm:"type:timestamp"`
+	Department        *string                   `json:"department" gorm:"type:varchar(100)"`
+	Currency          string                    `json:"currency" gorm:"type:varchar(3);not null"`
+	EstimatedTotal    float64                   `json:"estimated_total" gorm:"type:numeric(14,2);not null"`
+	GroupId           *uuid.UUID                `json:"group_id" gorm:"type:uuid"`
+	DestinationId     *uuid.UUID                `json:"destination_id" gorm:"type:uuid"`
+	SeriesId          *uuid.UUID                `json:"series_id" gorm:"type:uuid"`
+	OccurrenceDate    *time.Time                `json:"occurrence_date" gorm:"type:timestamptz"`
+	SeriesException   bool                      `json:"series_exception" gorm:"not null;default:false"`
+	BusinessPurpose   string                    `json:"business_purpose" gorm:"type:text;not null;default:''"`
+	CostCenterId      *uuid.UUID                `json:"cost_center_id" gorm:"type:uuid"`
+
+	// Preenchidos apenas nas listagens com busca textual (parâmetro q).
+	SearchRank      *float64 `json:"search_rank,omitempty" gorm:"->"`
+	SearchHighlight *string  `json:"search_highlight,omitempty" gorm:"->"`
+
+	User             User                    `json:"user" gorm:"foreignkey:user_id"`
+	CostItems        []TravelCostItem        `json:"cost_items" gorm:"foreignKey:TravelRequestId"`
+	PolicyViolations []TravelPolicyViolation `json:"policy_violations" gorm:"foreignKey:TravelRequestId"`
+	Travelers        []Traveler              `json:"travelers" gorm:"many2many:travel_request_travelers;"`
+	Group            *TravelGroup            `json:"group,omitempty" gorm:"foreignKey:GroupId"`
+	Destination      *Destination            `json:"destination,omitempty" gorm:"foreignKey:DestinationId"`
+	CostCenter       *CostCenter             `json:"cost_center,omitempty" gorm:"foreignKey:CostCenterId"`
+	Allocations      []TravelCostAllocation  `json:"allocations,omitempty" gorm:"foreignKey:TravelRequestId"`
 }
 
 func (e *TravelRequest) UpdateTravelRequest(
@@ -70,3 +95,194 @@ func (e *TravelRequest) UpdateTravelRequest(
 	updatedDate := time.Now()
 	e.UpdatedAt = &updatedDate
 }
+
+// CanTransitionTo indica se a decisão pode ser aplicada: somente solicitações pendentes
+// podem ser aprovadas ou canceladas.
+func (e *TravelRequest) CanTransitionTo(status enums.TravelRequestStatus) bool {
+	if e.Status != enums.TravelRequestStatusSolicited {
+		return false
+	}
+
+	return status == enums.TravelRequestStatusApproved || status == enums.TravelRequestStatusCanceled
+}
+
+// IsEditable indica se os dados da solicitação ainda podem ser alterados: rascunhos e
+// solicitações pendentes.
+func (e *TravelRequest) IsEditable() bool {
+	return e.Status == enums.TravelRequestStatusDraft || e.Status == enums.TravelRequestStatusSolicited
+}
+
+// Submit envia o rascunho para aprovação.
+func (e *TravelRequest) Submit() {
+	e.Status = enums.TravelRequestStatusSolicited
+
+	updatedDate := time.Now()
+	e.UpdatedAt = &updatedDate
+}
+
+// SetCostItems substitui os itens de custo, recalcula o total na moeda base e o valor de cada
+// parcela do rateio.
+func (e *TravelRequest) SetCostItems(items []TravelCostItem, baseCurrency string) {
+	total := 0.0
+	for i := range items {
+		items[i].TravelRequestId = e.Id
+		total += items[i].BaseAmount
+	}
+
+	e.CostItems = items
+	e.Currency = baseCurrency
+	e.EstimatedTotal = RoundMoney(total)
+	e.distributeAllocations()
+}
+
+// Nights conta as noites entre a data local de ida e a data local de volta.
+func (e *TravelRequest) Nights() int {
+	returnDate := e.LocalReturnDate()
+	if returnDate == nil {
+		return 0
+	}
+
+	return DaysBetween(e.LocalDepartureDate(), *returnDate)
+}
+
+// LocalDepartureDate é a data de ida no fuso horário da origem.
+func (e *TravelRequest) LocalDepartureDate() time.Time {
+	return e.DepartureDate.In(LoadTimezone(e.DepartureTimezone))
+}
+
+// LocalReturnDate é a data de volta no fuso horário do destino, ou no da origem quando não informado.
+func (e *TravelRequest) LocalReturnDate() *time.Time {
+	if e.ReturnDate == nil {
+		return nil
+	}
+
+	timezone := e.ReturnTimezone
+	if timezone == "" {
+		timezone = e.DepartureTimezone
+	}
+
+	local := e.ReturnDate.In(LoadTimezone(timezone))
+	return &local
+}
+
+func (e *TravelRequest) SetPolicyViolations(violations []TravelPolicyViolation) {
+	for i := range violations {
+		violations[i].TravelRequestId = e.Id
+	}
+
+	e.PolicyViolations = violations
+}
+
+func (e *TravelRequest) BlockingViolations() []TravelPolicyViolation {
+	var blocking []TravelPolicyViolation
+	for _, violation := range e.PolicyViolations {
+		if violation.Severity == enums.PolicySeverityBlock {
+			blocking = append(blocking, violation)
+		}
+	}
+
+	return blocking
+}
+
+// SetTravelers vincula os viajantes e mantém TravelerName com os nomes para exibição.
+func (e *TravelRequest) SetTravelers(travelers []Traveler) {
+	names := make([]string, 0, len(travelers))
+	for _, traveler := range travelers {
+		names = append(names, traveler.Name)
+	}
+
+	e.Travelers = travelers
+	if len(names) > 0 {
+		e.TravelerName = strings.Join(names, ", ")
+	}
+}
+
+func (e *TravelRequest) TravelerIds() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(e.Travelers))
+	for _, traveler := range e.Travelers {
+		ids = append(ids, traveler.Id)
+	}
+
+	return ids
+}
+
+// IsVisibleTo informa se o usuário é o solicitante ou um dos viajantes da solicitação.
+func (e *TravelRequest) IsVisibleTo(userID uuid.UUID) bool {
+	if e.UserId == userID {
+		return true
+	}
+
+	for _, traveler := range e.Travelers {
+		if traveler.IsUser(userID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotificationRecipient é um destinatário das notificações de uma solicitação, com o idioma em
+// que as recebe. UserId identifica destinatários com conta na aplicação, que também recebem as
+// notificações na caixa de entrada.
+type NotificationRecipient struct {
+	Name   string
+	Email  string
+	Locale enums.Locale
+	UserId *uuid.UUID
+}
+
+// NotificationRecipients retorna o solicitante e cada viajante cadastrado, sem e-mails repetidos.
+// Viajantes vinculados a um usuário recebem as notificações no idioma dele (User carregado); os
+// demais, em defaultLocale, o idioma padrão da organização.
+func (e *TravelRequest) NotificationRecipients(defaultLocale enums.Locale) []NotificationRecipient {
+	recipients := make([]NotificationRecipient, 0, len(e.Travelers)+1)
+	seen := make(map[string]bool)
+
+	add := func(name, email string, locale enums.Locale, userID *uuid.UUID) {
+		key := strings.ToLower(strings.TrimSpace(email))
+		if key == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		recipients = append(recipients, NotificationRecipient{Name: name, Email: email, Locale: locale, UserId: userID})
+	}
+
+	requesterID := e.UserId
+	add(e.User.Name, e.User.Email, e.User.Locale, &requesterID)
+	for _, traveler := range e.Travelers {
+		locale := defaultLocale
+		switch {
+		case traveler.User != nil:
+			locale = traveler.User.Locale
+		case traveler.IsUser(e.UserId):
+			locale = e.User.Locale
+		}
+		add(traveler.Name, traveler.Email, locale, traveler.UserId)
+	}
+
+	return recipients
+}
+
+// SetDestination vincula a solicitação a uma entrada do catálogo e usa o nome canônico.
+func (e *TravelRequest) SetDestination(destination *Destination) {
+	if destination == nil {
+		e.DestinationId = nil
+		e.Destination = nil
+		return
+	}
+
+	e.DestinationId = &destination.Id
+	e.Destination = destination
+	e.DestinationName = destination.DisplayName()
+}
+
+// TravelRequestPage é uma página de solicitações. Na paginação por deslocamento, Total traz o
+// número de registros que atendem aos filtros; na paginação por cursor, Page e Total são omitidos.
+// NextCursor, quando presente, continua a listagem após o último item.
+type TravelRequestPage struct {
+	Items      []TravelRequest `json:"items"`
+	Page       int             `json:"page,omitempty"`
+	PageSize   int             `json:"page_size"`
+	Total      *int64          `json:"total,omitempty"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
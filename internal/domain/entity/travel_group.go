@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TravelGroup reúne as solicitações de vários viajantes que compartilham o mesmo roteiro.
+// Cada viajante possui a sua própria solicitação (Members) e, portanto, o seu próprio status.
+type TravelGroup struct {
+	Id                uuid.UUID               `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId    uuid.UUID               `json:"organization_id" gorm:"type:uuid;not null"`
+	Name              string                  `json:"name" gorm:"type:varchar(255);not null"`
+	UserId            uuid.UUID               `json:"user_id" gorm:"type:uuid;not null"`
+	ApprovalMode      enums.GroupApprovalMode `json:"approval_mode" gorm:"type:travel_group_approval_mode;not null"`
+	DestinationName   string                  `json:"destination_name" gorm:"type:varchar(255);not null"`
+	DestinationId     *uuid.UUID              `json:"destination_id" gorm:"type:uuid"`
+	DepartureDate     time.Time               `json:"departure_date" gorm:"type:timestamptz;not null"`
+	ReturnDate        *time.Time              `json:"return_date" gorm:"type:timestamptz"`
+	DepartureTimezone string                  `json:"departure_timezone" gorm:"type:varchar(64);not null"`
+	ReturnTimezone    string                  `json:"return_timezone" gorm:"type:varchar(64);not null"`
+	CreatedAt         time.Time               `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt         *time.Time              `json:"updated_at" gorm:"type:timestamp"`
+
+	Members      []TravelRequest                   `json:"members,omitempty" gorm:"foreignKey:GroupId"`
+	StatusCounts map[enums.TravelRequestStatus]int `json:"status_counts,omitempty" gorm:"-"`
+}
+
+// Summarize contabiliza quantos membros estão em cada status.
+func (e *TravelGroup) Summarize() {
+	e.StatusCounts = make(map[enums.TravelRequestStatus]int)
+	for _, member := range e.Members {
+		e.StatusCounts[member.Status]++
+	}
+}
+
+// PendingMembers retorna ponteiros para os membros que ainda aguardam decisão.
+func (e *TravelGroup) PendingMembers() []*TravelRequest {
+	pending := make([]*TravelRequest, 0, len(e.Members))
+	for i := range e.Members {
+		if e.Members[i].Status == enums.TravelRequestStatusSolicited {
+			pending = append(pending, &e.Members[i])
+		}
+	}
+
+	return pending
+}
+
+// IsVisibleTo informa se o usuário criou o grupo ou viaja em algum dos membros.
+func (e *TravelGroup) IsVisibleTo(userID uuid.UUID) bool {
+	if e.UserId == userID {
+		return true
+	}
+
+	for i := range e.Members {
+		if e.Members[i].IsVisibleTo(userID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *TravelGroup) ApprovesAsUnit() bool {
+	return e.ApprovalMode == enums.GroupApprovalModeUnit
+}
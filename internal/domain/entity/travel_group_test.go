@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTravelGroup_Summarize(t *testing.T) {
+	t.Run("should count members by status and expose only pending ones", func(t *testing.T) {
+		// Arrange
+		group := &TravelGroup{
+			Id: uuid.New(),
+			Members: []TravelRequest{
+				{Id: uuid.New(), Status: enums.TravelRequestStatusApproved},
+				{Id: uuid.New(), Status: enums.TravelRequestStatusSolicited},
+				{Id: uuid.New(), Status: enums.TravelRequestStatusSolicited},
+			},
+		}
+
+		// Act
+		group.Summarize()
+		pending := group.PendingMembers()
+
+		// Assert
+		assert.Equal(t, 1, group.StatusCounts[enums.TravelRequestStatusApproved])
+		assert.Equal(t, 2, group.StatusCounts[enums.TravelRequestStatusSolicited])
+		assert.Len(t, pending, 2)
+		assert.Same(t, &group.Members[1], pending[0])
+	})
+}
+
+func TestTravelRequest_NotificationRecipients(t *testing.T) {
+	t.Run("should include requester and travelers without repeating e-mails", func(t *testing.T) {
+		// Arrange
+		requesterID := uuid.New()
+		brunoID := uuid.New()
+		travelRequest := &TravelRequest{
+			UserId: requesterID,
+			User:   User{Name: "Ana", Email: "ana@example.com", Locale: enums.LocalePtBR},
+			Travelers: []Traveler{
+				{Name: "Ana", Email: "ANA@example.com"},
+				{Name: "Bruno", Email: "bruno@example.com", UserId: &brunoID, User: &User{Id: brunoID, Locale: enums.LocaleEnUS}},
+				{Name: "Carla", Email: "carla@example.com"},
+			},
+		}
+
+		// Act
+		recipients := travelRequest.NotificationRecipients(enums.LocaleEs)
+
+		// Assert
+		assert.Equal(t, []NotificationRecipient{
+			{Name: "Ana", Email: "ana@example.com", Locale: enums.LocalePtBR, UserId: &requesterID},
+			{Name: "Bruno", Email: "bruno@example.com", Locale: enums.LocaleEnUS, UserId: &brunoID},
+			{Name: "Carla", Email: "carla@example.com", Locale: enums.LocaleEs},
+		}, recipients)
+	})
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TravelSeries gera solicitações a partir de um modelo seguindo uma regra de recorrência.
+// As ocorrências são criadas com antecedência de HorizonDays; GeneratedUntil marca até onde
+// a regra já foi expandida.
+type TravelSeries struct {
+	Id             uuid.UUID                `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID                `json:"organization_id" gorm:"type:uuid;not null"`
+	UserId         uuid.UUID                `json:"user_id" gorm:"type:uuid;not null"`
+	TemplateId     uuid.UUID                `json:"template_id" gorm:"type:uuid;not null"`
+	RRule          string                   `json:"rrule" gorm:"column:rrule;type:varchar(255);not null"`
+	StartsAt       time.Time                `json:"starts_at" gorm:"type:timestamptz;not null"`
+	Timezone       string                   `json:"timezone" gorm:"type:varchar(64);not null"`
+	HorizonDays    int                      `json:"horizon_days" gorm:"type:integer;not null"`
+	GeneratedUntil *time.Time               `json:"generated_until" gorm:"type:timestamptz"`
+	Status         enums.TravelSeriesStatus `json:"status" gorm:"type:travel_series_status;not null"`
+	CreatedAt      time.Time                `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt      *time.Time               `json:"updated_at" gorm:"type:timestamp"`
+
+	Template    *TravelTemplate `json:"template,omitempty" gorm:"foreignKey:TemplateId"`
+	Occurrences []TravelRequest `json:"occurrences,omitempty" gorm:"foreignKey:SeriesId"`
+}
+
+func (s *TravelSeries) IsActive() bool {
+	return s.Status == enums.TravelSeriesStatusActive
+}
+
+// LocalStart devolve o início da série no seu fuso, referência do horário das ocorrências.
+func (s *TravelSeries) LocalStart() time.Time {
+	return s.StartsAt.In(LoadTimezone(s.Timezone))
+}
+
+// DueOccurrences expande a regra entre o ponto já gerado (ou now, se posterior) e now somado
+// ao horizonte da série, devolvendo também o fim da janela considerada.
+func (s *TravelSeries) DueOccurrences(rule *RecurrenceRule, now time.Time, limit int) ([]time.Time, time.Time) {
+	from := now
+	if s.GeneratedUntil != nil && s.GeneratedUntil.After(now) {
+		from = *s.GeneratedUntil
+	}
+
+	to := now.AddDate(0, 0, s.HorizonDays)
+	if !to.After(from) {
+		return nil, from
+	}
+
+	return rule.Between(s.LocalStart(), from, to, limit), to
+}
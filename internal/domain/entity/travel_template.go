@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TravelTemplate guarda um roteiro recorrente do usuário (destino, viajantes, duração e custos)
+// para gerar novas solicitações informando apenas a data de ida.
+type TravelTemplate struct {
+	Id                uuid.UUID  `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId    uuid.UUID  `json:"organization_id" gorm:"type:uuid;not null"`
+	UserId            uuid.UUID  `json:"user_id" gorm:"type:uuid;not null"`
+	Name              string     `json:"name" gorm:"type:varchar(120);not null"`
+	DestinationName   string     `json:"destination_name" gorm:"type:varchar(255);not null"`
+	DestinationId     *uuid.UUID `json:"destination_id" gorm:"type:uuid"`
+	TravelerName      string     `json:"traveler_name" gorm:"type:varchar(255);not null"`
+	DurationDays      int        `json:"duration_days" gorm:"type:integer;not null"`
+	Department        *string    `json:"department" gorm:"type:varchar(100)"`
+	DepartureTimezone string     `json:"departure_timezone" gorm:"type:varchar(64);not null"`
+	BusinessPurpose   string     `json:"business_purpose" gorm:"type:text;not null;default:''"`
+	CostCenterId      *uuid.UUID `json:"cost_center_id" gorm:"type:uuid"`
+	CreatedAt         time.Time  `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt         *time.Time `json:"updated_at" gorm:"type:timestamp"`
+
+	Travelers []Traveler               `json:"travelers" gorm:"many2many:travel_template_travelers;"`
+	CostItems []TravelTemplateCostItem `json:"cost_items" gorm:"foreignKey:TemplateId"`
+}
+
+type TravelTemplateCostItem struct {
+	Id          uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	TemplateId  uuid.UUID          `json:"template_id" gorm:"type:uuid;not null"`
+	Category    enums.CostCategory `json:"category" gorm:"type:travel_cost_category;not null"`
+	Description string             `json:"description" gorm:"type:varchar(255)"`
+	Amount      float64            `json:"amount" gorm:"type:numeric(14,2);not null"`
+	Currency    string             `json:"currency" gorm:"type:char(3);not null"`
+}
+
+func (t *TravelTemplate) TravelerIds() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t.Travelers))
+	for _, traveler := range t.Travelers {
+		ids = append(ids, traveler.Id)
+	}
+	return ids
+}
+
+// ReturnDateFor calcula a volta somando a duração à data local de ida, para que o horário
+// de saída se mantenha mesmo quando há mudança de horário de verão no período.
+func (t *TravelTemplate) ReturnDateFor(departureDate time.Time, timezone string) *time.Time {
+	if t.DurationDays <= 0 {
+		return nil
+	}
+
+	returnDate := departureDate.In(LoadTimezone(timezone)).AddDate(0, 0, t.DurationDays)
+	return &returnDate
+}
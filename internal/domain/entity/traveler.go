@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Traveler é a pessoa que efetivamente viaja, que pode ou não possuir um usuário no sistema.
+type Traveler struct {
+	Id                uuid.UUID          `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId    uuid.UUID          `json:"organization_id" gorm:"type:uuid;not null"`
+	Name              string             `json:"name" gorm:"type:varchar(255);not null"`
+	Email             string             `json:"email" gorm:"type:varchar(255);not null"`
+	EmployeeId        *string            `json:"employee_id" gorm:"type:varchar(50)"`
+	UserId            *uuid.UUID         `json:"user_id" gorm:"type:uuid"`
+	Nationality       *string            `json:"nationality" gorm:"type:char(2)"`
+	PassportNumber    *string            `json:"passport_number" gorm:"type:varchar(50)"`
+	PassportCountry   *string            `json:"passport_country" gorm:"type:char(2)"`
+	PassportExpiresAt *time.Time         `json:"passport_expires_at" gorm:"type:date"`
+	CreatedBy         uuid.UUID          `json:"created_by" gorm:"type:uuid;not null"`
+	CreatedAt         time.Time          `json:"created_at" gorm:"type:timestamp;not null"`
+	UpdatedAt         *time.Time         `json:"updated_at" gorm:"type:timestamp"`
+	Delegates         []TravelerDelegate `json:"delegates" gorm:"foreignKey:TravelerId"`
+	User              *User              `json:"-" gorm:"foreignKey:UserId"`
+}
+
+// TravelerDelegate autoriza um usuário a solicitar viagens em nome do viajante.
+type TravelerDelegate struct {
+	TravelerId uuid.UUID `json:"traveler_id" gorm:"type:uuid;primary_key"`
+	UserId     uuid.UUID `json:"user_id" gorm:"type:uuid;primary_key"`
+	CreatedAt  time.Time `json:"created_at" gorm:"type:timestamp;not null"`
+}
+
+// CanBeManagedBy informa se o usuário pode alterar o perfil ou solicitar viagens para o viajante.
+func (e *Traveler) CanBeManagedBy(user *User) bool {
+	if user.Role == enums.UserTypeAdmin || e.CreatedBy == user.Id || e.IsUser(user.Id) {
+		return true
+	}
+
+	for _, delegate := range e.Delegates {
+		if delegate.UserId == user.Id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Traveler) IsUser(userID uuid.UUID) bool {
+	return e.UserId != nil && *e.UserId == userID
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraveler_CanBeManagedBy(t *testing.T) {
+	creator := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+	linked := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+	delegate := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+	admin := &User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+	stranger := &User{Id: uuid.New(), Role: enums.UserTypeCommon}
+
+	traveler := &Traveler{
+		Id:        uuid.New(),
+		CreatedBy: creator.Id,
+		UserId:    &linked.Id,
+	}
+	traveler.Delegates = []TravelerDelegate{{TravelerId: traveler.Id, UserId: delegate.Id}}
+
+	t.Run("should allow creator, linked user, delegates and admins", func(t *testing.T) {
+		for _, user := range []*User{creator, linked, delegate, admin} {
+			assert.True(t, traveler.CanBeManagedBy(user))
+		}
+	})
+
+	t.Run("should deny other users", func(t *testing.T) {
+		assert.False(t, traveler.CanBeManagedBy(stranger))
+	})
+}
+
+func TestTravelRequest_SetTravelers(t *testing.T) {
+	t.Run("should join traveler names and expose visibility to linked users", func(t *testing.T) {
+		// Arrange
+		linkedUserID := uuid.New()
+		travelRequest := &TravelRequest{UserId: uuid.New(), TravelerName: "Antigo"}
+		travelers := []Traveler{
+			{Id: uuid.New(), Name: "Maria Souza", UserId: &linkedUserID},
+			{Id: uuid.New(), Name: "João Lima"},
+		}
+
+		// Act
+		travelRequest.SetTravelers(travelers)
+
+		// Assert
+		assert.Equal(t, "Maria Souza, João Lima", travelRequest.TravelerName)
+		assert.Equal(t, []uuid.UUID{travelers[0].Id, travelers[1].Id}, travelRequest.TravelerIds())
+		assert.True(t, travelRequest.IsVisibleTo(travelRequest.UserId))
+		assert.True(t, travelRequest.IsVisibleTo(linkedUserID))
+		assert.False(t, travelRequest.IsVisibleTo(uuid.New()))
+	})
+
+	t.Run("should keep the free-text name when no travelers are given", func(t *testing.T) {
+		// Arrange
+		travelRequest := &TravelRequest{TravelerName: "John Doe"}
+
+		// Act
+		travelRequest.SetTravelers(nil)
+
+		// Assert
+		assert.Equal(t, "John Doe", travelRequest.TravelerName)
+		assert.Empty(t, travelRequest.TravelerIds())
+	})
+}
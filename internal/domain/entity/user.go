@@ -7,14 +7,21 @@ import (
 	"github.com/google/uuid"
 )
 
+// User é a conta de acesso à API, vinculada a uma única organização. DepartmentId e ManagerId
+// posicionam o usuário na hierarquia usada para encaminhar aprovações; Locale define o idioma das
+// notificações que ele recebe.
 type User struct {
-	Id        uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	Name      string         `json:"name" gorm:"type:varchar(255);not null"`
-	Email     string         `json:"email" gorm:"type:varchar(255);not null;unique_index"`
-	Password  string         `json:"password" gorm:"type:varchar(255);not null"`
-	CreatedAt time.Time      `json:"created_at" gorm:"type:timestamp;not null"`
-	IsActive  bool           `json:"is_active" gorm:"type:boolean;not null;default:true"`
-	Role      enums.UserType `json:"role" gorm:"type:user_type;not null"`
-	UpdatedAt *time.Time     `json:"updated_at" gorm:"type:timestamp"`
-	DeletedAt *time.Time     `json:"deleted_at" gorm:"type:timestamp"`
+	Id             uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name           string         `json:"name" gorm:"type:varchar(255);not null"`
+	Email          string         `json:"email" gorm:"type:varchar(255);not null;unique_index"`
+	Password       string         `json:"password" gorm:"type:varchar(255);not null"`
+	CreatedAt      time.Time      `json:"created_at" gorm:"type:timestamp;not null"`
+	IsActive       bool           `json:"is_active" gorm:"type:boolean;not null;default:true"`
+	OrganizationId uuid.UUID      `json:"organization_id" gorm:"type:uuid;not null"`
+	Role           enums.UserType `json:"role" gorm:"type:user_type;not null"`
+	Locale         enums.Locale   `json:"locale" gorm:"type:varchar(10);not null;default:'pt-BR'"`
+	DepartmentId   *uuid.UUID     `json:"department_id,omitempty" gorm:"type:uuid"`
+	ManagerId      *uuid.UUID     `json:"manager_id,omitempty" gorm:"type:uuid"`
+	UpdatedAt      *time.Time     `json:"updated_at" gorm:"type:timestamp"`
+	DeletedAt      *time.Time     `json:"deleted_at" gorm:"type:timestamp"`
 }
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// WebhookSubscription envia os eventos escolhidos da organização para uma URL externa. O segredo
+// assina as entregas e só é exibido na criação da assinatura.
+type WebhookSubscription struct {
+	Id             uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID      `json:"organization_id" gorm:"type:uuid;not null"`
+	Url            string         `json:"url" gorm:"type:varchar(2048);not null"`
+	Secret         string         `json:"-" gorm:"type:varchar(255);not null"`
+	Events         pq.StringArray `json:"events" gorm:"type:text[];not null" swaggertype:"array,string"`
+	Active         bool           `json:"active" gorm:"not null;default:true"`
+	CreatedBy      uuid.UUID      `json:"created_by" gorm:"type:uuid;not null"`
+	CreatedAt      time.Time      `json:"created_at" gorm:"type:timestamptz;not null"`
+	UpdatedAt      *time.Time     `json:"updated_at" gorm:"type:timestamptz"`
+}
+
+// Subscribes informa se a assinatura está ativa e recebe o evento.
+func (s *WebhookSubscription) Subscribes(event enums.WebhookEvent) bool {
+	if !s.Active {
+		return false
+	}
+
+	for _, subscribed := range s.Events {
+		if subscribed == string(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsWebhookAddressAllowed informa se o endereço pode receber entregas. Loopback, redes privadas,
+// link-local (o que inclui o serviço de metadados em 169.254.169.254), multicast e o endereço
+// não especificado ficam de fora, para que uma assinatura não alcance a rede interna.
+func IsWebhookAddressAllowed(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// WebhookDelivery é o envio de um evento a uma assinatura. O mesmo registro acompanha todas as
+// tentativas e reenvios, e seu ID identifica o evento para o destinatário descartar repetições.
+type WebhookDelivery struct {
+	Id             uuid.UUID                   `json:"id" gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrganizationId uuid.UUID                   `json:"organization_id" gorm:"type:uuid;not null"`
+	SubscriptionId uuid.UUID                   `json:"subscription_id" gorm:"type:uuid;not null"`
+	Event          enums.WebhookEvent          `json:"event" gorm:"type:varchar(50);not null"`
+	Payload        json.RawMessage             `json:"payload" gorm:"type:jsonb;not null" swaggertype:"object"`
+	Status         enums.WebhookDeliveryStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Attempts       int                         `json:"attempts" gorm:"not null;default:0"`
+	ResponseStatus *int                        `json:"response_status,omitempty"`
+	LastError      *string                     `json:"last_error,omitempty" gorm:"type:text"`
+	LastAttemptAt  *time.Time                  `json:"last_attempt_at,omitempty" gorm:"type:timestamptz"`
+	DeliveredAt    *time.Time                  `json:"delivered_at,omitempty" gorm:"type:timestamptz"`
+	CreatedAt      time.Time                   `json:"created_at" gorm:"type:timestamptz;not null"`
+	UpdatedAt      *time.Time                  `json:"updated_at" gorm:"type:timestamptz"`
+
+	Subscription *WebhookSubscription `json:"-" gorm:"foreignKey:SubscriptionId"`
+}
+
+// RecordAttempt registra o resultado de uma tentativa; responseStatus é zero quando não houve
+// resposta do destinatário.
+func (d *WebhookDelivery) RecordAttempt(responseStatus int, cause error, now time.Time) {
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.UpdatedAt = &now
+
+	d.ResponseStatus = nil
+	if responseStatus != 0 {
+		d.ResponseStatus = &responseStatus
+	}
+
+	if cause != nil {
+		message := cause.Error()
+		d.Status = enums.WebhookDeliveryStatusFailed
+		d.LastError = &message
+		return
+	}
+
+	d.Status = enums.WebhookDeliveryStatusDelivered
+	d.LastError = nil
+	d.DeliveredAt = &now
+}
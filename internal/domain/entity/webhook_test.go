@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSubscription_Subscribes(t *testing.T) {
+	subscription := WebhookSubscription{Active: true, Events: pq.StringArray{"travel.created", "travel.approved"}}
+
+	assert.True(t, subscription.Subscribes(enums.WebhookEventTravelApproved))
+	assert.False(t, subscription.Subscribes(enums.WebhookEventTravelCanceled))
+
+	subscription.Active = false
+	assert.False(t, subscription.Subscribes(enums.WebhookEventTravelApproved))
+}
+
+func TestIsWebhookAddressAllowed(t *testing.T) {
+	for _, address := range []string{"127.0.0.1", "::1", "10.1.2.3", "172.16.0.10", "192.168.0.1", "169.254.169.254", "fe80::1", "fd00::1", "0.0.0.0", "::ffff:127.0.0.1", "224.0.0.1"} {
+		assert.False(t, IsWebhookAddressAllowed(net.ParseIP(address)), address)
+	}
+
+	for _, address := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		assert.True(t, IsWebhookAddressAllowed(net.ParseIP(address)), address)
+	}
+}
+
+func TestWebhookDelivery_RecordAttempt(t *testing.T) {
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should keep the last failure until a delivery succeeds", func(t *testing.T) {
+		delivery := &WebhookDelivery{Status: enums.WebhookDeliveryStatusPending}
+
+		delivery.RecordAttempt(0, errors.New("conexão recusada"), now)
+		assert.Equal(t, enums.WebhookDeliveryStatusFailed, delivery.Status)
+		assert.Nil(t, delivery.ResponseStatus)
+		assert.Equal(t, "conexão recusada", *delivery.LastError)
+
+		later := now.Add(time.Minute)
+		delivery.RecordAttempt(200, nil, later)
+		assert.Equal(t, enums.WebhookDeliveryStatusDelivered, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, 200, *delivery.ResponseStatus)
+		assert.Nil(t, delivery.LastError)
+		assert.Equal(t, later, *delivery.DeliveredAt)
+		assert.Equal(t, later, *delivery.LastAttemptAt)
+	})
+}
@@ -0,0 +1,34 @@
+package enums
+
+type CostCategory string
+
+const (
+	CostCategoryAirfare         CostCategory = "AIRFARE"
+	CostCategoryLodging         CostCategory = "LODGING"
+	CostCategoryPerDiem         CostCategory = "PER_DIEM"
+	CostCategoryGroundTransport CostCategory = "GROUND_TRANSPORT"
+)
+
+func (c CostCategory) IsValid() bool {
+	switch c {
+	case CostCategoryAirfare, CostCategoryLodging, CostCategoryPerDiem, CostCategoryGroundTransport:
+		return true
+	}
+	return false
+}
+
+// CostCenterKind distingue centros de custo permanentes de projetos com orçamento próprio.
+type CostCenterKind string
+
+const (
+	CostCenterKindCostCenter CostCenterKind = "COST_CENTER"
+	CostCenterKindProject    CostCenterKind = "PROJECT"
+)
+
+func (k CostCenterKind) IsValid() bool {
+	switch k {
+	case CostCenterKindCostCenter, CostCenterKindProject:
+		return true
+	}
+	return false
+}
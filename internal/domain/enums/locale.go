@@ -0,0 +1,53 @@
+package enums
+
+import "strings"
+
+// Locale é o idioma e a região usados nas notificações enviadas ao usuário.
+type Locale string
+
+const (
+	LocalePtBR Locale = "pt-BR"
+	LocaleEnUS Locale = "en-US"
+	LocaleEs   Locale = "es"
+
+	DefaultLocale = LocalePtBR
+)
+
+var Locales = []Locale{LocalePtBR, LocaleEnUS, LocaleEs}
+
+func (l Locale) IsValid() bool {
+	for _, locale := range Locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseLocale aceita as tags suportadas sem diferenciar maiúsculas, com "_" ou "-", e as
+// variantes regionais do mesmo idioma ("pt-PT", "en-GB", "es-MX"), que usam a tag suportada
+// desse idioma.
+func ParseLocale(value string) (Locale, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(value), "_", "-"))
+	if tag == "" {
+		return "", false
+	}
+
+	for _, locale := range Locales {
+		if tag == strings.ToLower(string(locale)) {
+			return locale, true
+		}
+	}
+
+	language, _, _ := strings.Cut(tag, "-")
+	switch language {
+	case "pt":
+		return LocalePtBR, true
+	case "en":
+		return LocaleEnUS, true
+	case "es":
+		return LocaleEs, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,20 @@
+package enums
+
+// NotificationType é o assunto de uma notificação; as preferências do usuário são definidas por
+// tipo.
+type NotificationType string
+
+const (
+	NotificationTypeStatusChange NotificationType = "STATUS_CHANGE"
+	NotificationTypeComment      NotificationType = "COMMENT"
+)
+
+var NotificationTypes = []NotificationType{NotificationTypeStatusChange, NotificationTypeComment}
+
+func (t NotificationType) IsValid() bool {
+	switch t {
+	case NotificationTypeStatusChange, NotificationTypeComment:
+		return true
+	}
+	return false
+}
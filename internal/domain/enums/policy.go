@@ -0,0 +1,36 @@
+package enums
+
+type PolicySeverity string
+
+const (
+	PolicySeverityBlock PolicySeverity = "BLOCK"
+	PolicySeverityWarn  PolicySeverity = "WARN"
+)
+
+func (s PolicySeverity) IsValid() bool {
+	return s == PolicySeverityBlock || s == PolicySeverityWarn
+}
+
+type PolicyOperator string
+
+const (
+	PolicyOperatorLessThan           PolicyOperator = "LT"
+	PolicyOperatorLessThanOrEqual    PolicyOperator = "LTE"
+	PolicyOperatorGreaterThan        PolicyOperator = "GT"
+	PolicyOperatorGreaterThanOrEqual PolicyOperator = "GTE"
+	PolicyOperatorEqual              PolicyOperator = "EQ"
+	PolicyOperatorNotEqual           PolicyOperator = "NEQ"
+	PolicyOperatorIn                 PolicyOperator = "IN"
+	PolicyOperatorNotIn              PolicyOperator = "NOT_IN"
+)
+
+type PolicyField string
+
+const (
+	PolicyFieldAdvanceDays        PolicyField = "advance_days"
+	PolicyFieldNights             PolicyField = "nights"
+	PolicyFieldDestinationName    PolicyField = "destination_name"
+	PolicyFieldDepartment         PolicyField = "department"
+	PolicyFieldEstimatedTotal     PolicyField = "estimated_total"
+	PolicyFieldDestinationCountry PolicyField = "destination_country"
+)
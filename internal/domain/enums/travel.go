@@ -3,7 +3,30 @@ package enums
 type TravelRequestStatus string
 
 const (
+	// TravelRequestStatusDraft é uma solicitação ainda não enviada, como a cópia de outra
+	// solicitação: não vai para aprovação nem gera eventos até ser confirmada.
+	TravelRequestStatusDraft     TravelRequestStatus = "DRAFT"
 	TravelRequestStatusSolicited TravelRequestStatus = "SOLICITED"
 	TravelRequestStatusApproved  TravelRequestStatus = "APPROVED"
 	TravelRequestStatusCanceled  TravelRequestStatus = "CANCELED"
 )
+
+// TravelRequestSortField é um campo aceito na ordenação das listagens de solicitações.
+type TravelRequestSortField string
+
+const (
+	TravelRequestSortDepartureDate TravelRequestSortField = "departure_date"
+	TravelRequestSortCreatedAt     TravelRequestSortField = "created_at"
+	TravelRequestSortStatus        TravelRequestSortField = "status"
+	TravelRequestSortDestination   TravelRequestSortField = "destination"
+	// TravelRequestSortRelevance ordena pela relevância da busca textual (parâmetro q).
+	TravelRequestSortRelevance TravelRequestSortField = "relevance"
+)
+
+func (f TravelRequestSortField) IsValid() bool {
+	switch f {
+	case TravelRequestSortDepartureDate, TravelRequestSortCreatedAt, TravelRequestSortStatus, TravelRequestSortDestination, TravelRequestSortRelevance:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package enums
+
+type GroupApprovalMode string
+
+const (
+	GroupApprovalModeUnit        GroupApprovalMode = "UNIT"
+	GroupApprovalModePerTraveler GroupApprovalMode = "PER_TRAVELER"
+)
+
+func (m GroupApprovalMode) IsValid() bool {
+	switch m {
+	case GroupApprovalModeUnit, GroupApprovalModePerTraveler:
+		return true
+	}
+	return false
+}
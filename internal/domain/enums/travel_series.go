@@ -0,0 +1,8 @@
+package enums
+
+type TravelSeriesStatus string
+
+const (
+	TravelSeriesStatusActive   TravelSeriesStatus = "ACTIVE"
+	TravelSeriesStatusCanceled TravelSeriesStatus = "CANCELED"
+)
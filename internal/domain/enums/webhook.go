@@ -0,0 +1,28 @@
+package enums
+
+// WebhookEvent é um evento de solicitação de viagem enviado às assinaturas de webhook.
+type WebhookEvent string
+
+const (
+	WebhookEventTravelCreated  WebhookEvent = "travel.created"
+	WebhookEventTravelApproved WebhookEvent = "travel.approved"
+	WebhookEventTravelCanceled WebhookEvent = "travel.canceled"
+)
+
+var WebhookEvents = []WebhookEvent{WebhookEventTravelCreated, WebhookEventTravelApproved, WebhookEventTravelCanceled}
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventTravelCreated, WebhookEventTravelApproved, WebhookEventTravelCanceled:
+		return true
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
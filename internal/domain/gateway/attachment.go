@@ -0,0 +1,24 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentGateway interface {
+	Create(ctx context.Context, attachment *entity.TravelRequestAttachment) error
+	FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestAttachment, error)
+	ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID) ([]entity.TravelRequestAttachment, error)
+	Delete(ctx context.Context, attachment *entity.TravelRequestAttachment) error
+}
+
+// FileStorage guarda o conteúdo dos anexos. As implementações ficam em
+// internal/infrastructure/storage (sistema de arquivos local e compatível com S3).
+type FileStorage interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CommentGateway interface {
+	Create(ctx context.Context, comment *entity.TravelRequestComment) error
+	FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestComment, error)
+	ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID, includeInternal bool) ([]entity.TravelRequestComment, error)
+	Update(ctx context.Context, comment *entity.TravelRequestComment) error
+	Delete(ctx context.Context, comment *entity.TravelRequestComment) error
+}
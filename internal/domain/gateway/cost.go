@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ExchangeRateGateway interface {
+	FindByCurrency(ctx context.Context, currency string) (*entity.ExchangeRate, error)
+	List(ctx context.Context) ([]entity.ExchangeRate, error)
+	Save(ctx context.Context, rate *entity.ExchangeRate) error
+}
+
+type DepartmentBudgetGateway interface {
+	FindByDepartmentAndYear(ctx context.Context, department string, year int) (*entity.DepartmentBudget, error)
+	List(ctx context.Context, year *int) ([]entity.DepartmentBudget, error)
+	Save(ctx context.Context, budget *entity.DepartmentBudget) error
+}
+
+type CostCenterGateway interface {
+	Create(ctx context.Context, costCenter *entity.CostCenter) error
+	Update(ctx context.Context, costCenter *entity.CostCenter) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.CostCenter, error)
+	FindByCode(ctx context.Context, code string) (*entity.CostCenter, error)
+	List(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error)
+}
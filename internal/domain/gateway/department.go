@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type DepartmentGateway interface {
+	Create(ctx context.Context, department *entity.Department) error
+	Update(ctx context.Context, department *entity.Department) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error)
+	FindByCode(ctx context.Context, code string) (*entity.Department, error)
+	List(ctx context.Context, includeInactive bool) ([]entity.Department, error)
+}
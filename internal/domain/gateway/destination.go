@@ -0,0 +1,14 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type DestinationGateway interface {
+	Search(ctx context.Context, query string, limit int) ([]entity.Destination, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Destination, error)
+	UpsertMany(ctx context.Context, destinations []entity.Destination) error
+}
@@ -0,0 +1,12 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+)
+
+// Mailer entrega os e-mails de notificação. As implementações ficam em
+// internal/infrastructure/mail (SMTP e registro em log para desenvolvimento).
+type Mailer interface {
+	Send(ctx context.Context, message entity.EmailMessage) error
+}
@@ -0,0 +1,24 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationGateway guarda a caixa de entrada de cada usuário. As consultas e alterações são
+// sempre restritas às notificações do usuário informado.
+type NotificationGateway interface {
+	Create(ctx context.Context, notifications []entity.Notification) error
+	ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page int, pageSize int) ([]entity.Notification, int64, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, readAt time.Time) (bool, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error
+}
+
+type NotificationPreferenceGateway interface {
+	ListByUsers(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error)
+	Save(ctx context.Context, preferences []entity.NotificationPreference) error
+}
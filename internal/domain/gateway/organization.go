@@ -0,0 +1,15 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationGateway interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+	FindByCode(ctx context.Context, code string) (*entity.Organization, error)
+	ListActive(ctx context.Context) ([]entity.Organization, error)
+	Update(ctx context.Context, organization *entity.Organization) error
+}
@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxGateway interface {
+	Enqueue(ctx context.Context, messages []entity.OutboxMessage) error
+	ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]entity.OutboxMessage, error)
+	MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error
+	MarkFailed(ctx context.Context, message *entity.OutboxMessage) error
+	List(ctx context.Context, status enums.OutboxStatus, limit int) ([]entity.OutboxMessage, error)
+	Requeue(ctx context.Context, id uuid.UUID, now time.Time) (bool, error)
+}
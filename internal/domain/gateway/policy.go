@@ -0,0 +1,10 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+)
+
+type PolicyRuleGateway interface {
+	ListActive(ctx context.Context) ([]entity.TravelPolicyRule, error)
+}
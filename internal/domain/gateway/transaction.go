@@ -0,0 +1,10 @@
+package gateway
+
+import "context"
+
+// Transactor executa fn em uma transação do banco. Os repositórios chamados com o contexto
+// recebido por fn participam dela; chamadas aninhadas usam um savepoint, de forma que o erro
+// de uma etapa desfaz apenas essa etapa.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
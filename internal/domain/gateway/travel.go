@@ -2,8 +2,10 @@ package gateway
 
 import (
 	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/utils"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,7 +13,18 @@ import (
 type TravelRequestGateway interface {
 	Create(ctx context.Context, travelRequest *entity.TravelRequest) error
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelRequest, error)
-	Update(ctx context.Context, travelRequest *entity.TravelRequest) error
-	List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error)
-	ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.TravelRequest, error)
+	Update(ctx context.Context, travelRequest *entity.TravelRequest) (bool, error)
+	UpdateStatus(ctx context.Context, travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) (bool, error)
+	List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error)
+	ListPendingApproval(ctx context.Context, approverID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error)
+	CountByStatusExcludingUser(ctx context.Context, userID uuid.UUID) (map[enums.TravelRequestStatus]int, error)
+	ListBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]entity.TravelRequest, error)
+	FindOverlapping(ctx context.Context, userID uuid.UUID, travelerIDs []uuid.UUID, departureDate time.Time, returnDate *time.Time, excludeID *uuid.UUID) ([]uuid.UUID, error)
+	ReplaceTravelers(ctx context.Context, travelRequest *entity.TravelRequest) error
+	ReplaceCostItems(ctx context.Context, travelRequest *entity.TravelRequest) error
+	ReplaceAllocations(ctx context.Context, travelRequest *entity.TravelRequest) error
+	ReplacePolicyViolations(ctx context.Context, travelRequest *entity.TravelRequest) error
+	SumApprovedTotalByDepartment(ctx context.Context, department string, from, to time.Time, excludeID uuid.UUID) (float64, error)
 }
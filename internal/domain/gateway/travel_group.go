@@ -0,0 +1,13 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TravelGroupGateway interface {
+	Create(ctx context.Context, group *entity.TravelGroup) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelGroup, error)
+}
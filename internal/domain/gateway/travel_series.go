@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TravelSeriesGateway interface {
+	Create(ctx context.Context, series *entity.TravelSeries) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelSeries, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelSeries, error)
+	ListActive(ctx context.Context) ([]entity.TravelSeries, error)
+	Update(ctx context.Context, series *entity.TravelSeries) error
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TravelTemplateGateway interface {
+	Create(ctx context.Context, template *entity.TravelTemplate) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelTemplate, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelTemplate, error)
+	Update(ctx context.Context, template *entity.TravelTemplate) error
+	Delete(ctx context.Context, template *entity.TravelTemplate) error
+}
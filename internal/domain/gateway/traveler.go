@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TravelerGateway interface {
+	Create(ctx context.Context, traveler *entity.Traveler) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.Traveler, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Traveler, error)
+	Update(ctx context.Context, traveler *entity.Traveler) error
+	ListManageableBy(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error)
+	ListAll(ctx context.Context) ([]entity.Traveler, error)
+	AddDelegate(ctx context.Context, delegate *entity.TravelerDelegate) error
+	RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID) error
+}
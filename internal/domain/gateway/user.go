@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
 	"context"
 	"github.com/google/uuid"
 )
@@ -11,4 +12,9 @@ type UserGateway interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
 	FindByEmail(ctx context.Context, email string) (*entity.User, error)
 	Update(ctx context.Context, user *entity.User) error
+	ListActiveByRole(ctx context.Context, role enums.UserType) ([]entity.User, error)
+	FindByEmails(ctx context.Context, emails []string) ([]entity.User, error)
+	ListReportingLines(ctx context.Context) ([]entity.User, error)
+	LockReportingLines(ctx context.Context) error
+	UpdateReportingLine(ctx context.Context, user *entity.User) error
 }
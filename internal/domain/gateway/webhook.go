@@ -0,0 +1,35 @@
+package gateway
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type WebhookSubscriptionGateway interface {
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error)
+	List(ctx context.Context) ([]entity.WebhookSubscription, error)
+	ListActiveByEvent(ctx context.Context, event enums.WebhookEvent) ([]entity.WebhookSubscription, error)
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type WebhookDeliveryGateway interface {
+	Create(ctx context.Context, deliveries []entity.WebhookDelivery) error
+	FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	// FindForDelivery carrega a entrega com a assinatura; retorna nil se ela foi removida junto
+	// com a assinatura.
+	FindForDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error)
+	ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]entity.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+// WebhookSender faz a requisição de uma entrega à URL da assinatura. Retorna o status HTTP da
+// resposta (zero se não houve resposta) e um erro quando a entrega não foi aceita. A
+// implementação fica em internal/infrastructure/webhook.
+type WebhookSender interface {
+	Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error)
+}
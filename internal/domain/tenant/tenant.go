@@ -0,0 +1,44 @@
+// Package tenant carrega no contexto a organização em nome da qual a operação é executada,
+// usada pelos repositórios para isolar os dados de cada organização.
+package tenant
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+	"errors"
+)
+
+var (
+	ErrTenantRequired = errors.New("operação sem organização definida")
+	ErrTenantMismatch = errors.New("o registro pertence a outra organização")
+)
+
+type contextKey struct{}
+
+type scope struct {
+	organization *entity.Organization
+	system       bool
+}
+
+// WithOrganization restringe as operações do contexto à organização informada.
+func WithOrganization(ctx context.Context, organization *entity.Organization) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{organization: organization})
+}
+
+// AsSystem libera o acesso a todas as organizações. É reservado à autenticação, que ainda não
+// conhece a organização do usuário, e às tarefas em segundo plano.
+func AsSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{system: true})
+}
+
+// Organization retorna a organização do contexto, se houver.
+func Organization(ctx context.Context) (*entity.Organization, bool) {
+	current, _ := ctx.Value(contextKey{}).(scope)
+	return current.organization, current.organization != nil
+}
+
+// IsSystem informa se o contexto tem acesso irrestrito às organizações.
+func IsSystem(ctx context.Context) bool {
+	current, _ := ctx.Value(contextKey{}).(scope)
+	return current.system
+}
@@ -0,0 +1,97 @@
+package catalog
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// destinationsCSV é o conjunto de destinos distribuído com a aplicação, para que o catálogo
+// funcione sem depender de serviços externos.
+//
+//go:embed destinations.csv
+var destinationsCSV []byte
+
+var header = []string{"code", "city", "region", "country_code", "country_name", "timezone", "airports", "aliases"}
+
+// Bundled lê o catálogo embarcado. Os aeroportos vêm no formato "GRU:Nome|CGH:Nome" e os
+// apelidos separados por "|".
+func Bundled() ([]entity.Destination, error) {
+	reader := csv.NewReader(bytes.NewReader(destinationsCSV))
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler catálogo de destinos: %w", err)
+	}
+
+	if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(header, ",") {
+		return nil, fmt.Errorf("cabeçalho inválido no catálogo de destinos")
+	}
+
+	now := time.Now()
+	destinations := make([]entity.Destination, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		line := i + 2
+		code := strings.ToUpper(strings.TrimSpace(record[0]))
+		if code == "" || record[1] == "" || len(record[3]) != 2 || record[5] == "" {
+			return nil, fmt.Errorf("linha %d do catálogo de destinos incompleta", line)
+		}
+
+		destination := entity.Destination{
+			Id:          entity.DestinationIDFromCode(code),
+			Code:        code,
+			City:        strings.TrimSpace(record[1]),
+			Region:      strings.TrimSpace(record[2]),
+			CountryCode: strings.ToUpper(record[3]),
+			CountryName: strings.TrimSpace(record[4]),
+			Timezone:    strings.TrimSpace(record[5]),
+			Aliases:     strings.Join(splitList(record[7]), ","),
+			CreatedAt:   now,
+		}
+
+		for _, airport := range splitList(record[6]) {
+			iata, name, found := strings.Cut(airport, ":")
+			if !found || len(iata) != 3 {
+				return nil, fmt.Errorf("aeroporto inválido %q na linha %d do catálogo de destinos", airport, line)
+			}
+
+			destination.Airports = append(destination.Airports, entity.DestinationAirport{
+				IataCode:      strings.ToUpper(iata),
+				DestinationId: destination.Id,
+				Name:          strings.TrimSpace(name),
+			})
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations, nil
+}
+
+// Seed grava o catálogo embarcado, atualizando as entradas já existentes.
+func Seed(ctx context.Context, destinationGateway gateway.DestinationGateway) error {
+	destinations, err := Bundled()
+	if err != nil {
+		return err
+	}
+
+	return destinationGateway.UpsertMany(ctx, destinations)
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, "|") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -1,24 +1,265 @@
 package container
 
 import (
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/infrastructure/catalog"
+	"challenge-travel-api/internal/infrastructure/jobs"
+	"challenge-travel-api/internal/infrastructure/mail"
+	"challenge-travel-api/internal/infrastructure/policy"
 	"challenge-travel-api/internal/infrastructure/repository"
+	"challenge-travel-api/internal/infrastructure/storage"
+	"challenge-travel-api/internal/infrastructure/webhook"
 	"challenge-travel-api/internal/interface/controller"
+	"challenge-travel-api/internal/interface/middleware"
 	"challenge-travel-api/internal/usecase"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
 
+	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
 
-func Container(db *gorm.DB) (*controller.AuthController, *controller.TravelController) {
+type Controllers struct {
+	Auth         *controller.AuthController
+	Travel       *controller.TravelController
+	Cost         *controller.CostController
+	Policy       *controller.PolicyController
+	Traveler     *controller.TravelerController
+	Group        *controller.TravelGroupController
+	Destination  *controller.DestinationController
+	Comment      *controller.CommentController
+	Attachment   *controller.AttachmentController
+	Template     *controller.TravelTemplateController
+	Series       *controller.TravelSeriesController
+	Hierarchy    *controller.HierarchyController
+	Organization *controller.OrganizationController
+	Outbox       *controller.OutboxController
+	Notification *controller.NotificationTemplateController
+	Webhook      *controller.WebhookController
+	Inbox        *controller.InboxController
+
+	// Tenant coloca a organização do usuário autenticado no contexto das requisições.
+	Tenant gin.HandlerFunc
+
+	// Scheduler executa as tarefas em segundo plano; é iniciado por quem sobe a API.
+	Scheduler *jobs.Scheduler
+}
+
+func Container(db *gorm.DB) *Controllers {
 	userRepo := repository.NewUserRepository(db)
 	travelRepo := repository.NewTravelRequestRepository(db)
+	exchangeRateRepo := repository.NewExchangeRateRepository(db)
+	budgetRepo := repository.NewDepartmentBudgetRepository(db)
+	costCenterRepo := repository.NewCostCenterRepository(db)
+	travelerRepo := repository.NewTravelerRepository(db)
+	travelGroupRepo := repository.NewTravelGroupRepository(db)
+	destinationRepo := repository.NewDestinationRepository(db)
+	commentRepo := repository.NewCommentRepository(db)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	travelTemplateRepo := repository.NewTravelTemplateRepository(db)
+	travelSeriesRepo := repository.NewTravelSeriesRepository(db)
+	departmentRepo := repository.NewDepartmentRepository(db)
+	organizationRepo := repository.NewOrganizationRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	webhookSubscriptionRepo := repository.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	transactor := repository.NewTransactor(db)
+
+	if err := catalog.Seed(context.Background(), destinationRepo); err != nil {
+		log.Fatalf("Erro ao carregar catálogo de destinos: %v", err)
+	}
+
+	baseCurrency := os.Getenv("BASE_CURRENCY")
+	if baseCurrency == "" {
+		baseCurrency = "BRL"
+	}
+
+	defaultTimezone := os.Getenv("DEFAULT_TIMEZONE")
+	if defaultTimezone == "" {
+		defaultTimezone = "America/Sao_Paulo"
+	}
+
+	attachmentMaxSizeMB := int64(10)
+	if value := os.Getenv("ATTACHMENT_MAX_SIZE_MB"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("ATTACHMENT_MAX_SIZE_MB inválido: %s", value)
+		}
+		attachmentMaxSizeMB = parsed
+	}
+
+	defaultOrganizationCode := os.Getenv("DEFAULT_ORGANIZATION_CODE")
+	if defaultOrganizationCode == "" {
+		defaultOrganizationCode = "DEFAULT"
+	}
+
+	recurrenceInterval := time.Hour
+	if value := os.Getenv("RECURRENCE_JOB_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("RECURRENCE_JOB_INTERVAL inválido: %s", value)
+		}
+		recurrenceInterval = parsed
+	}
+
+	outboxInterval := 5 * time.Second
+	if value := os.Getenv("OUTBOX_POLL_INTERVAL"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("OUTBOX_POLL_INTERVAL inválido: %s", value)
+		}
+		outboxInterval = parsed
+	}
 
-	authUseCase := usecase.NewAUthUseCase(userRepo)
-	notificationService := usecase.NewEmailNotificationService()
-	travelUseCase := usecase.NewTravelRequestUseCase(travelRepo, userRepo, notificationService)
+	outboxConfig := usecase.OutboxConfig{BatchSize: 20, MaxAttempts: 8}
+	if value := os.Getenv("OUTBOX_MAX_ATTEMPTS"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("OUTBOX_MAX_ATTEMPTS inválido: %s", value)
+		}
+		outboxConfig.MaxAttempts = parsed
+	}
+	if value := os.Getenv("OUTBOX_BATCH_SIZE"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("OUTBOX_BATCH_SIZE inválido: %s", value)
+		}
+		outboxConfig.BatchSize = parsed
+	}
+
+	webhookTimeout := 10 * time.Second
+	if value := os.Getenv("WEBHOOK_TIMEOUT"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("WEBHOOK_TIMEOUT inválido: %s", value)
+		}
+		webhookTimeout = parsed
+	}
+
+	fileStorage, err := newFileStorage()
+	if err != nil {
+		log.Fatalf("Erro ao configurar armazenamento de anexos: %v", err)
+	}
+
+	mailer, err := newMailer()
+	if err != nil {
+		log.Fatalf("Erro ao configurar envio de e-mails: %v", err)
+	}
+
+	var policyRuleRepo gateway.PolicyRuleGateway
+	if policyFile := os.Getenv("TRAVEL_POLICY_FILE"); policyFile != "" {
+		fileRules, err := policy.NewFileRuleGateway(policyFile)
+		if err != nil {
+			log.Fatalf("Erro ao carregar arquivo de políticas %s: %v", policyFile, err)
+		}
+		policyRuleRepo = fileRules
+	} else {
+		policyRuleRepo = repository.NewPolicyRuleRepository(db)
+	}
+
+	authUseCase := usecase.NewAUthUseCase(userRepo, organizationRepo, defaultOrganizationCode)
+	notificationService := usecase.NewNotificationService(outboxRepo, notificationRepo, notificationPreferenceRepo)
+	costUseCase := usecase.NewCostUseCase(exchangeRateRepo, budgetRepo, costCenterRepo, departmentRepo, travelRepo, userRepo, baseCurrency)
+	policyUseCase := usecase.NewPolicyUseCase(policyRuleRepo)
+	travelerUseCase := usecase.NewTravelerUseCase(travelerRepo, userRepo)
+	destinationUseCase := usecase.NewDestinationUseCase(destinationRepo)
+	webhookUseCase := usecase.NewWebhookUseCase(webhookSubscriptionRepo, webhookDeliveryRepo, outboxRepo, userRepo, webhook.NewHTTPSender(webhookTimeout), transactor)
+	travelUseCase := usecase.NewTravelRequestUseCase(travelRepo, userRepo, notificationService, transactor, webhookUseCase, costUseCase, policyUseCase, travelerUseCase, travelGroupRepo, destinationUseCase, travelTemplateRepo, travelSeriesRepo, defaultTimezone)
+	commentUseCase := usecase.NewCommentUseCase(commentRepo, travelRepo, userRepo, notificationService, transactor)
+	hierarchyUseCase := usecase.NewHierarchyUseCase(departmentRepo, userRepo, transactor)
+	organizationUseCase := usecase.NewOrganizationUseCase(organizationRepo, userRepo)
+	attachmentUseCase := usecase.NewAttachmentUseCase(attachmentRepo, travelRepo, userRepo, fileStorage, attachmentMaxSizeMB<<20)
+	notificationTemplateUseCase := usecase.NewNotificationTemplateUseCase(userRepo, travelRepo)
+	inboxUseCase := usecase.NewInboxUseCase(notificationRepo, notificationPreferenceRepo)
+	outboxUseCase := usecase.NewOutboxUseCase(outboxRepo, userRepo, map[string]usecase.OutboxHandler{
+		usecase.OutboxTopicEmail:   usecase.EmailOutboxHandler(mailer),
+		usecase.OutboxTopicWebhook: webhookUseCase.Deliver,
+	}, outboxConfig)
+
+	scheduler := jobs.NewScheduler()
+	scheduler.Register("recurring-travels", recurrenceInterval, func(ctx context.Context) error {
+		return organizationUseCase.ForEachOrganization(ctx, func(ctx context.Context) error {
+			return travelUseCase.GenerateDueOccurrences(ctx, time.Now())
+		})
+	})
+	scheduler.Register("outbox", outboxInterval, outboxUseCase.ProcessDue)
+
+	return &Controllers{
+		Auth:         controller.NewAuthController(authUseCase),
+		Travel:       controller.NewTravelController(travelUseCase),
+		Cost:         controller.NewCostController(costUseCase),
+		Policy:       controller.NewPolicyController(policyUseCase),
+		Traveler:     controller.NewTravelerController(travelerUseCase),
+		Group:        controller.NewTravelGroupController(travelUseCase),
+		Destination:  controller.NewDestinationController(destinationUseCase),
+		Comment:      controller.NewCommentController(commentUseCase),
+		Attachment:   controller.NewAttachmentController(attachmentUseCase),
+		Template:     controller.NewTravelTemplateController(travelUseCase),
+		Series:       controller.NewTravelSeriesController(travelUseCase),
+		Hierarchy:    controller.NewHierarchyController(hierarchyUseCase),
+		Organization: controller.NewOrganizationController(organizationUseCase),
+		Outbox:       controller.NewOutboxController(outboxUseCase),
+		Notification: controller.NewNotificationTemplateController(notificationTemplateUseCase),
+		Webhook:      controller.NewWebhookController(webhookUseCase),
+		Inbox:        controller.NewInboxController(inboxUseCase),
+		Tenant:       middleware.TenantMiddleware(organizationUseCase),
+		Scheduler:    scheduler,
+	}
+}
+
+// newFileStorage escolhe o armazenamento dos anexos por ATTACHMENT_STORAGE: "local" (padrão,
+// diretório ATTACHMENT_DIR) ou "s3" (qualquer serviço compatível, configurado pelas variáveis S3_*).
+func newFileStorage() (gateway.FileStorage, error) {
+	switch os.Getenv("ATTACHMENT_STORAGE") {
+	case "", "local":
+		dir := os.Getenv("ATTACHMENT_DIR")
+		if dir == "" {
+			dir = "data/attachments"
+		}
+		return storage.NewLocalStorage(dir)
+	case "s3":
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			Region:          os.Getenv("S3_REGION"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+		}, nil)
+	default:
+		return nil, fmt.Errorf("ATTACHMENT_STORAGE desconhecido: %s", os.Getenv("ATTACHMENT_STORAGE"))
+	}
+}
 
-	authController := controller.NewAuthController(authUseCase)
-	travelController := controller.NewTravelController(travelUseCase)
+// newMailer envia as notificações pelo servidor SMTP_HOST ou, sem ele, apenas as registra em log.
+// SMTP_SECURITY aceita "starttls" (padrão), "tls" ou "none".
+func newMailer() (gateway.Mailer, error) {
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return mail.NewLogMailer(), nil
+	}
 
-	return authController, travelController
+	port := 0
+	if value := os.Getenv("SMTP_PORT"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("SMTP_PORT inválido: %s", value)
+		}
+		port = parsed
+	}
 
+	return mail.NewSMTPMailer(mail.SMTPConfig{
+		Host:     host,
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+		FromName: os.Getenv("SMTP_FROM_NAME"),
+		Security: os.Getenv("SMTP_SECURITY"),
+	}, nil)
 }
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("should run jobs at start and on every tick until canceled", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		scheduler.Register("counter", 10*time.Millisecond, func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		// Act
+		scheduler.Start(ctx)
+
+		// Assert
+		assert.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+
+		cancel()
+		time.Sleep(30 * time.Millisecond)
+		stopped := runs.Load()
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, stopped, runs.Load())
+	})
+
+	t.Run("should keep running after errors and panics", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var runs atomic.Int32
+		scheduler := NewScheduler()
+		scheduler.Register("flaky", 10*time.Millisecond, func(ctx context.Context) error {
+			if runs.Add(1)%2 == 0 {
+				panic("falha inesperada")
+			}
+			return errors.New("falha temporária")
+		})
+
+		// Act
+		scheduler.Start(ctx)
+
+		// Assert
+		assert.Eventually(t, func() bool { return runs.Load() >= 4 }, time.Second, 5*time.Millisecond)
+	})
+}
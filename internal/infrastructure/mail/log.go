@@ -0,0 +1,21 @@
+package mail
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"log"
+)
+
+// LogMailer apenas registra a versão em texto das mensagens; é usado quando nenhum servidor
+// SMTP está configurado, como em desenvolvimento.
+type LogMailer struct{}
+
+func NewLogMailer() gateway.Mailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, message entity.EmailMessage) error {
+	log.Printf("[NOTIFICATION] E-mail enviado para %s: %s\n%s", message.To.Email, message.Subject, message.TextBody)
+	return nil
+}
@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// buildMessage monta a mensagem MIME multipart/alternative com as partes em texto e em HTML,
+// nessa ordem, de forma que os clientes exibam a última que souberem apresentar.
+func buildMessage(from *mail.Address, to *mail.Address, message entity.EmailMessage, now time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	if err := writePart(parts, "text/plain; charset=utf-8", message.TextBody); err != nil {
+		return nil, err
+	}
+	if err := writePart(parts, "text/html; charset=utf-8", message.HTMLBody); err != nil {
+		return nil, err
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", messageID(from.Address, message.IdempotencyKey)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", parts.Boundary())},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(&buffer, "%s: %s\r\n", header[0], header[1])
+	}
+	buffer.WriteString("\r\n")
+	buffer.Write(body.Bytes())
+
+	return buffer.Bytes(), nil
+}
+
+func writePart(parts *multipart.Writer, contentType string, content string) error {
+	part, err := parts.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	writer := quotedprintable.NewWriter(part)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// messageID gera o identificador da mensagem no domínio do remetente. Com a chave de
+// idempotência, novas tentativas da mesma mensagem mantêm o identificador.
+func messageID(from string, idempotencyKey string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+
+	if idempotencyKey != "" {
+		return fmt.Sprintf("<%s@%s>", idempotencyKey, domain)
+	}
+
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(random), domain)
+}
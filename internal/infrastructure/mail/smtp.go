@@ -0,0 +1,189 @@
+package mail
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// Modos de segurança da conexão SMTP.
+const (
+	// SecurityStartTLS conecta em texto puro (normalmente na porta 587) e exige a negociação
+	// de TLS antes da autenticação.
+	SecurityStartTLS = "starttls"
+	// SecurityTLS usa TLS desde a conexão (normalmente na porta 465).
+	SecurityTLS = "tls"
+	// SecurityNone não cifra a conexão; serve apenas para relays locais.
+	SecurityNone = "none"
+)
+
+var ErrStartTLSUnsupported = errors.New("servidor SMTP não oferece STARTTLS")
+
+// SMTPConfig identifica o servidor e o remetente. Sem Username, a mensagem é enviada sem
+// autenticação.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
+	Security string
+	Timeout  time.Duration
+}
+
+type SMTPMailer struct {
+	config    SMTPConfig
+	from      *mail.Address
+	tlsConfig *tls.Config
+	now       func() time.Time
+}
+
+// NewSMTPMailer valida a configuração. tlsConfig pode ser nil; nesse caso o certificado do
+// servidor é verificado contra as autoridades do sistema.
+func NewSMTPMailer(config SMTPConfig, tlsConfig *tls.Config) (gateway.Mailer, error) {
+	if config.Host == "" || config.From == "" {
+		return nil, errors.New("configuração do servidor SMTP incompleta")
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("remetente SMTP inválido: %w", err)
+	}
+	if config.FromName != "" {
+		from.Name = config.FromName
+	}
+
+	switch config.Security {
+	case "":
+		config.Security = SecurityStartTLS
+	case SecurityStartTLS, SecurityTLS, SecurityNone:
+	default:
+		return nil, fmt.Errorf("modo de segurança SMTP desconhecido: %s", config.Security)
+	}
+
+	if config.Port == 0 {
+		config.Port = 587
+		if config.Security == SecurityTLS {
+			config.Port = 465
+		}
+	}
+
+	if config.Timeout <= 0 {
+		config.Timeout = 30 * time.Second
+	}
+
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	} else {
+		tlsConfig = tlsConfig.Clone()
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = config.Host
+	}
+
+	return &SMTPMailer{
+		config:    config,
+		from:      from,
+		tlsConfig: tlsConfig,
+		now:       time.Now,
+	}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message entity.EmailMessage) error {
+	to, err := mail.ParseAddress(message.To.Email)
+	if err != nil {
+		return fmt.Errorf("destinatário inválido: %w", err)
+	}
+	to.Name = message.To.Name
+
+	content, err := buildMessage(m.from, to, message, m.now())
+	if err != nil {
+		return err
+	}
+
+	client, err := m.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if m.config.Security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return ErrStartTLSUnsupported
+		}
+		if err := client.StartTLS(m.tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	if m.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(m.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(content); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// dial abre a conexão respeitando o prazo do contexto e o Timeout da configuração, que vale
+// para toda a conversa com o servidor.
+func (m *SMTPMailer) dial(ctx context.Context) (*smtp.Client, error) {
+	deadline := time.Now().Add(m.config.Timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	address := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+	dialer := &net.Dialer{Deadline: deadline}
+
+	var conn net.Conn
+	var err error
+	if m.config.Security == SecurityTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: m.tlsConfig}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	client, err := smtp.NewClient(conn, m.config.Host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,386 @@
+package mail
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedMail é uma mensagem aceita pelo servidor de teste.
+type receivedMail struct {
+	From     string
+	To       []string
+	Data     string
+	TLS      bool
+	AuthUser string
+}
+
+// testSMTPServer implementa o suficiente do protocolo SMTP (EHLO, STARTTLS, AUTH PLAIN, MAIL,
+// RCPT, DATA e QUIT) para exercitar o SMTPMailer sem um servidor externo.
+type testSMTPServer struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	offerTLS  bool
+	username  string
+	password  string
+
+	mu       sync.Mutex
+	messages []receivedMail
+}
+
+func newTestSMTPServer(t *testing.T, offerTLS bool, username, password string) (*testSMTPServer, *x509.CertPool) {
+	t.Helper()
+
+	certificate, pool := newTestCertificate(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &testSMTPServer{
+		listener:  listener,
+		tlsConfig: &tls.Config{Certificates: []tls.Certificate{certificate}},
+		offerTLS:  offerTLS,
+		username:  username,
+		password:  password,
+	}
+
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return server, pool
+}
+
+func (s *testSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *testSMTPServer) received() []receivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail(nil), s.messages...)
+}
+
+func (s *testSMTPServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *testSMTPServer) handle(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	text := textproto.NewConn(conn)
+	_ = text.PrintfLine("220 localhost ESMTP teste")
+
+	var current receivedMail
+	authenticated := s.username == ""
+
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command, argument, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			if s.offerTLS && !current.TLS {
+				_ = text.PrintfLine("250-localhost")
+				_ = text.PrintfLine("250-STARTTLS")
+			} else {
+				_ = text.PrintfLine("250-localhost")
+			}
+			_ = text.PrintfLine("250 AUTH PLAIN")
+		case "STARTTLS":
+			_ = text.PrintfLine("220 pronto para TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			text = textproto.NewConn(conn)
+			current.TLS = true
+		case "AUTH":
+			mechanism, encoded, _ := strings.Cut(argument, " ")
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			fields := strings.Split(string(decoded), "\x00")
+			if mechanism != "PLAIN" || err != nil || len(fields) != 3 || fields[1] != s.username || fields[2] != s.password {
+				_ = text.PrintfLine("535 5.7.8 credenciais inválidas")
+				continue
+			}
+			authenticated = true
+			current.AuthUser = fields[1]
+			_ = text.PrintfLine("235 2.7.0 autenticado")
+		case "MAIL":
+			if !authenticated {
+				_ = text.PrintfLine("530 5.7.0 autenticação obrigatória")
+				continue
+			}
+			current.From = strings.Trim(strings.TrimPrefix(argument, "FROM:"), "<>")
+			_ = text.PrintfLine("250 ok")
+		case "RCPT":
+			current.To = append(current.To, strings.Trim(strings.TrimPrefix(argument, "TO:"), "<>"))
+			_ = text.PrintfLine("250 ok")
+		case "DATA":
+			_ = text.PrintfLine("354 envie a mensagem")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			current.Data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, current)
+			s.mu.Unlock()
+			current = receivedMail{TLS: current.TLS, AuthUser: current.AuthUser}
+			_ = text.PrintfLine("250 mensagem aceita")
+		case "QUIT":
+			_ = text.PrintfLine("221 até logo")
+			return
+		default:
+			_ = text.PrintfLine("250 ok")
+		}
+	}
+}
+
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func newTestMessage() entity.EmailMessage {
+	return entity.EmailMessage{
+		To:       entity.NotificationRecipient{Name: "João Silva", Email: "joao@empresa.com"},
+		Subject:  "Pedido de viagem para São Paulo aprovado",
+		TextBody: "Olá João, o pedido foi aprovado.",
+		HTMLBody: "<p>Olá João, o pedido foi <strong>aprovado</strong>.</p>",
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	t.Run("should upgrade with STARTTLS, authenticate and send a multipart message", func(t *testing.T) {
+		// Arrange
+		server, pool := newTestSMTPServer(t, true, "viagens", "segredo")
+		mailer, err := NewSMTPMailer(SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     server.port(),
+			Username: "viagens",
+			Password: "segredo",
+			From:     "viagens@empresa.com",
+			FromName: "Viagens Corporativas",
+		}, &tls.Config{RootCAs: pool})
+		require.NoError(t, err)
+
+		// Act
+		err = mailer.Send(context.Background(), newTestMessage())
+
+		// Assert
+		require.NoError(t, err)
+		messages := server.received()
+		require.Len(t, messages, 1)
+		assert.True(t, messages[0].TLS)
+		assert.Equal(t, "viagens", messages[0].AuthUser)
+		assert.Equal(t, "viagens@empresa.com", messages[0].From)
+		assert.Equal(t, []string{"joao@empresa.com"}, messages[0].To)
+
+		parsed, err := mail.ReadMessage(strings.NewReader(messages[0].Data))
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Pedido de viagem para São Paulo aprovado", subject)
+
+		to, err := parsed.Header.AddressList("To")
+		require.NoError(t, err)
+		assert.Equal(t, "João Silva", to[0].Name)
+
+		mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		reader := multipart.NewReader(parsed.Body, params["boundary"])
+		var bodies []string
+		var contentTypes []string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(part)
+			require.NoError(t, err)
+			contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+			bodies = append(bodies, string(content))
+		}
+
+		assert.Equal(t, []string{"text/plain; charset=utf-8", "text/html; charset=utf-8"}, contentTypes)
+		assert.Equal(t, "Olá João, o pedido foi aprovado.", bodies[0])
+		assert.Equal(t, "<p>Olá João, o pedido foi <strong>aprovado</strong>.</p>", bodies[1])
+	})
+
+	t.Run("should refuse to send when the server does not offer STARTTLS", func(t *testing.T) {
+		// Arrange
+		server, pool := newTestSMTPServer(t, false, "viagens", "segredo")
+		mailer, err := NewSMTPMailer(SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     server.port(),
+			Username: "viagens",
+			Password: "segredo",
+			From:     "viagens@empresa.com",
+		}, &tls.Config{RootCAs: pool})
+		require.NoError(t, err)
+
+		// Act
+		err = mailer.Send(context.Background(), newTestMessage())
+
+		// Assert
+		assert.ErrorIs(t, err, ErrStartTLSUnsupported)
+		assert.Empty(t, server.received())
+	})
+
+	t.Run("should return the authentication failure", func(t *testing.T) {
+		// Arrange
+		server, pool := newTestSMTPServer(t, true, "viagens", "segredo")
+		mailer, err := NewSMTPMailer(SMTPConfig{
+			Host:     "127.0.0.1",
+			Port:     server.port(),
+			Username: "viagens",
+			Password: "errada",
+			From:     "viagens@empresa.com",
+		}, &tls.Config{RootCAs: pool})
+		require.NoError(t, err)
+
+		// Act
+		err = mailer.Send(context.Background(), newTestMessage())
+
+		// Assert
+		assert.ErrorContains(t, err, "535")
+		assert.Empty(t, server.received())
+	})
+
+	t.Run("should reject invalid recipients before connecting", func(t *testing.T) {
+		// Arrange
+		mailer, err := NewSMTPMailer(SMTPConfig{Host: "127.0.0.1", Port: 1, From: "viagens@empresa.com"}, nil)
+		require.NoError(t, err)
+
+		message := newTestMessage()
+		message.To.Email = "joao@empresa.com\r\nBcc: outro@empresa.com"
+
+		// Act
+		err = mailer.Send(context.Background(), message)
+
+		// Assert
+		assert.ErrorContains(t, err, "destinatário inválido")
+	})
+}
+
+func TestNewSMTPMailer(t *testing.T) {
+	t.Run("should default to STARTTLS on port 587", func(t *testing.T) {
+		// Act
+		mailer, err := NewSMTPMailer(SMTPConfig{Host: "smtp.empresa.com", From: "viagens@empresa.com"}, nil)
+
+		// Assert
+		require.NoError(t, err)
+		config := mailer.(*SMTPMailer).config
+		assert.Equal(t, SecurityStartTLS, config.Security)
+		assert.Equal(t, 587, config.Port)
+	})
+
+	t.Run("should reject unknown security modes", func(t *testing.T) {
+		// Act
+		_, err := NewSMTPMailer(SMTPConfig{Host: "smtp.empresa.com", From: "viagens@empresa.com", Security: "ssl"}, nil)
+
+		// Assert
+		assert.ErrorContains(t, err, "ssl")
+	})
+}
+
+func TestBuildMessage(t *testing.T) {
+	t.Run("should encode subjects with line breaks instead of injecting headers", func(t *testing.T) {
+		// Arrange
+		message := newTestMessage()
+		message.Subject = "Aprovado\r\nBcc: outro@empresa.com"
+
+		// Act
+		content, err := buildMessage(&mail.Address{Address: "viagens@empresa.com"}, &mail.Address{Address: "joao@empresa.com"}, message, time.Now())
+
+		// Assert
+		require.NoError(t, err)
+		parsed, err := mail.ReadMessage(strings.NewReader(string(content)))
+		require.NoError(t, err)
+		assert.Empty(t, parsed.Header.Get("Bcc"))
+		assert.True(t, strings.HasPrefix(parsed.Header.Get("Message-Id"), "<"))
+		assert.Equal(t, "1.0", parsed.Header.Get("Mime-Version"))
+	})
+}
+
+func TestMessageID(t *testing.T) {
+	t.Run("should keep the Message-ID across retries of the same message", func(t *testing.T) {
+		// Act
+		first := messageID("viagens@empresa.com", "3f2c8a8e-5d1b-4d7e-9a55-0c8f1d2b6e41")
+		second := messageID("viagens@empresa.com", "3f2c8a8e-5d1b-4d7e-9a55-0c8f1d2b6e41")
+
+		// Assert
+		assert.Equal(t, "<3f2c8a8e-5d1b-4d7e-9a55-0c8f1d2b6e41@empresa.com>", first)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should generate a random Message-ID without an idempotency key", func(t *testing.T) {
+		// Act
+		first := messageID("viagens@empresa.com", "")
+		second := messageID("viagens@empresa.com", "")
+
+		// Assert
+		assert.NotEqual(t, first, second)
+		assert.True(t, strings.HasSuffix(first, "@empresa.com>"))
+	})
+}
@@ -0,0 +1,87 @@
+package policy
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type fileRule struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Field       string `json:"field" yaml:"field"`
+	Operator    string `json:"operator" yaml:"operator"`
+	Value       string `json:"value" yaml:"value"`
+	Severity    string `json:"severity" yaml:"severity"`
+	Message     string `json:"message" yaml:"message"`
+	Disabled    bool   `json:"disabled" yaml:"disabled"`
+}
+
+type fileDocument struct {
+	Rules []fileRule `json:"rules" yaml:"rules"`
+}
+
+// FileRuleGateway carrega as regras de política de um arquivo JSON ou YAML na inicialização.
+type FileRuleGateway struct {
+	rules []entity.TravelPolicyRule
+}
+
+func NewFileRuleGateway(path string) (gateway.PolicyRuleGateway, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document fileDocument
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(content, &document)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &document)
+	default:
+		return nil, fmt.Errorf("formato de arquivo de política não suportado: %s", path)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]entity.TravelPolicyRule, 0, len(document.Rules))
+	for _, r := range document.Rules {
+		rule := entity.TravelPolicyRule{
+			Name:        r.Name,
+			Description: r.Description,
+			Field:       enums.PolicyField(strings.ToLower(r.Field)),
+			Operator:    enums.PolicyOperator(strings.ToUpper(r.Operator)),
+			Value:       r.Value,
+			Severity:    enums.PolicySeverity(strings.ToUpper(r.Severity)),
+			Message:     r.Message,
+			IsActive:    !r.Disabled,
+		}
+
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+
+		if rule.IsActive {
+			rules = append(rules, rule)
+		}
+	}
+
+	return &FileRuleGateway{
+		rules: rules,
+	}, nil
+}
+
+func (g *FileRuleGateway) ListActive(ctx context.Context) ([]entity.TravelPolicyRule, error) {
+	return g.rules, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAttachmentNotFound = errors.New("anexo não encontrado")
+)
+
+type AttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) gateway.AttachmentGateway {
+	return &AttachmentRepository{
+		db: db,
+	}
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *entity.TravelRequestAttachment) error {
+	return r.db.WithContext(ctx).Create(attachment).Error
+}
+
+func (r *AttachmentRepository) FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestAttachment, error) {
+	var attachment entity.TravelRequestAttachment
+
+	err := r.db.WithContext(ctx).
+		Where("id = ? AND travel_request_id = ?", id, travelRequestID).
+		First(&attachment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAttachmentNotFound
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func (r *AttachmentRepository) ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID) ([]entity.TravelRequestAttachment, error) {
+	var attachments []entity.TravelRequestAttachment
+
+	err := r.db.WithContext(ctx).
+		Where("travel_request_id = ?", travelRequestID).
+		Order("created_at, id").
+		Find(&attachments).Error
+
+	return attachments, err
+}
+
+func (r *AttachmentRepository) Delete(ctx context.Context, attachment *entity.TravelRequestAttachment) error {
+	result := r.db.WithContext(ctx).Delete(attachment)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAttachmentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCommentNotFound = errors.New("comentário não encontrado")
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) gateway.CommentGateway {
+	return &CommentRepository{
+		db: db,
+	}
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *entity.TravelRequestComment) error {
+	return conn(ctx, r.db).Create(comment).Error
+}
+
+func (r *CommentRepository) FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestComment, error) {
+	var comment entity.TravelRequestComment
+
+	err := conn(ctx, r.db).
+		Where("id = ? AND travel_request_id = ?", id, travelRequestID).
+		First(&comment).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+func (r *CommentRepository) ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID, includeInternal bool) ([]entity.TravelRequestComment, error) {
+	var comments []entity.TravelRequestComment
+
+	query := conn(ctx, r.db).Where("travel_request_id = ?", travelRequestID)
+	if !includeInternal {
+		query = query.Where("internal = ?", false)
+	}
+
+	err := query.Order("created_at, id").Find(&comments).Error
+
+	return comments, err
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *entity.TravelRequestComment) error {
+	result := conn(ctx, r.db).Model(comment).
+		Select("body", "updated_at").
+		Updates(comment)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+	return nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, comment *entity.TravelRequestComment) error {
+	result := conn(ctx, r.db).Delete(comment)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCostCenterNotFound = errors.New("centro de custo não encontrado")
+)
+
+type CostCenterRepository struct {
+	db *gorm.DB
+}
+
+func NewCostCenterRepository(db *gorm.DB) gateway.CostCenterGateway {
+	return &CostCenterRepository{
+		db: db,
+	}
+}
+
+func (r *CostCenterRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *CostCenterRepository) Create(ctx context.Context, costCenter *entity.CostCenter) error {
+	if err := checkTenant(ctx, costCenter.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(costCenter).Error
+}
+
+func (r *CostCenterRepository) Update(ctx context.Context, costCenter *entity.CostCenter) error {
+	if err := checkTenant(ctx, costCenter.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Save(costCenter).Error
+}
+
+func (r *CostCenterRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error) {
+	var costCenter entity.CostCenter
+
+	err := r.tenantDB(ctx).First(&costCenter, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCostCenterNotFound
+		}
+		return nil, err
+	}
+
+	return &costCenter, nil
+}
+
+// FindByIDs carrega vários centros de uma vez; IDs inexistentes são ignorados.
+func (r *CostCenterRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.CostCenter, error) {
+	var costCenters []entity.CostCenter
+
+	err := r.tenantDB(ctx).Where("id IN ?", ids).Find(&costCenters).Error
+
+	return costCenters, err
+}
+
+// FindByCode retorna nil quando nenhum centro da organização usa o código.
+func (r *CostCenterRepository) FindByCode(ctx context.Context, code string) (*entity.CostCenter, error) {
+	var costCenter entity.CostCenter
+
+	err := r.tenantDB(ctx).Where("code = ?", code).First(&costCenter).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &costCenter, nil
+}
+
+func (r *CostCenterRepository) List(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error) {
+	var costCenters []entity.CostCenter
+
+	query := r.tenantDB(ctx).Order("code")
+
+	if !includeInactive {
+		query = query.Where("active")
+	}
+
+	err := query.Find(&costCenters).Error
+
+	return costCenters, err
+}
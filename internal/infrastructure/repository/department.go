@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDepartmentNotFound = errors.New("departamento não encontrado")
+)
+
+type DepartmentRepository struct {
+	db *gorm.DB
+}
+
+func NewDepartmentRepository(db *gorm.DB) gateway.DepartmentGateway {
+	return &DepartmentRepository{
+		db: db,
+	}
+}
+
+func (r *DepartmentRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *DepartmentRepository) Create(ctx context.Context, department *entity.Department) error {
+	if err := checkTenant(ctx, department.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(department).Error
+}
+
+func (r *DepartmentRepository) Update(ctx context.Context, department *entity.Department) error {
+	if err := checkTenant(ctx, department.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Save(department).Error
+}
+
+func (r *DepartmentRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	var department entity.Department
+
+	err := r.tenantDB(ctx).First(&department, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDepartmentNotFound
+		}
+		return nil, err
+	}
+
+	return &department, nil
+}
+
+// FindByCode retorna nil quando nenhum departamento da organização usa o código.
+func (r *DepartmentRepository) FindByCode(ctx context.Context, code string) (*entity.Department, error) {
+	var department entity.Department
+
+	err := r.tenantDB(ctx).Where("code = ?", code).First(&department).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &department, nil
+}
+
+func (r *DepartmentRepository) List(ctx context.Context, includeInactive bool) ([]entity.Department, error) {
+	var departments []entity.Department
+
+	query := r.tenantDB(ctx).Order("code")
+
+	if !includeInactive {
+		query = query.Where("active")
+	}
+
+	err := query.Find(&departments).Error
+
+	return departments, err
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DepartmentBudgetRepository struct {
+	db *gorm.DB
+}
+
+func NewDepartmentBudgetRepository(db *gorm.DB) gateway.DepartmentBudgetGateway {
+	return &DepartmentBudgetRepository{
+		db: db,
+	}
+}
+
+// FindByDepartmentAndYear retorna nil quando o departamento não possui orçamento no ano. Dentro de
+// uma transação, a linha fica bloqueada até o seu fim, serializando as aprovações do departamento.
+func (r *DepartmentBudgetRepository) FindByDepartmentAndYear(ctx context.Context, department string, year int) (*entity.DepartmentBudget, error) {
+	var budget entity.DepartmentBudget
+
+	err := scopeTenant(ctx, conn(ctx, r.db), "organization_id").
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("department = ? AND year = ?", department, year).
+		First(&budget).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &budget, nil
+}
+
+func (r *DepartmentBudgetRepository) List(ctx context.Context, year *int) ([]entity.DepartmentBudget, error) {
+	var budgets []entity.DepartmentBudget
+
+	query := scopeTenant(ctx, r.db.WithContext(ctx), "organization_id").Order("year DESC, department")
+
+	if year != nil {
+		query = query.Where("year = ?", *year)
+	}
+
+	err := query.Find(&budgets).Error
+
+	return budgets, err
+}
+
+// Save cria ou atualiza o orçamento do departamento no ano e devolve em budget a linha gravada.
+func (r *DepartmentBudgetRepository) Save(ctx context.Context, budget *entity.DepartmentBudget) error {
+	if err := checkTenant(ctx, budget.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "department"}, {Name: "year"}},
+		DoUpdates: clause.AssignmentColumns([]string{"amount"}),
+	}, clause.Returning{}).Create(budget).Error
+}
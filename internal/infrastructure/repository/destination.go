@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrDestinationNotFound = errors.New("destino não encontrado no catálogo")
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type DestinationRepository struct {
+	db *gorm.DB
+}
+
+func NewDestinationRepository(db *gorm.DB) gateway.DestinationGateway {
+	return &DestinationRepository{
+		db: db,
+	}
+}
+
+// Search busca destinos para autocompletar, ignorando acentos e maiúsculas. Códigos IATA
+// exatos aparecem primeiro, seguidos das cidades que começam com o termo.
+func (r *DestinationRepository) Search(ctx context.Context, query string, limit int) ([]entity.Destination, error) {
+	var destinations []entity.Destination
+
+	term := likeEscaper.Replace(strings.ToLower(strings.TrimSpace(query)))
+	iata := strings.ToUpper(strings.TrimSpace(query))
+
+	tx := r.db.WithContext(ctx).Preload("Airports").Limit(limit)
+
+	if term == "" {
+		err := tx.Order("country_code, city").Find(&destinations).Error
+		return destinations, err
+	}
+
+	airports := r.db.Model(&entity.DestinationAirport{}).Select("destination_id").Where("iata_code = ?", iata)
+
+	err := tx.
+		Where(
+			"unaccent(lower(city)) LIKE unaccent(@prefix) OR unaccent(lower(city)) LIKE unaccent(@word) "+
+				"OR unaccent(lower(country_name)) LIKE unaccent(@prefix) "+
+				"OR unaccent(lower(',' || aliases)) LIKE unaccent(@alias) "+
+				"OR id IN (@airports)",
+			sql.Named("prefix", term+"%"),
+			sql.Named("word", "% "+term+"%"),
+			sql.Named("alias", "%,"+term+"%"),
+			sql.Named("airports", airports),
+		).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "CASE WHEN id IN (?) THEN 0 WHEN unaccent(lower(city)) LIKE unaccent(?) THEN 1 ELSE 2 END, city",
+			Vars:               []interface{}{airports, term + "%"},
+			WithoutParentheses: true,
+		}}).
+		Find(&destinations).Error
+
+	return destinations, err
+}
+
+func (r *DestinationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Destination, error) {
+	var destination entity.Destination
+
+	err := r.db.WithContext(ctx).Preload("Airports").First(&destination, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDestinationNotFound
+		}
+		return nil, err
+	}
+
+	return &destination, nil
+}
+
+func (r *DestinationRepository) UpsertMany(ctx context.Context, destinations []entity.Destination) error {
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	airports := make([]entity.DestinationAirport, 0, len(destinations))
+	for _, destination := range destinations {
+		airports = append(airports, destination.Airports...)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("Airports").
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "code"}},
+				DoUpdates: clause.AssignmentColumns([]string{"city", "region", "country_code", "country_name", "timezone", "aliases"}),
+			}).
+			Create(&destinations).Error
+		if err != nil {
+			return err
+		}
+
+		if len(airports) == 0 {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "iata_code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"destination_id", "name"}),
+		}).Create(&airports).Error
+	})
+}
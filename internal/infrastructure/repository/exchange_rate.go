@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrExchangeRateNotFound = errors.New("taxa de câmbio não encontrada para a moeda informada")
+)
+
+type ExchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) gateway.ExchangeRateGateway {
+	return &ExchangeRateRepository{
+		db: db,
+	}
+}
+
+func (r *ExchangeRateRepository) FindByCurrency(ctx context.Context, currency string) (*entity.ExchangeRate, error) {
+	var rate entity.ExchangeRate
+
+	err := scopeTenant(ctx, r.db.WithContext(ctx), "organization_id").Where("currency = ?", currency).First(&rate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExchangeRateNotFound
+		}
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (r *ExchangeRateRepository) List(ctx context.Context) ([]entity.ExchangeRate, error) {
+	var rates []entity.ExchangeRate
+
+	err := scopeTenant(ctx, r.db.WithContext(ctx), "organization_id").Order("currency").Find(&rates).Error
+
+	return rates, err
+}
+
+func (r *ExchangeRateRepository) Save(ctx context.Context, rate *entity.ExchangeRate) error {
+	if err := checkTenant(ctx, rate.OrganizationId); err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization_id"}, {Name: "currency"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(rate).Error
+}
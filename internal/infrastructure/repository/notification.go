@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) gateway.NotificationGateway {
+	return &NotificationRepository{
+		db: db,
+	}
+}
+
+func (r *NotificationRepository) userDB(ctx context.Context, userID uuid.UUID) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id").
+		Model(&entity.Notification{}).
+		Where("user_id = ?", userID)
+}
+
+// Create grava as notificações na transação do contexto, se houver, junto com a alteração que
+// as originou.
+func (r *NotificationRepository) Create(ctx context.Context, notifications []entity.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	for _, notification := range notifications {
+		if err := checkTenant(ctx, notification.OrganizationId); err != nil {
+			return err
+		}
+	}
+
+	return conn(ctx, r.db).Create(&notifications).Error
+}
+
+func (r *NotificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page int, pageSize int) ([]entity.Notification, int64, error) {
+	query := r.userDB(ctx, userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []entity.Notification
+	err := query.
+		Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&notifications).Error
+
+	return notifications, total, err
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+
+	err := r.userDB(ctx, userID).Where("read_at IS NULL").Count(&count).Error
+
+	return count, err
+}
+
+// MarkRead marca a notificação do usuário como lida, mantendo a data da primeira leitura.
+// Retorna false se a notificação não existe ou é de outro usuário.
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, readAt time.Time) (bool, error) {
+	result := r.userDB(ctx, userID).
+		Where("id = ?", id).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", readAt))
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error {
+	return r.userDB(ctx, userID).Where("read_at IS NULL").Update("read_at", readAt).Error
+}
+
+type NotificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) gateway.NotificationPreferenceGateway {
+	return &NotificationPreferenceRepository{
+		db: db,
+	}
+}
+
+func (r *NotificationPreferenceRepository) ListByUsers(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error) {
+	var preferences []entity.NotificationPreference
+	if len(userIDs) == 0 {
+		return preferences, nil
+	}
+
+	err := conn(ctx, r.db).Where("user_id IN ?", userIDs).Order("user_id, type").Find(&preferences).Error
+
+	return preferences, err
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, preferences []entity.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+
+	return conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "in_app", "updated_at"}),
+	}).Create(&preferences).Error
+}
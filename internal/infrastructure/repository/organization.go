@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organização não encontrada")
+)
+
+type OrganizationRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationRepository(db *gorm.DB) gateway.OrganizationGateway {
+	return &OrganizationRepository{
+		db: db,
+	}
+}
+
+func (r *OrganizationRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	var organization entity.Organization
+
+	err := r.db.WithContext(ctx).First(&organization, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, err
+	}
+
+	return &organization, nil
+}
+
+// FindByCode retorna nil quando nenhuma organização usa o código.
+func (r *OrganizationRepository) FindByCode(ctx context.Context, code string) (*entity.Organization, error) {
+	var organization entity.Organization
+
+	err := r.db.WithContext(ctx).Where("code = ?", code).First(&organization).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &organization, nil
+}
+
+func (r *OrganizationRepository) ListActive(ctx context.Context) ([]entity.Organization, error) {
+	var organizations []entity.Organization
+
+	err := r.db.WithContext(ctx).Where("active").Order("code").Find(&organizations).Error
+
+	return organizations, err
+}
+
+// Update grava as configurações; somente a própria organização do contexto pode ser alterada.
+func (r *OrganizationRepository) Update(ctx context.Context, organization *entity.Organization) error {
+	if err := checkTenant(ctx, organization.Id); err != nil {
+		return err
+	}
+
+	return r.db.WithContext(ctx).Save(organization).Error
+}
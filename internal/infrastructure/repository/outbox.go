@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) gateway.OutboxGateway {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+// Enqueue grava as mensagens na transação do contexto, se houver, junto com a alteração que as
+// originou.
+func (r *OutboxRepository) Enqueue(ctx context.Context, messages []entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	for _, message := range messages {
+		if err := checkTenant(ctx, message.OrganizationId); err != nil {
+			return err
+		}
+	}
+
+	return conn(ctx, r.db).Create(&messages).Error
+}
+
+// ClaimDue reserva até limit mensagens vencidas por lease. As linhas reservadas por outra
+// instância são puladas (SKIP LOCKED) e a reserva expira sozinha se o worker morrer antes de
+// registrar o resultado, devolvendo a mensagem à fila.
+func (r *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]entity.OutboxMessage, error) {
+	var messages []entity.OutboxMessage
+
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND available_at <= ?", enums.OutboxStatusPending, now).
+			Where("locked_until IS NULL OR locked_until <= ?", now).
+			Order("available_at, created_at").
+			Limit(limit).
+			Find(&messages).Error
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(messages))
+		for i := range messages {
+			ids[i] = messages[i].Id
+		}
+
+		lockedUntil := now.Add(lease)
+		return tx.Model(&entity.OutboxMessage{}).
+			Where("id IN ?", ids).
+			Update("locked_until", lockedUntil).Error
+	})
+
+	return messages, err
+}
+
+// MarkDelivered só altera mensagens ainda pendentes, para que uma entrega repetida após a
+// expiração da reserva não sobrescreva o resultado já registrado.
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error {
+	return conn(ctx, r.db).
+		Model(&entity.OutboxMessage{}).
+		Where("id = ? AND status = ?", id, enums.OutboxStatusPending).
+		Updates(map[string]interface{}{
+			"status":       enums.OutboxStatusDelivered,
+			"delivered_at": deliveredAt,
+			"locked_until": nil,
+			"updated_at":   deliveredAt,
+		}).Error
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, message *entity.OutboxMessage) error {
+	return conn(ctx, r.db).
+		Model(&entity.OutboxMessage{}).
+		Where("id = ? AND status = ?", message.Id, enums.OutboxStatusPending).
+		Updates(map[string]interface{}{
+			"status":       message.Status,
+			"attempts":     message.Attempts,
+			"available_at": message.AvailableAt,
+			"last_error":   message.LastError,
+			"locked_until": nil,
+			"updated_at":   message.UpdatedAt,
+		}).Error
+}
+
+// List retorna as mensagens da organização do contexto com o status informado, as mais
+// recentes primeiro.
+func (r *OutboxRepository) List(ctx context.Context, status enums.OutboxStatus, limit int) ([]entity.OutboxMessage, error) {
+	var messages []entity.OutboxMessage
+
+	err := scopeTenant(ctx, conn(ctx, r.db), "organization_id").
+		Where("status = ?", status).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&messages).Error
+
+	return messages, err
+}
+
+// Requeue devolve uma mensagem DEAD à fila com as tentativas zeradas; retorna false se ela não
+// existir na organização do contexto ou não estiver DEAD.
+func (r *OutboxRepository) Requeue(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	result := scopeTenant(ctx, conn(ctx, r.db), "organization_id").
+		Model(&entity.OutboxMessage{}).
+		Where("id = ? AND status = ?", id, enums.OutboxStatusDead).
+		Updates(map[string]interface{}{
+			"status":       enums.OutboxStatusPending,
+			"attempts":     0,
+			"available_at": now,
+			"locked_until": nil,
+			"updated_at":   now,
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type PolicyRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPolicyRuleRepository(db *gorm.DB) gateway.PolicyRuleGateway {
+	return &PolicyRuleRepository{
+		db: db,
+	}
+}
+
+// ListActive retorna as regras ativas que valem para todas as organizações e as próprias da
+// organização do contexto.
+func (r *PolicyRuleRepository) ListActive(ctx context.Context) ([]entity.TravelPolicyRule, error) {
+	var rules []entity.TravelPolicyRule
+
+	query := r.db.WithContext(ctx).
+		Where("is_active = ?", true).
+		Order("name")
+
+	if organization, ok := tenant.Organization(ctx); ok {
+		query = query.Where("organization_id IS NULL OR organization_id = ?", organization.Id)
+	} else {
+		query = query.Where("organization_id IS NULL")
+	}
+
+	err := query.Find(&rules).Error
+
+	return rules, err
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// scopeTenant restringe a consulta à organização do contexto. Apenas contextos de sistema
+// (autenticação e tarefas em segundo plano) enxergam todas as organizações; fora deles, um
+// contexto sem organização faz a consulta falhar em vez de expor dados de outra organização.
+func scopeTenant(ctx context.Context, db *gorm.DB, column string) *gorm.DB {
+	if organization, ok := tenant.Organization(ctx); ok {
+		return db.Where(column+" = ?", organization.Id)
+	}
+
+	if !tenant.IsSystem(ctx) {
+		_ = db.AddError(tenant.ErrTenantRequired)
+	}
+
+	return db
+}
+
+// checkTenant garante, antes de uma gravação, que o registro pertença à organização do contexto.
+func checkTenant(ctx context.Context, organizationID uuid.UUID) error {
+	if organization, ok := tenant.Organization(ctx); ok {
+		if organization.Id != organizationID {
+			return tenant.ErrTenantMismatch
+		}
+		return nil
+	}
+
+	if !tenant.IsSystem(ctx) {
+		return tenant.ErrTenantRequired
+	}
+
+	return nil
+}
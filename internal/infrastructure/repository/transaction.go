@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type transactionKey struct{}
+
+type Transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) gateway.Transactor {
+	return &Transactor{
+		db: db,
+	}
+}
+
+// WithinTransaction abre uma transação ou, se o contexto já estiver em uma, um savepoint
+// dentro dela.
+func (t *Transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return conn(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, transactionKey{}, tx))
+	})
+}
+
+// conn devolve a transação em andamento no contexto ou, fora dela, a conexão do repositório.
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
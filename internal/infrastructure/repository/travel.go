@@ -2,13 +2,20 @@ package repository
 
 import (
 	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/domain/gateway"
 	"challenge-travel-api/internal/utils"
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -27,63 +34,499 @@ func NewTravelRequestRepository(db *gorm.DB) gateway.TravelRequestGateway {
 	}
 }
 
+// tenantDB inicia uma consulta restrita às solicitações da organização do contexto.
+func (r *TravelRequestRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "travel_requests.organization_id")
+}
+
 func (r *TravelRequestRepository) Create(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return err
+	}
 
-	return r.db.WithContext(ctx).Create(travelRequest).Error
+	return conn(ctx, r.db).Create(travelRequest).Error
 }
 
 func (r *TravelRequestRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelRequest, error) {
 	var travelRequest entity.TravelRequest
 
-	err := r.db.WithContext(ctx).First(&travelRequest, id).Error
+	err := r.tenantDB(ctx).
+		Preload("User").
+		Preload("CostItems").
+		Preload("PolicyViolations").
+		Preload("Travelers.User").
+		Preload("Group").
+		Preload("Destination").
+		Preload("CostCenter").
+		Preload("Allocations.CostCenter").
+		First(&travelRequest, id).Error
 
-	if err != nil && err == gorm.ErrRecordNotFound {
-		return nil, ErrTravelRequestNotFound
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTravelRequestNotFound
+		}
+		return nil, err
 	}
 
 	return &travelRequest, nil
 }
 
-func (r *TravelRequestRepository) Update(ctx context.Context, travelRequest *entity.TravelRequest) error {
-	return r.db.WithContext(ctx).Updates(travelRequest).Where("id = ?", travelRequest.Id).Error
+// FindByIDs carrega várias solicitações de uma vez; IDs inexistentes são ignorados.
+func (r *TravelRequestRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.TravelRequest, error) {
+	var requests []entity.TravelRequest
+
+	err := r.tenantDB(ctx).
+		Preload("User").
+		Preload("Travelers.User").
+		Preload("Group").
+		Where("id IN ?", ids).
+		Find(&requests).Error
+
+	return requests, err
+}
+
+// Update grava os dados de um rascunho ou de uma solicitação ainda pendente. O status e as colunas
+// da decisão ficam de fora, pois mudam apenas por UpdateStatus; false indica que o status mudou
+// antes da gravação.
+func (r *TravelRequestRepository) Update(ctx context.Context, travelRequest *entity.TravelRequest) (bool, error) {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return false, err
+	}
+
+	if !travelRequest.IsEditable() {
+		return false, nil
+	}
+
+	result := conn(ctx, r.db).
+		Model(travelRequest).
+		Select("*").
+		Omit(clause.Associations, "status", "approved_by", "approved_at", "canceled_by", "canceled_at").
+		Where("status = ?", travelRequest.Status).
+		Updates(travelRequest)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
 }
 
-func (r *TravelRequestRepository) List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+// UpdateStatus grava a decisão somente se o status ainda for previousStatus. A alteração é um
+// único UPDATE condicional, atômico por solicitação; false indica que outra operação mudou o
+// status antes. A data da ocorrência e a marca de exceção seguem na mesma gravação, pois o
+// cancelamento de ocorrências de uma série altera esses campos junto com o status.
+func (r *TravelRequestRepository) UpdateStatus(ctx context.Context, travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) (bool, error) {
+	result := r.tenantDB(ctx).
+		Model(&entity.TravelRequest{}).
+		Where("id = ? AND status = ?", travelRequest.Id, previousStatus).
+		Updates(map[string]interface{}{
+			"status":           travelRequest.Status,
+			"canceled_by":      travelRequest.CanceledBy,
+			"canceled_at":      travelRequest.CanceledAt,
+			"approved_by":      travelRequest.ApprovedBy,
+			"approved_at":      travelRequest.ApprovedAt,
+			"updated_at":       travelRequest.UpdatedAt,
+			"occurrence_date":  travelRequest.OccurrenceDate,
+			"series_exception": travelRequest.SeriesException,
+		})
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// List retorna uma página das solicitações de todos os usuários que atendem aos filtros,
+// junto com o total sem paginação. Sem ordenação informada, as mais recentes vêm primeiro.
+func (r *TravelRequestRepository) List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
+	query := r.applyFilters(r.tenantDB(ctx).Model(&entity.TravelRequest{}), filters).
+		Preload("User").
+		Preload("Travelers").
+		Preload("CostCenter").
+		Preload("Allocations.CostCenter")
+
+	return r.findPage(query, filters)
+}
+
+// findPage carrega a página pedida na ordem dos filtros. Na paginação por deslocamento também
+// conta os registros; com cursor, busca a partir da chave (coluna, id) da última solicitação
+// entregue, o que mantém a ordem estável mesmo com inserções concorrentes.
+func (r *TravelRequestRepository) findPage(query *gorm.DB, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
 	var requests []entity.TravelRequest
-	query := r.db.WithContext(ctx).Find(requests)
+	var total int64
+
+	if cursor := filters.Cursor; cursor != nil {
+		operator := ">"
+		if cursor.SortDesc {
+			operator = "<"
+		}
+		query = query.
+			Where("("+sortColumns[cursor.SortBy]+", id) "+operator+" (?, ?)", cursor.Value, cursor.Id).
+			Limit(filters.PageSize)
+	} else {
+		if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
+
+		if filters.PageSize > 0 {
+			query = query.Limit(filters.PageSize).Offset((filters.Page - 1) * filters.PageSize)
+		}
+	}
+
+	if filters.Search != nil {
+		query = query.Select(searchColumns, sql.Named("q", *filters.Search))
+	}
+
+	err := query.Order(sortClause(filters)).Find(&requests).Error
+
+	return requests, total, err
+}
+
+// searchColumns acrescenta a relevância e os trechos destacados com <mark> a cada solicitação.
+const searchColumns = "travel_requests.*, " +
+	"(SELECT ts_rank(d.document, websearch_to_tsquery('travel_search', @q)) " +
+	"FROM travel_request_search_documents d WHERE d.travel_request_id = travel_requests.id) AS search_rank, " +
+	"ts_headline('travel_search', concat_ws(' ', traveler_name, destination_name, business_purpose, (" +
+	"SELECT string_agg(c.body, ' ') FROM travel_request_comments c " +
+	"WHERE c.travel_request_id = travel_requests.id AND NOT c.internal)), " +
+	"websearch_to_tsquery('travel_search', @q), 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2') AS search_highlight"
+
+var sortColumns = map[enums.TravelRequestSortField]string{
+	enums.TravelRequestSortDepartureDate: "departure_date",
+	enums.TravelRequestSortCreatedAt:     "created_at",
+	enums.TravelRequestSortStatus:        "status",
+	enums.TravelRequestSortDestination:   "destination_name",
+	enums.TravelRequestSortRelevance:     "search_rank",
+}
+
+// sortClause ordena pelo campo pedido (created_at decrescente por padrão) e desempata pelo id,
+// no mesmo sentido, para que a paginação seja estável.
+func sortClause(filters utils.TravelRequestFilters) clause.OrderBy {
+	column, desc := sortColumns[filters.SortBy], filters.SortDesc
+	if column == "" {
+		column, desc = "created_at", true
+	}
+
+	return clause.OrderBy{Columns: []clause.OrderByColumn{
+		{Column: clause.Column{Name: column}, Desc: desc},
+		{Column: clause.Column{Name: "id"}, Desc: desc},
+	}}
+}
+
+func (r *TravelRequestRepository) applyFilters(query *gorm.DB, filters utils.TravelRequestFilters) *gorm.DB {
+	if filters.UserId != nil {
+		query = query.Where("user_id = ?", *filters.UserId)
+	}
+
+	if filters.ApprovedBy != nil {
+		query = query.Where("approved_by = ?", *filters.ApprovedBy)
+	}
 
 	if filters.Status != nil {
-		query = query.Where("status = ?", filters.Status)
+		query = query.Where("status = ?", *filters.Status)
 	}
 
-	if filters.StartDate != nil {
-		query = query.Where("start_date >= ?", filters.StartDate)
+	if len(filters.Statuses) > 0 {
+		query = query.Where("status IN ?", filters.Statuses)
 	}
 
-	if filters.EndDate != nil {
-		query = query.Where("end_date <= ?", filters.EndDate)
+	if filters.Department != nil {
+		query = query.Where("lower(department) = lower(?)", *filters.Department)
 	}
 
 	if filters.DestinationName != nil {
-		query = query.Where("destination ILIKE ?", "%"+*filters.DestinationName+"%")
+		query = query.Where("unaccent(lower(destination_name)) LIKE unaccent(?)", "%"+likeEscaper.Replace(strings.ToLower(*filters.DestinationName))+"%")
 	}
 
-	if filters.UserId != nil {
-		query = query.Where("user_id = ?", filters.UserId)
+	query = whereBetween(query, "departure_date", filters.StartDate, filters.EndDate)
+	query = whereBetween(query, "created_at", filters.CreatedFrom, filters.CreatedTo)
+	query = whereBetween(query, "approved_at", filters.ApprovedFrom, filters.ApprovedTo)
+
+	if filters.Search != nil {
+		query = query.Where(
+			"id IN (SELECT travel_request_id FROM travel_request_search_documents WHERE document @@ websearch_to_tsquery('travel_search', ?))",
+			*filters.Search,
+		)
 	}
 
-	err := query.Error
-	return requests, err
+	if filters.CostCenterId != nil {
+		query = query.Where(
+			"(cost_center_id = @id OR id IN (SELECT travel_request_id FROM travel_cost_allocations WHERE cost_center_id = @id))",
+			sql.Named("id", *filters.CostCenterId),
+		)
+	}
+
+	return query
 }
 
-func (r *TravelRequestRepository) ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+// whereBetween limita column ao intervalo fechado [from, to]; limites nulos são ignorados.
+func whereBetween(query *gorm.DB, column string, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		query = query.Where(column+" >= ?", *from)
+	}
+
+	if to != nil {
+		query = query.Where(column+" <= ?", *to)
+	}
+
+	return query
+}
+
+// ListByUserID retorna uma página das solicitações feitas pelo usuário e daquelas em que ele é
+// um dos viajantes, com o total sem paginação. Com CollapseGroups, cada grupo aparece uma única
+// vez, com os seus membros em Group.
+func (r *TravelRequestRepository) ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
+	visible := func(db *gorm.DB) *gorm.DB {
+		return r.applyFilters(
+			db.Model(&entity.TravelRequest{}).Where("user_id = ? OR id IN (?)", userID, r.travelerRequestIDs(userID)),
+			filters,
+		)
+	}
+
+	var query *gorm.DB
+	if filters.CollapseGroups {
+		representatives := visible(r.tenantDB(ctx)).
+			Select("DISTINCT ON (COALESCE(group_id, id)) id").
+			Order("COALESCE(group_id, id), created_at, id")
+
+		query = r.tenantDB(ctx).
+			Model(&entity.TravelRequest{}).
+			Preload("Group.Members", func(db *gorm.DB) *gorm.DB {
+				return db.Order("created_at, id")
+			}).
+			Preload("Group.Members.Travelers").
+			Where("id IN (?)", representatives)
+	} else {
+		query = visible(r.tenantDB(ctx))
+	}
+
+	return r.findPage(query.Preload("Travelers").Preload("CostCenter"), filters)
+}
+
+// ListPendingApproval retorna as solicitações pendentes de outros usuários, da ida mais próxima
+// para a mais distante; em caso de empate, a registrada há mais tempo vem primeiro.
+func (r *TravelRequestRepository) ListPendingApproval(ctx context.Context, approverID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
 	var requests []entity.TravelRequest
 
-	err := r.db.WithContext(ctx).Find(requests).Where("user_id = ?", userID).Error
+	query := r.tenantDB(ctx).
+		Preload("User").
+		Preload("Travelers").
+		Preload("PolicyViolations").
+		Preload("Group").
+		Where("status = ? AND user_id <> ?", enums.TravelRequestStatusSolicited, approverID).
+		Order("departure_date, created_at, id")
+
+	if filters.PageSize > 0 {
+		query = query.Limit(filters.PageSize).Offset((filters.Page - 1) * filters.PageSize)
+	}
+
+	err := query.Find(&requests).Error
+
+	return requests, err
+}
+
+// CountByStatusExcludingUser conta, por status, as solicitações que não foram feitas por userID.
+func (r *TravelRequestRepository) CountByStatusExcludingUser(ctx context.Context, userID uuid.UUID) (map[enums.TravelRequestStatus]int, error) {
+	var rows []struct {
+		Status enums.TravelRequestStatus
+		Total  int
+	}
+
+	err := r.tenantDB(ctx).
+		Model(&entity.TravelRequest{}).
+		Select("status, COUNT(*) AS total").
+		Where("user_id <> ?", userID).
+		Group("status").
+		Scan(&rows).Error
 
 	if err != nil {
-		return requests, err
+		return nil, err
+	}
+
+	counts := make(map[enums.TravelRequestStatus]int, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Total
 	}
 
-	return requests, nil
+	return counts, nil
+}
+
+// ListBySeriesID retorna as ocorrências de uma série recorrente, em ordem de ida.
+func (r *TravelRequestRepository) ListBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]entity.TravelRequest, error) {
+	var requests []entity.TravelRequest
+
+	err := r.tenantDB(ctx).
+		Preload("User").
+		Preload("Travelers.User").
+		Where("series_id = ?", seriesID).
+		Order("departure_date, id").
+		Find(&requests).Error
+
+	return requests, err
+}
+
+func (r *TravelRequestRepository) travelerRequestIDs(userID uuid.UUID) *gorm.DB {
+	return r.db.Table("travel_request_travelers AS trt").
+		Select("trt.travel_request_id").
+		Joins("JOIN travelers t ON t.id = trt.traveler_id").
+		Where("t.user_id = ?", userID)
+}
+
+// FindOverlapping retorna os IDs das solicitações pendentes ou aprovadas cujo período se
+// sobrepõe ao informado e que compartilham algum viajante. Sem viajantes cadastrados, o
+// conflito é verificado entre as solicitações sem viajantes do mesmo usuário. Dentro de uma
+// transação, os viajantes (ou o usuário) ficam bloqueados até o seu fim, para que outra
+// solicitação não grave um período sobreposto entre a verificação e a gravação.
+func (r *TravelRequestRepository) FindOverlapping(ctx context.Context, userID uuid.UUID, travelerIDs []uuid.UUID, departureDate time.Time, returnDate *time.Time, excludeID *uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+
+	if err := r.lockOverlapKeys(ctx, userID, travelerIDs); err != nil {
+		return nil, err
+	}
+
+	end := departureDate
+	if returnDate != nil {
+		end = *returnDate
+	}
+
+	travelers := r.db.Table("travel_request_travelers AS trt").
+		Select("1").
+		Where("trt.travel_request_id = travel_requests.id")
+
+	// Status literais permitem ao planejador usar o índice parcial idx_travel_requests_active_period.
+	query := r.tenantDB(ctx).
+		Model(&entity.TravelRequest{}).
+		Where(fmt.Sprintf("status IN ('%s', '%s')", enums.TravelRequestStatusSolicited, enums.TravelRequestStatusApproved)).
+		Where("tstzrange(departure_date, COALESCE(return_date, departure_date), '[]') && tstzrange(?::timestamptz, ?::timestamptz, '[]')", departureDate, end).
+		Order("departure_date")
+
+	if len(travelerIDs) > 0 {
+		query = query.Where("EXISTS (?)", travelers.Where("trt.traveler_id IN ?", travelerIDs))
+	} else {
+		query = query.Where("user_id = ? AND NOT EXISTS (?)", userID, travelers)
+	}
+
+	if excludeID != nil {
+		query = query.Where("id <> ?", *excludeID)
+	}
+
+	err := query.Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// lockOverlapKeys toma, em ordem fixa para evitar deadlocks, um advisory lock de transação por
+// viajante ou, sem viajantes, pelo usuário.
+func (r *TravelRequestRepository) lockOverlapKeys(ctx context.Context, userID uuid.UUID, travelerIDs []uuid.UUID) error {
+	keys := make([]string, 0, len(travelerIDs))
+	for _, id := range travelerIDs {
+		keys = append(keys, "travel-overlap:traveler:"+id.String())
+	}
+
+	if len(keys) == 0 {
+		keys = append(keys, "travel-overlap:user:"+userID.String())
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", key).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *TravelRequestRepository) ReplaceTravelers(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).
+		Model(travelRequest).
+		Association("Travelers").
+		Replace(travelRequest.Travelers)
+}
+
+func (r *TravelRequestRepository) ReplaceCostItems(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("travel_request_id = ?", travelRequest.Id).Delete(&entity.TravelCostItem{}).Error; err != nil {
+			return err
+		}
+
+		if len(travelRequest.CostItems) > 0 {
+			if err := tx.Create(&travelRequest.CostItems).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&entity.TravelRequest{}).
+			Where("id = ?", travelRequest.Id).
+			UpdateColumns(map[string]interface{}{
+				"currency":        travelRequest.Currency,
+				"estimated_total": travelRequest.EstimatedTotal,
+			}).Error
+	})
+}
+
+// ReplaceAllocations grava o centro de custo responsável e substitui as parcelas do rateio.
+func (r *TravelRequestRepository) ReplaceAllocations(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("travel_request_id = ?", travelRequest.Id).Delete(&entity.TravelCostAllocation{}).Error; err != nil {
+			return err
+		}
+
+		if len(travelRequest.Allocations) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&travelRequest.Allocations).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&entity.TravelRequest{}).
+			Where("id = ?", travelRequest.Id).
+			UpdateColumn("cost_center_id", travelRequest.CostCenterId).Error
+	})
+}
+
+func (r *TravelRequestRepository) ReplacePolicyViolations(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if err := checkTenant(ctx, travelRequest.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("travel_request_id = ?", travelRequest.Id).Delete(&entity.TravelPolicyViolation{}).Error; err != nil {
+			return err
+		}
+
+		if len(travelRequest.PolicyViolations) == 0 {
+			return nil
+		}
+
+		return tx.Create(&travelRequest.PolicyViolations).Error
+	})
+}
+
+func (r *TravelRequestRepository) SumApprovedTotalByDepartment(ctx context.Context, department string, from, to time.Time, excludeID uuid.UUID) (float64, error) {
+	var total float64
+
+	err := r.tenantDB(ctx).
+		Model(&entity.TravelRequest{}).
+		Select("COALESCE(SUM(estimated_total), 0)").
+		Where("department = ?", department).
+		Where("status = ?", enums.TravelRequestStatusApproved).
+		Where("departure_date >= ? AND departure_date < ?", from, to).
+		Where("id <> ?", excludeID).
+		Scan(&total).Error
+
+	return total, err
 }
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTravelGroupNotFound = errors.New("grupo de viagem não encontrado")
+)
+
+type TravelGroupRepository struct {
+	db *gorm.DB
+}
+
+func NewTravelGroupRepository(db *gorm.DB) gateway.TravelGroupGateway {
+	return &TravelGroupRepository{
+		db: db,
+	}
+}
+
+// Create grava o grupo e as solicitações dos membros (com itens de custo, violações e
+// viajantes) em uma única transação.
+func (r *TravelGroupRepository) Create(ctx context.Context, group *entity.TravelGroup) error {
+	if err := checkTenant(ctx, group.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(group).Error
+}
+
+func (r *TravelGroupRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelGroup, error) {
+	var group entity.TravelGroup
+
+	err := scopeTenant(ctx, conn(ctx, r.db), "organization_id").
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, id")
+		}).
+		Preload("Members.User").
+		Preload("Members.CostItems").
+		Preload("Members.PolicyViolations").
+		Preload("Members.Travelers.User").
+		First(&group, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTravelGroupNotFound
+		}
+		return nil, err
+	}
+
+	return &group, nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTravelSeriesNotFound = errors.New("série de viagens não encontrada")
+)
+
+type TravelSeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewTravelSeriesRepository(db *gorm.DB) gateway.TravelSeriesGateway {
+	return &TravelSeriesRepository{
+		db: db,
+	}
+}
+
+func (r *TravelSeriesRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *TravelSeriesRepository) Create(ctx context.Context, series *entity.TravelSeries) error {
+	if err := checkTenant(ctx, series.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Omit(clause.Associations).Create(series).Error
+}
+
+func (r *TravelSeriesRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelSeries, error) {
+	var series entity.TravelSeries
+
+	err := r.tenantDB(ctx).
+		Preload("Template").
+		Preload("Occurrences", func(db *gorm.DB) *gorm.DB {
+			return db.Order("departure_date, id")
+		}).
+		First(&series, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTravelSeriesNotFound
+		}
+		return nil, err
+	}
+
+	return &series, nil
+}
+
+func (r *TravelSeriesRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelSeries, error) {
+	var series []entity.TravelSeries
+
+	err := r.tenantDB(ctx).
+		Preload("Template").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&series).Error
+
+	return series, err
+}
+
+// ListActive retorna as séries ainda ativas da organização do contexto, percorridas pela geração
+// periódica de ocorrências.
+func (r *TravelSeriesRepository) ListActive(ctx context.Context) ([]entity.TravelSeries, error) {
+	var series []entity.TravelSeries
+
+	err := r.tenantDB(ctx).
+		Where("status = ?", enums.TravelSeriesStatusActive).
+		Order("created_at").
+		Find(&series).Error
+
+	return series, err
+}
+
+func (r *TravelSeriesRepository) Update(ctx context.Context, series *entity.TravelSeries) error {
+	if err := checkTenant(ctx, series.OrganizationId); err != nil {
+		return err
+	}
+
+	result := conn(ctx, r.db).Omit(clause.Associations).Save(series)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTravelSeriesNotFound
+	}
+	return nil
+}
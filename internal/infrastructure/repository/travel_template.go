@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTravelTemplateNotFound = errors.New("modelo de viagem não encontrado")
+)
+
+type TravelTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTravelTemplateRepository(db *gorm.DB) gateway.TravelTemplateGateway {
+	return &TravelTemplateRepository{
+		db: db,
+	}
+}
+
+func (r *TravelTemplateRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *TravelTemplateRepository) Create(ctx context.Context, template *entity.TravelTemplate) error {
+	if err := checkTenant(ctx, template.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(template).Error
+}
+
+func (r *TravelTemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelTemplate, error) {
+	var template entity.TravelTemplate
+
+	err := r.tenantDB(ctx).
+		Preload("Travelers").
+		Preload("CostItems").
+		First(&template, id).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTravelTemplateNotFound
+		}
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (r *TravelTemplateRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelTemplate, error) {
+	var templates []entity.TravelTemplate
+
+	err := r.tenantDB(ctx).
+		Preload("Travelers").
+		Preload("CostItems").
+		Where("user_id = ?", userID).
+		Order("name").
+		Find(&templates).Error
+
+	return templates, err
+}
+
+// Update substitui o modelo inteiro, inclusive viajantes e itens de custo, em uma transação.
+func (r *TravelTemplateRepository) Update(ctx context.Context, template *entity.TravelTemplate) error {
+	if err := checkTenant(ctx, template.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(template).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("template_id = ?", template.Id).Delete(&entity.TravelTemplateCostItem{}).Error; err != nil {
+			return err
+		}
+
+		if len(template.CostItems) > 0 {
+			if err := tx.Create(&template.CostItems).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(template).Association("Travelers").Replace(template.Travelers)
+	})
+}
+
+func (r *TravelTemplateRepository) Delete(ctx context.Context, template *entity.TravelTemplate) error {
+	result := r.tenantDB(ctx).Delete(template)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTravelTemplateNotFound
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// recordingConnPool registra os comandos enviados ao banco e responde a todos com o número de
+// linhas afetadas configurado, o que permite conferir o SQL gerado sem um Postgres.
+type recordingConnPool struct {
+	rowsAffected int64
+	queries      []string
+	args         [][]interface{}
+}
+
+func (p *recordingConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errors.New("prepare não suportado")
+}
+
+func (p *recordingConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.queries = append(p.queries, query)
+	p.args = append(p.args, args)
+	return driver.RowsAffected(p.rowsAffected), nil
+}
+
+func (p *recordingConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("consulta não suportada")
+}
+
+func (p *recordingConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+var assignmentPattern = regexp.MustCompile(`"(\w+)"=\$(\d+)`)
+
+// assignments associa cada coluna do comando ao valor enviado para ela.
+func (p *recordingConnPool) assignments(t *testing.T, i int) map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, match := range assignmentPattern.FindAllStringSubmatch(p.queries[i], -1) {
+		position, err := strconv.Atoi(match[2])
+		require.NoError(t, err)
+		values[match[1]] = p.args[i][position-1]
+	}
+	return values
+}
+
+func newRecordingDB(t *testing.T, rowsAffected int64) (*gorm.DB, *recordingConnPool) {
+	pool := &recordingConnPool{rowsAffected: rowsAffected}
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: pool}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+
+	return db, pool
+}
+
+func TestTravelRequestRepository_UpdateStatus(t *testing.T) {
+	organization := &entity.Organization{Id: uuid.New()}
+	ctx := tenant.WithOrganization(context.Background(), organization)
+
+	t.Run("should release the occurrence date of a rescheduled occurrence with the cancellation", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 1)
+		repo := NewTravelRequestRepository(db)
+
+		seriesID := uuid.New()
+		canceledBy := uuid.New()
+		now := time.Now()
+		travel := &entity.TravelRequest{
+			Id:             uuid.New(),
+			OrganizationId: organization.Id,
+			SeriesId:       &seriesID,
+			OccurrenceDate: nil,
+			Status:         enums.TravelRequestStatusCanceled,
+			CanceledBy:     &canceledBy,
+			CanceledAt:     &now,
+			UpdatedAt:      &now,
+		}
+
+		// Act
+		applied, err := repo.UpdateStatus(ctx, travel, enums.TravelRequestStatusSolicited)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, applied)
+		require.Len(t, pool.queries, 1)
+		assert.Contains(t, pool.queries[0], `(id = $`)
+		assert.Contains(t, pool.queries[0], `AND status = $`)
+		assert.Contains(t, pool.args[0], enums.TravelRequestStatusSolicited)
+
+		values := pool.assignments(t, 0)
+		assert.Equal(t, enums.TravelRequestStatusCanceled, values["status"])
+		assert.Contains(t, values, "occurrence_date")
+		assert.Nil(t, values["occurrence_date"])
+		assert.Equal(t, false, values["series_exception"])
+	})
+
+	t.Run("should keep a canceled occurrence as a series exception", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 1)
+		repo := NewTravelRequestRepository(db)
+
+		seriesID := uuid.New()
+		occurrence := time.Now().AddDate(0, 0, 7)
+		travel := &entity.TravelRequest{
+			Id:              uuid.New(),
+			OrganizationId:  organization.Id,
+			SeriesId:        &seriesID,
+			OccurrenceDate:  &occurrence,
+			SeriesException: true,
+			Status:          enums.TravelRequestStatusCanceled,
+		}
+
+		// Act
+		applied, err := repo.UpdateStatus(ctx, travel, enums.TravelRequestStatusSolicited)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, applied)
+		values := pool.assignments(t, 0)
+		assert.Equal(t, true, values["series_exception"])
+		assert.Equal(t, &occurrence, values["occurrence_date"])
+	})
+
+	t.Run("should report a status changed meanwhile", func(t *testing.T) {
+		// Arrange
+		db, _ := newRecordingDB(t, 0)
+		repo := NewTravelRequestRepository(db)
+
+		travel := &entity.TravelRequest{Id: uuid.New(), OrganizationId: organization.Id, Status: enums.TravelRequestStatusApproved}
+
+		// Act
+		applied, err := repo.UpdateStatus(ctx, travel, enums.TravelRequestStatusSolicited)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, applied)
+	})
+}
+
+func TestTravelRequestRepository_Update(t *testing.T) {
+	organization := &entity.Organization{Id: uuid.New()}
+	ctx := tenant.WithOrganization(context.Background(), organization)
+
+	t.Run("should update only a pending request without touching the decision", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 1)
+		repo := NewTravelRequestRepository(db)
+
+		approvedBy := uuid.New()
+		travel := &entity.TravelRequest{
+			Id:              uuid.New(),
+			OrganizationId:  organization.Id,
+			DestinationName: "Paris",
+			Status:          enums.TravelRequestStatusSolicited,
+			ApprovedBy:      &approvedBy,
+		}
+
+		// Act
+		updated, err := repo.Update(ctx, travel)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, updated)
+		require.Len(t, pool.queries, 1)
+		assert.Contains(t, pool.queries[0], "status = $")
+		assert.Contains(t, pool.args[0], enums.TravelRequestStatusSolicited)
+
+		values := pool.assignments(t, 0)
+		assert.Equal(t, "Paris", values["destination_name"])
+		for _, column := range []string{"status", "approved_by", "approved_at", "canceled_by", "canceled_at"} {
+			assert.NotContains(t, values, column)
+		}
+	})
+
+	t.Run("should keep a draft as a draft", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 1)
+		repo := NewTravelRequestRepository(db)
+
+		travel := &entity.TravelRequest{Id: uuid.New(), OrganizationId: organization.Id, Status: enums.TravelRequestStatusDraft}
+
+		// Act
+		updated, err := repo.Update(ctx, travel)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Contains(t, pool.args[0], enums.TravelRequestStatusDraft)
+		assert.NotContains(t, pool.assignments(t, 0), "status")
+	})
+
+	t.Run("should not write a decided request", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 1)
+		repo := NewTravelRequestRepository(db)
+
+		travel := &entity.TravelRequest{Id: uuid.New(), OrganizationId: organization.Id, Status: enums.TravelRequestStatusApproved}
+
+		// Act
+		updated, err := repo.Update(ctx, travel)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.Empty(t, pool.queries)
+	})
+
+	t.Run("should report a request that is no longer pending", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 0)
+		repo := NewTravelRequestRepository(db)
+
+		travel := &entity.TravelRequest{Id: uuid.New(), OrganizationId: organization.Id, Status: enums.TravelRequestStatusSolicited}
+
+		// Act
+		updated, err := repo.Update(ctx, travel)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, updated)
+		assert.Len(t, pool.queries, 1)
+	})
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTravelerNotFound = errors.New("viajante não encontrado")
+)
+
+type TravelerRepository struct {
+	db *gorm.DB
+}
+
+func NewTravelerRepository(db *gorm.DB) gateway.TravelerGateway {
+	return &TravelerRepository{
+		db: db,
+	}
+}
+
+// tenantDB inicia uma consulta restrita aos viajantes da organização do contexto.
+func (r *TravelerRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "travelers.organization_id")
+}
+
+func (r *TravelerRepository) Create(ctx context.Context, traveler *entity.Traveler) error {
+	if err := checkTenant(ctx, traveler.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(traveler).Error
+}
+
+func (r *TravelerRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Traveler, error) {
+	var traveler entity.Traveler
+
+	err := r.tenantDB(ctx).Preload("Delegates").Where("id = ?", id).First(&traveler).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTravelerNotFound
+		}
+		return nil, err
+	}
+
+	return &traveler, nil
+}
+
+func (r *TravelerRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Traveler, error) {
+	var travelers []entity.Traveler
+
+	err := r.tenantDB(ctx).Preload("Delegates").Where("id IN ?", ids).Find(&travelers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(travelers) != len(ids) {
+		return nil, ErrTravelerNotFound
+	}
+
+	return travelers, nil
+}
+
+func (r *TravelerRepository) Update(ctx context.Context, traveler *entity.Traveler) error {
+	if err := checkTenant(ctx, traveler.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Omit(clause.Associations).Save(traveler).Error
+}
+
+func (r *TravelerRepository) ListManageableBy(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error) {
+	var travelers []entity.Traveler
+
+	err := r.tenantDB(ctx).
+		Preload("Delegates").
+		Where("created_by = ? OR user_id = ? OR id IN (?)",
+			userID,
+			userID,
+			r.db.Model(&entity.TravelerDelegate{}).Select("traveler_id").Where("user_id = ?", userID),
+		).
+		Order("name").
+		Find(&travelers).Error
+
+	return travelers, err
+}
+
+func (r *TravelerRepository) ListAll(ctx context.Context) ([]entity.Traveler, error) {
+	var travelers []entity.Traveler
+
+	err := r.tenantDB(ctx).Preload("Delegates").Order("name").Find(&travelers).Error
+
+	return travelers, err
+}
+
+func (r *TravelerRepository) AddDelegate(ctx context.Context, delegate *entity.TravelerDelegate) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(delegate).Error
+}
+
+func (r *TravelerRepository) RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("traveler_id = ? AND user_id = ?", travelerID, userID).
+		Delete(&entity.TravelerDelegate{}).Error
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
 	"context"
 	"errors"
 
@@ -24,14 +26,23 @@ func NewUserRepository(db *gorm.DB) gateway.UserGateway {
 	}
 }
 
+// tenantDB inicia uma consulta restrita aos usuários da organização do contexto.
+func (r *UserRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "users.organization_id")
+}
+
 func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
+	if err := checkTenant(ctx, user.OrganizationId); err != nil {
+		return err
+	}
+
 	return r.db.WithContext(ctx).Create(user).Error
 }
 
 func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
 	var user entity.User
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
+	err := r.tenantDB(ctx).Where("id = ?", id).First(&user).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
@@ -43,7 +54,7 @@ func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Us
 }
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity.User, error) {
 	var user entity.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := r.tenantDB(ctx).Where("email = ?", email).First(&user).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
@@ -55,7 +66,70 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity
 }
 
 func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
-	result := r.db.WithContext(ctx).Model(user).Where("id = ?", user.Id).Updates(user)
+	result := r.tenantDB(ctx).Model(user).Where("id = ?", user.Id).Updates(user)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) ListActiveByRole(ctx context.Context, role enums.UserType) ([]entity.User, error) {
+	var users []entity.User
+
+	err := r.tenantDB(ctx).
+		Where("role = ? AND is_active AND deleted_at IS NULL", role).
+		Order("name").
+		Find(&users).Error
+
+	return users, err
+}
+
+// FindByEmails carrega vários usuários de uma vez, comparando os e-mails (informados em
+// minúsculas) sem diferenciar maiúsculas; e-mails sem cadastro são ignorados.
+func (r *UserRepository) FindByEmails(ctx context.Context, emails []string) ([]entity.User, error) {
+	var users []entity.User
+
+	err := r.tenantDB(ctx).Where("lower(email) IN ?", emails).Find(&users).Error
+
+	return users, err
+}
+
+// ListReportingLines retorna apenas os usuários que possuem gestor, o suficiente para montar a
+// hierarquia em memória.
+func (r *UserRepository) ListReportingLines(ctx context.Context) ([]entity.User, error) {
+	var users []entity.User
+
+	err := r.tenantDB(ctx).
+		Select("id", "manager_id").
+		Where("manager_id IS NOT NULL").
+		Find(&users).Error
+
+	return users, err
+}
+
+// LockReportingLines toma um advisory lock de transação pela hierarquia da organização do
+// contexto, para que a checagem de ciclos e a gravação de um gestor não se intercalem com outra
+// alteração de hierarquia.
+func (r *UserRepository) LockReportingLines(ctx context.Context) error {
+	organization, ok := tenant.Organization(ctx)
+	if !ok {
+		return tenant.ErrTenantRequired
+	}
+
+	return conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "reporting-lines:"+organization.Id.String()).Error
+}
+
+// UpdateReportingLine grava departamento e gestor, inclusive quando passam a ser nulos, o que
+// Update não faz por ignorar campos vazios.
+func (r *UserRepository) UpdateReportingLine(ctx context.Context, user *entity.User) error {
+	result := r.tenantDB(ctx).
+		Model(user).
+		Where("id = ?", user.Id).
+		Select("department_id", "manager_id", "updated_at").
+		Updates(user)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository_LockReportingLines(t *testing.T) {
+	t.Run("should lock the hierarchy of the organization", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 0)
+		repo := NewUserRepository(db)
+		organization := &entity.Organization{Id: uuid.New()}
+		ctx := tenant.WithOrganization(context.Background(), organization)
+
+		// Act
+		err := repo.LockReportingLines(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, pool.queries, 1)
+		assert.Contains(t, pool.queries[0], "pg_advisory_xact_lock")
+		assert.Equal(t, []interface{}{"reporting-lines:" + organization.Id.String()}, pool.args[0])
+	})
+
+	t.Run("should require an organization", func(t *testing.T) {
+		// Arrange
+		db, pool := newRecordingDB(t, 0)
+		repo := NewUserRepository(db)
+
+		// Act
+		err := repo.LockReportingLines(context.Background())
+
+		// Assert
+		assert.ErrorIs(t, err, tenant.ErrTenantRequired)
+		assert.Empty(t, pool.queries)
+	})
+}
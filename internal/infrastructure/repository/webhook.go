@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("assinatura de webhook não encontrada")
+	ErrWebhookDeliveryNotFound     = errors.New("entrega de webhook não encontrada")
+)
+
+type WebhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) gateway.WebhookSubscriptionGateway {
+	return &WebhookSubscriptionRepository{
+		db: db,
+	}
+}
+
+func (r *WebhookSubscriptionRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *WebhookSubscriptionRepository) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	if err := checkTenant(ctx, subscription.OrganizationId); err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).Create(subscription).Error
+}
+
+func (r *WebhookSubscriptionRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+
+	err := r.tenantDB(ctx).First(&subscription, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *WebhookSubscriptionRepository) List(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	var subscriptions []entity.WebhookSubscription
+
+	err := r.tenantDB(ctx).Order("created_at").Find(&subscriptions).Error
+
+	return subscriptions, err
+}
+
+func (r *WebhookSubscriptionRepository) ListActiveByEvent(ctx context.Context, event enums.WebhookEvent) ([]entity.WebhookSubscription, error) {
+	var subscriptions []entity.WebhookSubscription
+
+	err := r.tenantDB(ctx).
+		Where("active AND ? = ANY(events)", string(event)).
+		Order("created_at").
+		Find(&subscriptions).Error
+
+	return subscriptions, err
+}
+
+func (r *WebhookSubscriptionRepository) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	result := r.tenantDB(ctx).
+		Model(&entity.WebhookSubscription{}).
+		Where("id = ?", subscription.Id).
+		Updates(map[string]interface{}{
+			"url":        subscription.Url,
+			"events":     subscription.Events,
+			"active":     subscription.Active,
+			"updated_at": subscription.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+// Delete remove a assinatura e, em cascata, o histórico de entregas dela.
+func (r *WebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.tenantDB(ctx).Where("id = ?", id).Delete(&entity.WebhookSubscription{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+type WebhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) gateway.WebhookDeliveryGateway {
+	return &WebhookDeliveryRepository{
+		db: db,
+	}
+}
+
+func (r *WebhookDeliveryRepository) tenantDB(ctx context.Context) *gorm.DB {
+	return scopeTenant(ctx, conn(ctx, r.db), "organization_id")
+}
+
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	for _, delivery := range deliveries {
+		if err := checkTenant(ctx, delivery.OrganizationId); err != nil {
+			return err
+		}
+	}
+
+	return conn(ctx, r.db).Create(&deliveries).Error
+}
+
+func (r *WebhookDeliveryRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+
+	err := r.tenantDB(ctx).First(&delivery, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (r *WebhookDeliveryRepository) FindForDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+
+	err := r.tenantDB(ctx).Preload("Subscription").First(&delivery, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// ListBySubscription retorna as entregas mais recentes da assinatura.
+func (r *WebhookDeliveryRepository) ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]entity.WebhookDelivery, error) {
+	var deliveries []entity.WebhookDelivery
+
+	err := r.tenantDB(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+
+	return deliveries, err
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return r.tenantDB(ctx).
+		Model(&entity.WebhookDelivery{}).
+		Where("id = ?", delivery.Id).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_status": delivery.ResponseStatus,
+			"last_error":      delivery.LastError,
+			"last_attempt_at": delivery.LastAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+			"updated_at":      delivery.UpdatedAt,
+		}).Error
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	storage, err := NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("should round-trip and delete files", func(t *testing.T) {
+		// Act
+		putErr := storage.Put(ctx, "travel-requests/abc/file", []byte("agenda"), "text/plain")
+		reader, getErr := storage.Get(ctx, "travel-requests/abc/file")
+		require.NoError(t, getErr)
+		content, _ := io.ReadAll(reader)
+		reader.Close()
+		deleteErr := storage.Delete(ctx, "travel-requests/abc/file")
+		_, missingErr := storage.Get(ctx, "travel-requests/abc/file")
+
+		// Assert
+		assert.NoError(t, putErr)
+		assert.Equal(t, "agenda", string(content))
+		assert.NoError(t, deleteErr)
+		assert.ErrorIs(t, missingErr, ErrObjectNotFound)
+	})
+
+	t.Run("should reject keys escaping the root directory", func(t *testing.T) {
+		err := storage.Put(ctx, "../outside", []byte("x"), "text/plain")
+
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 é um substituto local do S3: guarda os objetos em memória e recusa requisições cuja
+// assinatura SigV4 ou hash de conteúdo não confiram.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	secret  string
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3(bucket, secret string) *fakeS3 {
+	return &fakeS3{bucket: bucket, secret: secret, objects: map[string][]byte{}, types: map[string]string{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	if err := f.verify(r, body); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	prefix := "/" + f.bucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = body
+		f.types[key] = r.Header.Get("Content-Type")
+	case http.MethodGet:
+		content, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[key])
+		w.Write(content)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *fakeS3) verify(r *http.Request, body []byte) error {
+	sum := sha256.Sum256(body)
+	if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) {
+		return fmt.Errorf("XAmzContentSHA256Mismatch")
+	}
+
+	var credential, signedHeaders, signature string
+	for _, part := range strings.Split(strings.TrimPrefix(r.Header.Get("Authorization"), s3Algorithm+" "), ", ") {
+		name, value, _ := strings.Cut(part, "=")
+		switch name {
+		case "Credential":
+			credential = value
+		case "SignedHeaders":
+			signedHeaders = value
+		case "Signature":
+			signature = value
+		}
+	}
+
+	scope := strings.SplitN(credential, "/", 2)
+	if len(scope) != 2 {
+		return fmt.Errorf("AuthorizationHeaderMalformed")
+	}
+	fields := strings.Split(scope[1], "/")
+
+	canonicalRequest, expectedHeaders := CanonicalRequest(r, r.Header.Get("X-Amz-Content-Sha256"))
+	if expectedHeaders != signedHeaders {
+		return fmt.Errorf("SignedHeaders mismatch: %s", signedHeaders)
+	}
+
+	hashed := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, r.Header.Get("X-Amz-Date"), scope[1], hex.EncodeToString(hashed[:])}, "\n")
+	expected := hex.EncodeToString(hmacSHA256(SigningKey(f.secret, fields[0], fields[1], fields[2]), stringToSign))
+
+	if expected != signature {
+		return fmt.Errorf("SignatureDoesNotMatch")
+	}
+
+	return nil
+}
+
+func TestSigningKey(t *testing.T) {
+	t.Run("should derive the key from the AWS documentation example", func(t *testing.T) {
+		key := SigningKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam")
+
+		assert.Equal(t, "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d", hex.EncodeToString(key))
+	})
+}
+
+func TestS3Storage(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeS3("attachments", "secret")
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	newStorage := func(secret string) *S3Storage {
+		storage, err := NewS3Storage(S3Config{
+			Endpoint:        server.URL,
+			Bucket:          "attachments",
+			AccessKeyID:     "access",
+			SecretAccessKey: secret,
+		}, server.Client())
+		require.NoError(t, err)
+		return storage.(*S3Storage)
+	}
+
+	t.Run("should put, get and delete objects with signed requests", func(t *testing.T) {
+		// Arrange
+		storage := newStorage("secret")
+		key := "travel-requests/abc/convite de viagem.pdf"
+
+		// Act
+		putErr := storage.Put(ctx, key, []byte("%PDF-1.4 conteúdo"), "application/pdf")
+		reader, getErr := storage.Get(ctx, key)
+		require.NoError(t, getErr)
+		content, _ := io.ReadAll(reader)
+		reader.Close()
+		deleteErr := storage.Delete(ctx, key)
+		_, missingErr := storage.Get(ctx, key)
+
+		// Assert
+		assert.NoError(t, putErr)
+		assert.Equal(t, "%PDF-1.4 conteúdo", string(content))
+		assert.Equal(t, "application/pdf", fake.types[key])
+		assert.NoError(t, deleteErr)
+		assert.ErrorIs(t, missingErr, ErrObjectNotFound)
+	})
+
+	t.Run("should surface signature errors from the server", func(t *testing.T) {
+		// Arrange
+		storage := newStorage("wrong-secret")
+		storage.now = func() time.Time { return time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+		// Act
+		err := storage.Put(ctx, "travel-requests/abc/file.txt", []byte("hello"), "text/plain")
+
+		// Assert
+		assert.ErrorContains(t, err, "403")
+		assert.ErrorContains(t, err, "SignatureDoesNotMatch")
+	})
+
+	t.Run("should require bucket and credentials", func(t *testing.T) {
+		_, err := NewS3Storage(S3Config{Endpoint: server.URL}, nil)
+
+		assert.Error(t, err)
+	})
+}
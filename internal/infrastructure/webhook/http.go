@@ -0,0 +1,120 @@
+package webhook
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// ErrAddressNotAllowed é devolvido quando a URL da assinatura resolve para a rede interna.
+var ErrAddressNotAllowed = errors.New("destino do webhook na rede interna")
+
+// Cabeçalhos de cada entrega. O destinatário confere a assinatura recalculando o HMAC-SHA256 de
+// "<timestamp>.<corpo>" com o segredo da assinatura e recusa timestamps antigos, para que uma
+// requisição capturada não possa ser repetida depois.
+const (
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	userAgent       = "challenge-travel-api-webhooks/1.0"
+)
+
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender envia as entregas com o prazo informado por requisição. Redirecionamentos não
+// são seguidos: a URL cadastrada é a única que recebe os eventos. O endereço é conferido na
+// conexão, já resolvido, para que um DNS alterado depois do cadastro não leve à rede interna.
+func NewHTTPSender(timeout time.Duration) gateway.WebhookSender {
+	return newHTTPSender(timeout, checkAddress)
+}
+
+func newHTTPSender(timeout time.Duration, control func(network, address string, conn syscall.RawConn) error) *HTTPSender {
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second, Control: control}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+func checkAddress(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !entity.IsWebhookAddressAllowed(ip) {
+		return fmt.Errorf("%w: %s", ErrAddressNotAllowed, host)
+	}
+
+	return nil
+}
+
+// Sign calcula a assinatura enviada em X-Webhook-Signature.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send considera entregue qualquer resposta 2xx; as demais respostas e as falhas de conexão
+// retornam erro para uma nova tentativa.
+func (s *HTTPSender) Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	timestamp := s.now().Unix()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(HeaderID, delivery.Id.String())
+	request.Header.Set(HeaderEvent, string(delivery.Event))
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64<<10))
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("destinatário respondeu %s", response.Status)
+	}
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+	subscription := &entity.WebhookSubscription{Secret: "whsec_segredo-de-teste"}
+	delivery := &entity.WebhookDelivery{
+		Id:      uuid.New(),
+		Event:   enums.WebhookEventTravelApproved,
+		Payload: []byte(`{"event":"travel.approved"}`),
+	}
+
+	newSender := func() *HTTPSender {
+		sender := newHTTPSender(time.Second, nil)
+		sender.now = func() time.Time { return now }
+		return sender
+	}
+
+	t.Run("should post the signed payload", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		subscription.Url = server.URL
+
+		status, err := newSender().Send(context.Background(), subscription, delivery)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, string(delivery.Payload), string(body))
+		assert.Equal(t, delivery.Id.String(), received.Header.Get(HeaderID))
+		assert.Equal(t, "travel.approved", received.Header.Get(HeaderEvent))
+		assert.Equal(t, strconv.FormatInt(now.Unix(), 10), received.Header.Get(HeaderTimestamp))
+		assert.Equal(t, Sign(subscription.Secret, now.Unix(), body), received.Header.Get(HeaderSignature))
+		assert.Regexp(t, "^sha256=[0-9a-f]{64}$", received.Header.Get(HeaderSignature))
+	})
+
+	t.Run("should fail on non-2xx responses and redirects", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/moved" {
+				http.Redirect(w, r, "/", http.StatusFound)
+				return
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		subscription.Url = server.URL
+		status, err := newSender().Send(context.Background(), subscription, delivery)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		assert.EqualError(t, err, "destinatário respondeu 503 Service Unavailable")
+
+		subscription.Url = server.URL + "/moved"
+		status, err = newSender().Send(context.Background(), subscription, delivery)
+		assert.Equal(t, http.StatusFound, status)
+		assert.Error(t, err)
+	})
+
+	t.Run("should refuse to connect to the internal network after resolving the host", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		sender := NewHTTPSender(time.Second)
+
+		for _, target := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+			subscription.Url = target
+			status, err := sender.Send(context.Background(), subscription, delivery)
+
+			assert.Zero(t, status, target)
+			assert.ErrorIs(t, err, ErrAddressNotAllowed, target)
+		}
+		assert.False(t, called)
+	})
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t,
+		"sha256=28d73844c84580182772a1e98a60aeb8f04184b1bef0d4e147cfa59ebf0bfedc",
+		Sign("segredo", 1700000000, []byte(`{}`)),
+	)
+}
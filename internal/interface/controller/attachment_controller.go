@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// multipartOverhead folga para os cabeçalhos e campos do formulário além do próprio arquivo.
+const multipartOverhead = 1 << 20
+
+type AttachmentController struct {
+	attachmentUseCase usecase.AttachmentUseCase
+}
+
+func NewAttachmentController(attachmentUseCase usecase.AttachmentUseCase) *AttachmentController {
+	return &AttachmentController{
+		attachmentUseCase: attachmentUseCase,
+	}
+}
+
+// ListAttachments godoc
+// @Summary Listar anexos
+// @Description Retorna os anexos da solicitação de viagem
+// @Tags attachments
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Success 200 {array} entity.TravelRequestAttachment
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/attachments [get]
+func (c *AttachmentController) ListAttachments(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	attachments, err := c.attachmentUseCase.ListAttachments(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attachments)
+}
+
+// UploadAttachment godoc
+// @Summary Enviar anexo
+// @Description Anexa um arquivo (PDF, imagem, texto ou documento do Office) à solicitação. O campo opcional checksum (SHA-256 em hexadecimal) é conferido com o arquivo recebido
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Param file formData file true "Arquivo"
+// @Param checksum formData string false "SHA-256 do arquivo"
+// @Success 201 {object} entity.TravelRequestAttachment
+// @Failure 400 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Failure 415 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/attachments [post]
+func (c *AttachmentController) UploadAttachment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, c.attachmentUseCase.MaxAttachmentSize()+multipartOverhead)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": usecase.ErrAttachmentTooLarge.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, c.attachmentUseCase.MaxAttachmentSize()+1))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	attachment, err := c.attachmentUseCase.UploadAttachment(ctx.Request.Context(), id, userID, dto.UploadAttachmentDTO{
+		FileName: fileHeader.Filename,
+		Content:  content,
+		Checksum: ctx.PostForm("checksum"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrAttachmentTooLarge):
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+		case errors.Is(err, usecase.ErrAttachmentTypeNotAllowed):
+			ctx.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, attachment)
+}
+
+// DownloadAttachment godoc
+// @Summary Baixar anexo
+// @Description Retorna o conteúdo do anexo após conferir o checksum gravado no envio
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path string true "ID da solicitação de viagem"
+// @Param attachmentId path string true "ID do anexo"
+// @Success 200 {file} file
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/attachments/{attachmentId} [get]
+func (c *AttachmentController) DownloadAttachment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	attachmentID, err := uuid.Parse(ctx.Param("attachmentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	attachment, content, err := c.attachmentUseCase.DownloadAttachment(ctx.Request.Context(), id, attachmentID, userID)
+	if err != nil {
+		if errors.Is(err, usecase.ErrAttachmentCorrupted) {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	ctx.Header("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	ctx.Header("X-Checksum-Sha256", attachment.Checksum)
+	ctx.Data(http.StatusOK, attachment.ContentType, content)
+}
+
+// DeleteAttachment godoc
+// @Summary Excluir anexo
+// @Description Remove um anexo (quem enviou ou administradores)
+// @Tags attachments
+// @Param id path string true "ID da solicitação de viagem"
+// @Param attachmentId path string true "ID do anexo"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/attachments/{attachmentId} [delete]
+func (c *AttachmentController) DeleteAttachment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	attachmentID, err := uuid.Parse(ctx.Param("attachmentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.attachmentUseCase.DeleteAttachment(ctx.Request.Context(), id, attachmentID, userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
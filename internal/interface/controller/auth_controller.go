@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type AuthController struct {
@@ -69,3 +70,30 @@ func (c *AuthController) Login(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response)
 }
+
+// UpdateLocale godoc
+// @Summary Alterar idioma das notificações
+// @Description Define o idioma (pt-BR, en-US ou es) em que o usuário autenticado recebe as notificações
+// @Tags auth
+// @Accept json
+// @Param request body dto.UpdateLocaleDTO true "Idioma"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /me/locale [put]
+func (c *AuthController) UpdateLocale(ctx *gin.Context) {
+	var request dto.UpdateLocaleDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.authUseCase.UpdateLocale(ctx.Request.Context(), userID, request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -29,6 +30,11 @@ func (m *MockAuthUseCase) Login(ctx context.Context, input dto.LoginRequestDTO)
 	return args.Get(0).(dto.LoginResponseDTO), args.Error(1)
 }
 
+func (m *MockAuthUseCase) UpdateLocale(ctx context.Context, userID uuid.UUID, input dto.UpdateLocaleDTO) error {
+	args := m.Called(ctx, userID, input)
+	return args.Error(0)
+}
+
 func setupTestRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
@@ -48,7 +54,6 @@ func TestAuthController_Register(t *testing.T) {
 			Name:     "John Doe",
 			Email:    "john.doe@example.com",
 			Password: "password123",
-			Role:     "USER",
 		}
 
 		mockUseCase.On("Register", mock.Anything, request).Return(nil)
@@ -89,7 +94,6 @@ func TestAuthController_Register(t *testing.T) {
 			Name:     "John Doe",
 			Email:    "existing@example.com",
 			Password: "password123",
-			Role:     "USER",
 		}
 
 		mockUseCase.On("Register", mock.Anything, request).Return(errors.New("Usuário já existe no sistema"))
@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CommentController struct {
+	commentUseCase usecase.CommentUseCase
+}
+
+func NewCommentController(commentUseCase usecase.CommentUseCase) *CommentController {
+	return &CommentController{
+		commentUseCase: commentUseCase,
+	}
+}
+
+// ListComments godoc
+// @Summary Listar comentários
+// @Description Retorna a conversa da solicitação em ordem cronológica; comentários internos aparecem apenas para aprovadores
+// @Tags comments
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Success 200 {array} entity.TravelRequestComment
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/comments [get]
+func (c *CommentController) ListComments(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	comments, err := c.commentUseCase.ListComments(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comments)
+}
+
+// AddComment godoc
+// @Summary Adicionar comentário
+// @Description Publica um comentário na solicitação e notifica a outra parte
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Param request body dto.CreateCommentDTO true "Comentário"
+// @Success 201 {object} entity.TravelRequestComment
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/comments [post]
+func (c *CommentController) AddComment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.CreateCommentDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	comment, err := c.commentUseCase.AddComment(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment)
+}
+
+// UpdateComment godoc
+// @Summary Editar comentário
+// @Description Altera o texto de um comentário (somente o autor)
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Param commentId path string true "ID do comentário"
+// @Param request body dto.UpdateCommentDTO true "Novo texto"
+// @Success 200 {object} entity.TravelRequestComment
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/comments/{commentId} [put]
+func (c *CommentController) UpdateComment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	commentID, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.UpdateCommentDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	comment, err := c.commentUseCase.UpdateComment(ctx.Request.Context(), id, commentID, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment godoc
+// @Summary Excluir comentário
+// @Description Remove um comentário (autor ou administradores)
+// @Tags comments
+// @Param id path string true "ID da solicitação de viagem"
+// @Param commentId path string true "ID do comentário"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/comments/{commentId} [delete]
+func (c *CommentController) DeleteComment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	commentID, err := uuid.Parse(ctx.Param("commentId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.commentUseCase.DeleteComment(ctx.Request.Context(), id, commentID, userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
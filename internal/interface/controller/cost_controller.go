@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CostController struct {
+	costUseCase usecase.CostUseCase
+}
+
+func NewCostController(costUseCase usecase.CostUseCase) *CostController {
+	return &CostController{
+		costUseCase: costUseCase,
+	}
+}
+
+// ListExchangeRates godoc
+// @Summary Listar taxas de câmbio
+// @Description Retorna as taxas de câmbio usadas para converter custos para a moeda base
+// @Tags costs
+// @Produce json
+// @Success 200 {array} entity.ExchangeRate
+// @Security Bearer
+// @Router /exchange-rates [get]
+func (c *CostController) ListExchangeRates(ctx *gin.Context) {
+	rates, err := c.costUseCase.ListExchangeRates(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rates)
+}
+
+// SaveExchangeRate godoc
+// @Summary Cadastrar ou atualizar taxa de câmbio
+// @Description Define quantas unidades da moeda base equivalem a uma unidade da moeda informada (somente administradores)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Param currency path string true "Código ISO 4217 da moeda"
+// @Param request body dto.SaveExchangeRateDTO true "Taxa de câmbio"
+// @Success 200 {object} entity.ExchangeRate
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /exchange-rates/{currency} [put]
+func (c *CostController) SaveExchangeRate(ctx *gin.Context) {
+	var request dto.SaveExchangeRateDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	request.Currency = ctx.Param("currency")
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	rate, err := c.costUseCase.SaveExchangeRate(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rate)
+}
+
+// ListDepartmentBudgets godoc
+// @Summary Listar orçamentos por departamento
+// @Description Retorna os orçamentos de viagem por departamento, opcionalmente filtrados por ano
+// @Tags costs
+// @Produce json
+// @Param year query int false "Ano do orçamento"
+// @Success 200 {array} entity.DepartmentBudget
+// @Security Bearer
+// @Router /budgets [get]
+func (c *CostController) ListDepartmentBudgets(ctx *gin.Context) {
+	var year *int
+	if yearStr := ctx.Query("year"); yearStr != "" {
+		y, err := strconv.Atoi(yearStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Ano inválido"})
+			return
+		}
+		year = &y
+	}
+
+	budgets, err := c.costUseCase.ListDepartmentBudgets(ctx.Request.Context(), year)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, budgets)
+}
+
+// SaveDepartmentBudget godoc
+// @Summary Definir orçamento de um departamento
+// @Description Cria ou atualiza o orçamento anual de viagens de um departamento (somente administradores)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Param request body dto.SaveDepartmentBudgetDTO true "Orçamento do departamento"
+// @Success 200 {object} entity.DepartmentBudget
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /budgets [put]
+func (c *CostController) SaveDepartmentBudget(ctx *gin.Context) {
+	var request dto.SaveDepartmentBudgetDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	budget, err := c.costUseCase.SaveDepartmentBudget(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, budget)
+}
+
+// ListCostCenters godoc
+// @Summary Listar centros de custo
+// @Description Retorna os centros de custo e projetos aceitos nas solicitações, ordenados pelo código
+// @Tags costs
+// @Produce json
+// @Param include_inactive query bool false "Incluir centros inativos"
+// @Success 200 {array} entity.CostCenter
+// @Security Bearer
+// @Router /cost-centers [get]
+func (c *CostController) ListCostCenters(ctx *gin.Context) {
+	includeInactive := false
+	if value := ctx.Query("include_inactive"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "include_inactive inválido"})
+			return
+		}
+		includeInactive = parsed
+	}
+
+	costCenters, err := c.costUseCase.ListCostCenters(ctx.Request.Context(), includeInactive)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, costCenters)
+}
+
+// CreateCostCenter godoc
+// @Summary Cadastrar centro de custo
+// @Description Cadastra um centro de custo ou projeto; o código é único e gravado em maiúsculas (somente administradores)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Param request body dto.SaveCostCenterDTO true "Centro de custo"
+// @Success 201 {object} entity.CostCenter
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /cost-centers [post]
+func (c *CostController) CreateCostCenter(ctx *gin.Context) {
+	var request dto.SaveCostCenterDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	costCenter, err := c.costUseCase.CreateCostCenter(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, costCenter)
+}
+
+// UpdateCostCenter godoc
+// @Summary Atualizar centro de custo
+// @Description Altera código, nome, tipo ou situação de um centro de custo; centros inativos não podem ser usados em novas solicitações (somente administradores)
+// @Tags costs
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do centro de custo"
+// @Param request body dto.SaveCostCenterDTO true "Centro de custo"
+// @Success 200 {object} entity.CostCenter
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /cost-centers/{id} [put]
+func (c *CostController) UpdateCostCenter(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.SaveCostCenterDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	costCenter, err := c.costUseCase.UpdateCostCenter(ctx.Request.Context(), userID, id, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, costCenter)
+}
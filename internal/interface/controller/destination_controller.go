@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type DestinationController struct {
+	destinationUseCase usecase.DestinationUseCase
+}
+
+func NewDestinationController(destinationUseCase usecase.DestinationUseCase) *DestinationController {
+	return &DestinationController{
+		destinationUseCase: destinationUseCase,
+	}
+}
+
+// SearchDestinations godoc
+// @Summary Autocompletar destinos
+// @Description Busca no catálogo por cidade, país, apelido ou código IATA, ignorando acentos
+// @Tags destinations
+// @Produce json
+// @Param q query string false "Termo de busca"
+// @Param limit query int false "Quantidade máxima de resultados" default(10)
+// @Success 200 {array} entity.Destination
+// @Security Bearer
+// @Router /destinations [get]
+func (c *DestinationController) SearchDestinations(ctx *gin.Context) {
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+
+	destinations, err := c.destinationUseCase.SearchDestinations(ctx.Request.Context(), ctx.Query("q"), limit)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, destinations)
+}
+
+// GetDestination godoc
+// @Summary Obter destino do catálogo
+// @Description Retorna uma entrada do catálogo com os aeroportos e o fuso horário
+// @Tags destinations
+// @Produce json
+// @Param id path string true "ID do destino"
+// @Success 200 {object} entity.Destination
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /destinations/{id} [get]
+func (c *DestinationController) GetDestination(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	destination, err := c.destinationUseCase.GetDestination(ctx.Request.Context(), id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, destination)
+}
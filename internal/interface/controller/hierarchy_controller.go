@@ -0,0 +1,292 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// reportingLineImportMaxSize limita o tamanho do CSV de hierarquia aceito na importação.
+const reportingLineImportMaxSize = 5 << 20
+
+var errInvalidReportingLineCSV = errors.New("CSV inválido: o cabeçalho deve conter email, department_code e manager_email")
+
+type HierarchyController struct {
+	hierarchyUseCase usecase.HierarchyUseCase
+}
+
+func NewHierarchyController(hierarchyUseCase usecase.HierarchyUseCase) *HierarchyController {
+	return &HierarchyController{
+		hierarchyUseCase: hierarchyUseCase,
+	}
+}
+
+// ListDepartments godoc
+// @Summary Listar departamentos
+// @Description Retorna os departamentos ordenados pelo código
+// @Tags org
+// @Produce json
+// @Param include_inactive query bool false "Incluir departamentos inativos"
+// @Success 200 {array} entity.Department
+// @Security Bearer
+// @Router /departments [get]
+func (c *HierarchyController) ListDepartments(ctx *gin.Context) {
+	includeInactive := false
+	if value := ctx.Query("include_inactive"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "include_inactive inválido"})
+			return
+		}
+		includeInactive = parsed
+	}
+
+	departments, err := c.hierarchyUseCase.ListDepartments(ctx.Request.Context(), includeInactive)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, departments)
+}
+
+// CreateDepartment godoc
+// @Summary Cadastrar departamento
+// @Description Cadastra um departamento e, opcionalmente, seu responsável; o código é único e gravado em maiúsculas (somente administradores)
+// @Tags org
+// @Accept json
+// @Produce json
+// @Param request body dto.SaveDepartmentDTO true "Departamento"
+// @Success 201 {object} entity.Department
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /departments [post]
+func (c *HierarchyController) CreateDepartment(ctx *gin.Context) {
+	var request dto.SaveDepartmentDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	department, err := c.hierarchyUseCase.CreateDepartment(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, department)
+}
+
+// UpdateDepartment godoc
+// @Summary Atualizar departamento
+// @Description Altera código, nome, responsável ou situação de um departamento (somente administradores)
+// @Tags org
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do departamento"
+// @Param request body dto.SaveDepartmentDTO true "Departamento"
+// @Success 200 {object} entity.Department
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /departments/{id} [put]
+func (c *HierarchyController) UpdateDepartment(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.SaveDepartmentDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	department, err := c.hierarchyUseCase.UpdateDepartment(ctx.Request.Context(), userID, id, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, department)
+}
+
+// AssignReportingLine godoc
+// @Summary Definir departamento e gestor do usuário
+// @Description Substitui o departamento e o gestor do usuário; campos omitidos são removidos e gestores que criariam um ciclo na hierarquia são recusados (somente administradores)
+// @Tags org
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do usuário"
+// @Param request body dto.AssignReportingLineDTO true "Departamento e gestor"
+// @Success 200 {object} dto.UserOrgDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/users/{id}/reporting-line [put]
+func (c *HierarchyController) AssignReportingLine(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.AssignReportingLineDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	user, err := c.hierarchyUseCase.AssignReportingLine(ctx.Request.Context(), userID, id, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+// ImportReportingLines godoc
+// @Summary Importar hierarquia via CSV
+// @Description Aplica um CSV com as colunas email, department_code e manager_email, na ordem do arquivo. Cada linha é aplicada isoladamente e o relatório indica o resultado de cada uma; valores vazios removem o departamento ou o gestor (somente administradores)
+// @Tags org
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV da hierarquia"
+// @Success 200 {object} dto.ReportingLineImportResponseDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/users/reporting-lines/import [post]
+func (c *HierarchyController) ImportReportingLines(ctx *gin.Context) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, reportingLineImportMaxSize+multipartOverhead)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+	defer file.Close()
+
+	rows, err := parseReportingLineCSV(file)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	report, err := c.hierarchyUseCase.ImportReportingLines(ctx.Request.Context(), userID, rows)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// GetApprovers godoc
+// @Summary Consultar aprovadores do usuário
+// @Description Retorna o gestor (pulando gestores inativos) e o responsável pelo departamento a quem as aprovações do usuário são encaminhadas; usuários comuns só consultam a própria hierarquia
+// @Tags org
+// @Produce json
+// @Param id path string true "ID do usuário"
+// @Success 200 {object} dto.ApproversDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /users/{id}/approvers [get]
+func (c *HierarchyController) GetApprovers(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	approvers, err := c.hierarchyUseCase.GetApprovers(ctx.Request.Context(), userID, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, approvers)
+}
+
+// parseReportingLineCSV lê o CSV de hierarquia localizando as colunas pelo cabeçalho, de forma
+// que a ordem delas e colunas extras não importam. Linhas em branco são ignoradas.
+func parseReportingLineCSV(r io.Reader) ([]dto.ReportingLineImportRowDTO, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errInvalidReportingLineCSV
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+
+	emailColumn, hasEmail := columns["email"]
+	departmentColumn, hasDepartment := columns["department_code"]
+	managerColumn, hasManager := columns["manager_email"]
+	if !hasEmail || !hasDepartment || !hasManager {
+		return nil, errInvalidReportingLineCSV
+	}
+
+	field := func(record []string, column int) string {
+		if column >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[column])
+	}
+
+	var rows []dto.ReportingLineImportRowDTO
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV inválido: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := dto.ReportingLineImportRowDTO{
+			Line:           line,
+			Email:          field(record, emailColumn),
+			DepartmentCode: field(record, departmentColumn),
+			ManagerEmail:   field(record, managerColumn),
+		}
+
+		if row.Email == "" && row.DepartmentCode == "" && row.ManagerEmail == "" {
+			continue
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHierarchyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockHierarchyUseCase) ManagerOf(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) DepartmentHeadOf(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) ListDepartments(ctx context.Context, includeInactive bool) ([]entity.Department, error) {
+	args := m.Called(ctx, includeInactive)
+	return args.Get(0).([]entity.Department), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) CreateDepartment(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) UpdateDepartment(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error) {
+	args := m.Called(ctx, userID, id, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) AssignReportingLine(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, input dto.AssignReportingLineDTO) (*dto.UserOrgDTO, error) {
+	args := m.Called(ctx, userID, targetID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.UserOrgDTO), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) ImportReportingLines(ctx context.Context, userID uuid.UUID, rows []dto.ReportingLineImportRowDTO) (*dto.ReportingLineImportResponseDTO, error) {
+	args := m.Called(ctx, userID, rows)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ReportingLineImportResponseDTO), args.Error(1)
+}
+
+func (m *MockHierarchyUseCase) GetApprovers(ctx context.Context, userID uuid.UUID, targetID uuid.UUID) (*dto.ApproversDTO, error) {
+	args := m.Called(ctx, userID, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.ApproversDTO), args.Error(1)
+}
+
+func newCSVUpload(t *testing.T, content string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", "hierarquia.csv")
+	assert.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestHierarchyController_ImportReportingLines(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockHierarchyUseCase)
+	controller := NewHierarchyController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.POST("/admin/users/reporting-lines/import", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.ImportReportingLines(c)
+	})
+
+	t.Run("should locate columns by header and skip blank lines", func(t *testing.T) {
+		// Arrange
+		body, contentType := newCSVUpload(t, "\ufeffManager_Email,email,department_code\n"+
+			"diretora@empresa.com, gestor@empresa.com,FIN\n"+
+			",,\n"+
+			",diretora@empresa.com,\n")
+
+		expected := []dto.ReportingLineImportRowDTO{
+			{Line: 2, Email: "gestor@empresa.com", DepartmentCode: "FIN", ManagerEmail: "diretora@empresa.com"},
+			{Line: 4, Email: "diretora@empresa.com"},
+		}
+		mockUseCase.On("ImportReportingLines", mock.Anything, userID, expected).
+			Return(&dto.ReportingLineImportResponseDTO{Succeeded: 2}, nil).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/admin/users/reporting-lines/import", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"succeeded":2`)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should reject a file without the expected columns", func(t *testing.T) {
+		// Arrange
+		body, contentType := newCSVUpload(t, "email,manager\nana@empresa.com,\n")
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/admin/users/reporting-lines/import", body)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "department_code")
+		mockUseCase.AssertNumberOfCalls(t, "ImportReportingLines", 1)
+	})
+}
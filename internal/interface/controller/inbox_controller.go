@@ -0,0 +1,185 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type InboxController struct {
+	inboxUseCase usecase.InboxUseCase
+}
+
+func NewInboxController(inboxUseCase usecase.InboxUseCase) *InboxController {
+	return &InboxController{
+		inboxUseCase: inboxUseCase,
+	}
+}
+
+// ListNotifications godoc
+// @Summary Caixa de entrada
+// @Description Lista as notificações do usuário autenticado, das mais recentes para as mais antigas, com o total de não lidas
+// @Tags me
+// @Produce json
+// @Param unread query bool false "Somente não lidas"
+// @Param page query int false "Número da página" default(1)
+// @Param page_size query int false "Tamanho da página (máximo 100)" default(20)
+// @Success 200 {object} entity.NotificationPage
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /me/notifications [get]
+func (c *InboxController) ListNotifications(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	unreadOnly := false
+	if value := ctx.Query("unread"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "unread inválido"})
+			return
+		}
+		unreadOnly = parsed
+	}
+
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	notifications, err := c.inboxUseCase.ListNotifications(ctx.Request.Context(), userID, unreadOnly, page, pageSize)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notifications)
+}
+
+// CountUnreadNotifications godoc
+// @Summary Notificações não lidas
+// @Description Retorna quantas notificações do usuário autenticado ainda não foram lidas
+// @Tags me
+// @Produce json
+// @Success 200 {object} dto.UnreadNotificationsDTO
+// @Security Bearer
+// @Router /me/notifications/unread-count [get]
+func (c *InboxController) CountUnreadNotifications(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	count, err := c.inboxUseCase.CountUnread(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.UnreadNotificationsDTO{UnreadCount: count})
+}
+
+// MarkNotificationRead godoc
+// @Summary Marcar notificação como lida
+// @Description Marca como lida uma notificação do usuário autenticado
+// @Tags me
+// @Param id path string true "ID da notificação"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /me/notifications/{id}/read [post]
+func (c *InboxController) MarkNotificationRead(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.inboxUseCase.MarkRead(ctx.Request.Context(), userID, id); err != nil {
+		if errors.Is(err, usecase.ErrNotificationNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Marcar todas as notificações como lidas
+// @Description Marca como lidas todas as notificações do usuário autenticado
+// @Tags me
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /me/notifications/read-all [post]
+func (c *InboxController) MarkAllNotificationsRead(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.inboxUseCase.MarkAllRead(ctx.Request.Context(), userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListNotificationPreferences godoc
+// @Summary Preferências de notificação
+// @Description Retorna, para cada tipo de notificação, se o usuário autenticado a recebe por e-mail e na caixa de entrada
+// @Tags me
+// @Produce json
+// @Success 200 {array} entity.NotificationPreference
+// @Security Bearer
+// @Router /me/notification-preferences [get]
+func (c *InboxController) ListNotificationPreferences(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	preferences, err := c.inboxUseCase.ListPreferences(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preferences)
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Alterar preferências de notificação
+// @Description Define os canais (e-mail e caixa de entrada) de cada tipo informado (STATUS_CHANGE, COMMENT); os demais tipos não mudam
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateNotificationPreferencesDTO true "Preferências"
+// @Success 200 {array} entity.NotificationPreference
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /me/notification-preferences [put]
+func (c *InboxController) UpdateNotificationPreferences(ctx *gin.Context) {
+	var request dto.UpdateNotificationPreferencesDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	preferences, err := c.inboxUseCase.UpdatePreferences(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preferences)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationTemplateController struct {
+	notificationTemplateUseCase usecase.NotificationTemplateUseCase
+}
+
+func NewNotificationTemplateController(notificationTemplateUseCase usecase.NotificationTemplateUseCase) *NotificationTemplateController {
+	return &NotificationTemplateController{
+		notificationTemplateUseCase: notificationTemplateUseCase,
+	}
+}
+
+// ListNotificationTemplates godoc
+// @Summary Listar modelos de notificação
+// @Description Retorna os modelos de e-mail e os idiomas disponíveis para cada um (somente administradores)
+// @Tags admin
+// @Produce json
+// @Success 200 {array} dto.NotificationTemplateDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/notification-templates [get]
+func (c *NotificationTemplateController) ListNotificationTemplates(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	templates, err := c.notificationTemplateUseCase.ListTemplates(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+// PreviewNotificationTemplate godoc
+// @Summary Pré-visualizar modelo de notificação
+// @Description Renderiza o modelo no idioma informado (ou no do administrador) com uma solicitação da organização ou, sem travel_request_id, com uma solicitação de exemplo (somente administradores)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body dto.PreviewNotificationDTO true "Modelo, idioma e solicitação"
+// @Success 200 {object} dto.NotificationPreviewDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/notification-templates/preview [post]
+func (c *NotificationTemplateController) PreviewNotificationTemplate(ctx *gin.Context) {
+	var request dto.PreviewNotificationDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	preview, err := c.notificationTemplateUseCase.PreviewTemplate(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, preview)
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrganizationController struct {
+	organizationUseCase usecase.OrganizationUseCase
+}
+
+func NewOrganizationController(organizationUseCase usecase.OrganizationUseCase) *OrganizationController {
+	return &OrganizationController{
+		organizationUseCase: organizationUseCase,
+	}
+}
+
+// GetOrganization godoc
+// @Summary Consultar organização
+// @Description Retorna a organização do usuário autenticado e suas configurações
+// @Tags organization
+// @Produce json
+// @Success 200 {object} entity.Organization
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /organization [get]
+func (c *OrganizationController) GetOrganization(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	organization, err := c.organizationUseCase.GetOrganization(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organization)
+}
+
+// UpdateOrganization godoc
+// @Summary Atualizar configurações da organização
+// @Description Altera nome, moeda base e fuso horário padrão da organização. A moeda base vale para as próximas estimativas; as cotações cadastradas precisam ser atualizadas para a nova moeda (somente administradores)
+// @Tags organization
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateOrganizationDTO true "Configurações"
+// @Success 200 {object} entity.Organization
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /organization [put]
+func (c *OrganizationController) UpdateOrganization(ctx *gin.Context) {
+	var request dto.UpdateOrganizationDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	organization, err := c.organizationUseCase.UpdateOrganization(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, organization)
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/usecase"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OutboxController struct {
+	outboxUseCase usecase.OutboxUseCase
+}
+
+func NewOutboxController(outboxUseCase usecase.OutboxUseCase) *OutboxController {
+	return &OutboxController{
+		outboxUseCase: outboxUseCase,
+	}
+}
+
+// ListOutboxMessages godoc
+// @Summary Listar mensagens do outbox
+// @Description Retorna as mensagens de notificação da organização com o status informado, as mais recentes primeiro. Sem status, lista a fila de falhas (DEAD) (somente administradores)
+// @Tags admin
+// @Produce json
+// @Param status query string false "PENDING, DELIVERED ou DEAD (padrão DEAD)"
+// @Success 200 {array} entity.OutboxMessage
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/outbox [get]
+func (c *OutboxController) ListOutboxMessages(ctx *gin.Context) {
+	status := enums.OutboxStatus(strings.ToUpper(ctx.DefaultQuery("status", string(enums.OutboxStatusDead))))
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	messages, err := c.outboxUseCase.ListMessages(ctx.Request.Context(), userID, status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, messages)
+}
+
+// RetryOutboxMessage godoc
+// @Summary Reenviar mensagem do outbox
+// @Description Devolve à fila uma mensagem DEAD, com as tentativas zeradas (somente administradores)
+// @Tags admin
+// @Param id path string true "ID da mensagem"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /admin/outbox/{id}/retry [post]
+func (c *OutboxController) RetryOutboxMessage(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.outboxUseCase.RetryMessage(ctx.Request.Context(), userID, id); err != nil {
+		if errors.Is(err, usecase.ErrOutboxMessageNotFound) {
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PolicyController struct {
+	policyUseCase usecase.PolicyUseCase
+}
+
+func NewPolicyController(policyUseCase usecase.PolicyUseCase) *PolicyController {
+	return &PolicyController{
+		policyUseCase: policyUseCase,
+	}
+}
+
+// ListPolicyRules godoc
+// @Summary Listar regras da política de viagens
+// @Description Retorna as regras ativas avaliadas na criação e alteração das solicitações
+// @Tags policies
+// @Produce json
+// @Success 200 {array} entity.TravelPolicyRule
+// @Security Bearer
+// @Router /policy-rules [get]
+func (c *PolicyController) ListPolicyRules(ctx *gin.Context) {
+	rules, err := c.policyUseCase.ListRules(ctx.Request.Context())
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+}
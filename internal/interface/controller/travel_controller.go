@@ -1,11 +1,19 @@
 package controller
 
 import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/interface/dto"
 	"challenge-travel-api/internal/usecase"
+	"challenge-travel-api/internal/utils"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -49,7 +57,7 @@ func (c *TravelController) CreateTravelRequest(ctx *gin.Context) {
 	)
 
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
 		return
 	}
 
@@ -66,6 +74,7 @@ func (c *TravelController) CreateTravelRequest(ctx *gin.Context) {
 // @Param request body dto.UpdateTravelRequestDTO true "Dados atualizados da solicitação"
 // @Success 200 {object} entity.TravelRequest
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Security Bearer
 // @Router /travels/{id} [put]
 func (c *TravelController) UpdateTravelRequest(ctx *gin.Context) {
@@ -91,7 +100,54 @@ func (c *TravelController) UpdateTravelRequest(ctx *gin.Context) {
 	)
 
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if errors.Is(err, usecase.ErrStatusChangedMeanwhile) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, travel)
+}
+
+// SubmitTravelRequest godoc
+// @Summary Enviar um rascunho para aprovação
+// @Description Envia um rascunho do usuário, como a cópia de outra solicitação, para aprovação. A sobreposição e as políticas são conferidas no envio.
+// @Tags travels
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do rascunho"
+// @Param request body dto.SubmitTravelRequestDTO false "Opções do envio"
+// @Success 200 {object} entity.TravelRequest
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/submit [post]
+func (c *TravelController) SubmitTravelRequest(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.SubmitTravelRequestDTO
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&request); err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+			return
+		}
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	travel, err := c.travelUseCase.SubmitTravelRequest(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		if errors.Is(err, usecase.ErrTravelNotDraft) || errors.Is(err, usecase.ErrStatusChangedMeanwhile) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
 		return
 	}
 
@@ -108,6 +164,7 @@ func (c *TravelController) UpdateTravelRequest(ctx *gin.Context) {
 // @Param request body dto.UpdateStatusTravelRequestDTO true "Novo status da solicitação"
 // @Success 204 "No Content"
 // @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
 // @Security Bearer
 // @Router /travels/{id}/status [patch]
 func (c *TravelController) UpdateStatusTravelRequest(ctx *gin.Context) {
@@ -128,6 +185,10 @@ func (c *TravelController) UpdateStatusTravelRequest(ctx *gin.Context) {
 	)
 
 	if err != nil {
+		if errors.Is(err, usecase.ErrStatusChangedMeanwhile) {
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
@@ -135,6 +196,35 @@ func (c *TravelController) UpdateStatusTravelRequest(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// BulkUpdateStatus godoc
+// @Summary Aprovar ou cancelar solicitações em lote
+// @Description Aplica o mesmo status a várias solicitações (até 100). Cada item é processado de forma independente e o relatório traz o resultado de cada um (SUCCESS, NOT_FOUND, UNAUTHORIZED, INVALID_TRANSITION ou FAILED). Os envolvidos recebem uma única notificação com todas as decisões
+// @Tags travels
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkUpdateStatusDTO true "Solicitações e novo status"
+// @Success 200 {object} dto.BulkUpdateStatusResponseDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/status [patch]
+func (c *TravelController) BulkUpdateStatus(ctx *gin.Context) {
+	var request dto.BulkUpdateStatusDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	report, err := c.travelUseCase.BulkUpdateStatus(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
 // GetTravelRequest godoc
 // @Summary Obter detalhes de uma solicitação de viagem
 // @Description Retorna os detalhes de uma solicitação de viagem específica
@@ -174,9 +264,15 @@ func (c *TravelController) GetTravelRequest(ctx *gin.Context) {
 // @Param start_date query string false "Data inicial (YYYY-MM-DD)"
 // @Param end_date query string false "Data final (YYYY-MM-DD)"
 // @Param destination query string false "Nome do destino"
+// @Param q query string false "Busca textual em viajantes, destino, motivo e comentários, sem distinção de acentos; aceita \"frase exata\", OR e -termo"
 // @Param page query int false "Número da página" default(1)
-// @Param page_size query int false "Tamanho da página" default(10)
-// @Success 200 {array} entity.TravelRequest
+// @Param page_size query int false "Tamanho da página (máximo 100)" default(10)
+// @Param sort query string false "Campo de ordenação (departure_date, created_at, status, destination ou relevance, quando há q), opcionalmente com :asc ou :desc"
+// @Param cursor query string false "Valor de next_cursor de uma resposta anterior; ativa a paginação por cursor (somente com sort departure_date ou created_at)"
+// @Param group_view query string false "expanded (padrão) lista cada viajante; collapsed lista cada grupo uma única vez"
+// @Success 200 {object} entity.TravelRequestPage
+// @Header 200 {string} Link "Links para as páginas first, prev, next e last"
+// @Failure 400 {object} map[string]string
 // @Security Bearer
 // @Router /travels [get]
 func (c *TravelController) ListTravelRequests(ctx *gin.Context) {
@@ -185,9 +281,10 @@ func (c *TravelController) ListTravelRequests(ctx *gin.Context) {
 	statusStr := ctx.Query("status")
 	startDateStr := ctx.Query("start_date")
 	endDateStr := ctx.Query("end_date")
-	destinationName := ctx.Query("destination_name")
+	destinationName := ctx.DefaultQuery("destination", ctx.Query("destination_name"))
 	pageStr := ctx.Query("page")
 	pageSizeStr := ctx.Query("page_size")
+	collapseGroups := ctx.Query("group_view") == "collapsed"
 
 	page, _ := strconv.Atoi(pageStr)
 	if page < 1 {
@@ -225,21 +322,470 @@ func (c *TravelController) ListTravelRequests(ctx *gin.Context) {
 		destName = &destinationName
 	}
 
-	travels, err := c.travelUseCase.ListTravelRequests(
-		ctx.Request.Context(),
-		userID,
-		status,
-		startDate,
-		endDate,
-		destName,
-		page,
-		pageSize,
-	)
+	filters := utils.TravelRequestFilters{
+		Status:          status,
+		StartDate:       startDate,
+		EndDate:         endDate,
+		DestinationName: destName,
+		Search:          optionalStringQuery(ctx, "q"),
+		Page:            page,
+		PageSize:        pageSize,
+		CollapseGroups:  collapseGroups,
+	}
+
+	if err := parseTravelSort(ctx, &filters); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := parseTravelCursor(ctx, &filters); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
+	travels, err := c.travelUseCase.ListTravelRequests(ctx.Request.Context(), userID, filters)
 	if err != nil {
+		if errors.Is(err, utils.ErrInvalidCursor) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	setPaginationLinks(ctx, travels)
 	ctx.JSON(http.StatusOK, travels)
 }
+
+// ListApproverInbox godoc
+// @Summary Caixa de entrada do aprovador
+// @Description Lista as solicitações pendentes de outros usuários que aguardam a decisão do administrador, da ida mais próxima para a mais distante, com a contagem por status, os dias até a ida e as horas desde o registro
+// @Tags travels
+// @Produce json
+// @Param page query int false "Número da página" default(1)
+// @Param page_size query int false "Tamanho da página" default(20)
+// @Success 200 {object} entity.ApproverInbox
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/inbox [get]
+func (c *TravelController) ListApproverInbox(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	page, _ := strconv.Atoi(ctx.Query("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(ctx.Query("page_size"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	inbox, err := c.travelUseCase.ListApproverInbox(ctx.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUnauthorized) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, inbox)
+}
+
+// ListAllTravelRequests godoc
+// @Summary Listar solicitações de todos os usuários
+// @Description Listagem administrativa paginada, das solicitações mais recentes para as mais antigas, com o total de registros que atendem aos filtros. Datas aceitam YYYY-MM-DD ou RFC3339; um limite final só com a data inclui o dia inteiro
+// @Tags admin
+// @Produce json
+// @Param user_id query string false "Solicitante"
+// @Param approved_by query string false "Aprovador"
+// @Param status query []string false "Um ou mais status (SOLICITED, APPROVED, CANCELED), repetidos ou separados por vírgula" collectionFormat(multi)
+// @Param department query string false "Departamento"
+// @Param cost_center_id query string false "Centro de custo responsável ou com parcela no rateio"
+// @Param destination query string false "Nome do destino"
+// @Param departure_from query string false "Ida a partir de"
+// @Param departure_to query string false "Ida até"
+// @Param created_from query string false "Registro a partir de"
+// @Param created_to query string false "Registro até"
+// @Param approved_from query string false "Aprovação a partir de"
+// @Param approved_to query string false "Aprovação até"
+// @Param q query string false "Busca textual em viajantes, destino, motivo e comentários, sem distinção de acentos; aceita \"frase exata\", OR e -termo"
+// @Param page query int false "Número da página" default(1)
+// @Param page_size query int false "Tamanho da página (máximo 100)" default(20)
+// @Param sort query string false "Campo de ordenação (departure_date, created_at, status, destination ou relevance, quando há q), opcionalmente com :asc ou :desc"
+// @Param cursor query string false "Valor de next_cursor de uma resposta anterior; ativa a paginação por cursor (somente com sort departure_date ou created_at)"
+// @Success 200 {object} entity.TravelRequestPage
+// @Header 200 {string} Link "Links para as páginas first, prev, next e last"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /admin/travels [get]
+func (c *TravelController) ListAllTravelRequests(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	filters, err := parseAdminTravelFilters(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := c.travelUseCase.ListAllTravelRequests(ctx.Request.Context(), userID, filters)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUnauthorized) || errors.Is(err, usecase.ErrInvalidStatusFilter) || errors.Is(err, utils.ErrInvalidCursor) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	setPaginationLinks(ctx, page)
+	ctx.JSON(http.StatusOK, page)
+}
+
+// ExportTravelRequests godoc
+// @Summary Exportar solicitações para o financeiro
+// @Description Gera um CSV com as solicitações que atendem aos filtros da listagem administrativa, uma linha por parcela do rateio de centro de custo (somente administradores)
+// @Tags admin
+// @Produce text/csv
+// @Param user_id query string false "Solicitante"
+// @Param approved_by query string false "Aprovador"
+// @Param status query []string false "Um ou mais status (SOLICITED, APPROVED, CANCELED), repetidos ou separados por vírgula" collectionFormat(multi)
+// @Param department query string false "Departamento"
+// @Param cost_center_id query string false "Centro de custo responsável ou com parcela no rateio"
+// @Param destination query string false "Nome do destino"
+// @Param departure_from query string false "Ida a partir de"
+// @Param departure_to query string false "Ida até"
+// @Param created_from query string false "Registro a partir de"
+// @Param created_to query string false "Registro até"
+// @Param approved_from query string false "Aprovação a partir de"
+// @Param approved_to query string false "Aprovação até"
+// @Param q query string false "Busca textual em viajantes, destino, motivo e comentários"
+// @Param sort query string false "Campo de ordenação, opcionalmente com :asc ou :desc"
+// @Success 200 {string} string "Arquivo CSV"
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Security Bearer
+// @Router /admin/travels/export [get]
+func (c *TravelController) ExportTravelRequests(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	filters, err := parseAdminTravelFilters(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	travels, err := c.travelUseCase.ExportTravelRequests(ctx.Request.Context(), userID, filters)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUnauthorized) {
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, usecase.ErrInvalidStatusFilter) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// O arquivo é montado antes do envio para que uma falha na escrita ainda vire um erro HTTP.
+	var buffer bytes.Buffer
+	if err := writeTravelExport(&buffer, travels); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="travels-%s.csv"`, time.Now().Format("20060102")))
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buffer.Bytes())
+}
+
+func writeTravelExport(w io.Writer, travels []entity.TravelRequest) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(travelExportHeader); err != nil {
+		return err
+	}
+
+	for _, travel := range travels {
+		for _, row := range travelExportRows(travel) {
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+var travelExportHeader = []string{
+	"travel_request_id", "status", "requester", "traveler_name", "destination_name",
+	"departure_date", "return_date", "department", "business_purpose",
+	"cost_center_code", "cost_center_name", "cost_center_kind", "allocation_percentage",
+	"allocation_amount", "estimated_total", "currency", "approved_at",
+}
+
+// travelExportRows gera uma linha por parcela do rateio; solicitações sem centro de custo
+// saem em uma única linha com as colunas do centro vazias.
+func travelExportRows(travel entity.TravelRequest) [][]string {
+	formatDate := func(value *time.Time) string {
+		if value == nil {
+			return ""
+		}
+		return value.Format(time.RFC3339)
+	}
+
+	department := ""
+	if travel.Department != nil {
+		department = *travel.Department
+	}
+
+	lines := travel.AllocationLines()
+	if len(lines) == 0 {
+		lines = []entity.TravelCostAllocation{{}}
+	}
+
+	rows := make([][]string, 0, len(lines))
+	for _, line := range lines {
+		code, name, kind, percentage, amount := "", "", "", "", ""
+		if line.CostCenter != nil {
+			code, name, kind = line.CostCenter.Code, line.CostCenter.Name, string(line.CostCenter.Kind)
+		}
+		if line.CostCenterId != uuid.Nil {
+			percentage = strconv.FormatFloat(line.Percentage, 'f', 2, 64)
+			amount = strconv.FormatFloat(line.Amount, 'f', 2, 64)
+		}
+
+		rows = append(rows, []string{
+			travel.Id.String(),
+			string(travel.Status),
+			csvSafe(travel.User.Name),
+			csvSafe(travel.TravelerName),
+			csvSafe(travel.DestinationName),
+			formatDate(&travel.DepartureDate),
+			formatDate(travel.ReturnDate),
+			csvSafe(department),
+			csvSafe(travel.BusinessPurpose),
+			csvSafe(code),
+			csvSafe(name),
+			kind,
+			percentage,
+			amount,
+			strconv.FormatFloat(travel.EstimatedTotal, 'f', 2, 64),
+			travel.Currency,
+			formatDate(travel.ApprovedAt),
+		})
+	}
+
+	return rows
+}
+
+// csvSafe impede que textos livres sejam interpretados como fórmula pelas planilhas.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func parseAdminTravelFilters(ctx *gin.Context) (utils.TravelRequestFilters, error) {
+	var filters utils.TravelRequestFilters
+	var err error
+
+	if filters.UserId, err = optionalUUIDQuery(ctx, "user_id"); err != nil {
+		return filters, err
+	}
+
+	if filters.ApprovedBy, err = optionalUUIDQuery(ctx, "approved_by"); err != nil {
+		return filters, err
+	}
+
+	if filters.CostCenterId, err = optionalUUIDQuery(ctx, "cost_center_id"); err != nil {
+		return filters, err
+	}
+
+	for _, value := range ctx.QueryArray("status") {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filters.Statuses = append(filters.Statuses, enums.TravelRequestStatus(strings.ToUpper(status)))
+			}
+		}
+	}
+
+	filters.Department = optionalStringQuery(ctx, "department")
+	filters.DestinationName = optionalStringQuery(ctx, "destination")
+	filters.Search = optionalStringQuery(ctx, "q")
+
+	ranges := []struct {
+		name  string
+		end   bool
+		value **time.Time
+	}{
+		{"departure_from", false, &filters.StartDate},
+		{"departure_to", true, &filters.EndDate},
+		{"created_from", false, &filters.CreatedFrom},
+		{"created_to", true, &filters.CreatedTo},
+		{"approved_from", false, &filters.ApprovedFrom},
+		{"approved_to", true, &filters.ApprovedTo},
+	}
+	for _, r := range ranges {
+		if *r.value, err = optionalTimeQuery(ctx, r.name, r.end); err != nil {
+			return filters, err
+		}
+	}
+
+	filters.Page, _ = strconv.Atoi(ctx.Query("page"))
+	filters.PageSize, _ = strconv.Atoi(ctx.Query("page_size"))
+
+	if err := parseTravelSort(ctx, &filters); err != nil {
+		return filters, err
+	}
+
+	return filters, parseTravelCursor(ctx, &filters)
+}
+
+// parseTravelSort lê o parâmetro sort no formato campo[:asc|:desc].
+func parseTravelSort(ctx *gin.Context, filters *utils.TravelRequestFilters) error {
+	value := ctx.Query("sort")
+	if value == "" {
+		return nil
+	}
+
+	field, direction, _ := strings.Cut(value, ":")
+	filters.SortBy = enums.TravelRequestSortField(strings.ToLower(field))
+	if !filters.SortBy.IsValid() {
+		return errors.New("sort inválido")
+	}
+
+	switch strings.ToLower(direction) {
+	case "", "asc":
+		filters.SortDesc = false
+	case "desc":
+		filters.SortDesc = true
+	default:
+		return errors.New("sort inválido")
+	}
+
+	return nil
+}
+
+// setPaginationLinks publica no cabeçalho Link (RFC 8288) as páginas vizinhas, preservando os
+// demais parâmetros da requisição. Na paginação por cursor há apenas o link next.
+func setPaginationLinks(ctx *gin.Context, page *entity.TravelRequestPage) {
+	link := func(rel string, set map[string]string) string {
+		query := ctx.Request.URL.Query()
+		query.Del("page")
+		query.Del("cursor")
+		query.Set("page_size", strconv.Itoa(page.PageSize))
+		for key, value := range set {
+			query.Set(key, value)
+		}
+		return fmt.Sprintf("<%s?%s>; rel=\"%s\"", ctx.Request.URL.Path, query.Encode(), rel)
+	}
+
+	if page.Total == nil {
+		if page.NextCursor != "" {
+			ctx.Header("Link", link("next", map[string]string{"cursor": page.NextCursor}))
+		}
+		return
+	}
+
+	pageLink := func(number int, rel string) string {
+		return link(rel, map[string]string{"page": strconv.Itoa(number)})
+	}
+
+	lastPage := 1
+	if page.PageSize > 0 && *page.Total > 0 {
+		lastPage = int((*page.Total + int64(page.PageSize) - 1) / int64(page.PageSize))
+	}
+
+	links := []string{pageLink(1, "first")}
+	if page.Page > 1 {
+		links = append(links, pageLink(min(page.Page-1, lastPage), "prev"))
+	}
+	if page.Page < lastPage {
+		links = append(links, pageLink(page.Page+1, "next"))
+	}
+	links = append(links, pageLink(lastPage, "last"))
+
+	ctx.Header("Link", strings.Join(links, ", "))
+}
+
+// parseTravelCursor lê o parâmetro cursor, que ativa a paginação por chave.
+func parseTravelCursor(ctx *gin.Context, filters *utils.TravelRequestFilters) error {
+	value := ctx.Query("cursor")
+	if value == "" {
+		return nil
+	}
+
+	cursor, err := utils.DecodeTravelRequestCursor(value)
+	if err != nil {
+		return err
+	}
+
+	filters.Cursor = cursor
+	return nil
+}
+
+func optionalStringQuery(ctx *gin.Context, name string) *string {
+	value := strings.TrimSpace(ctx.Query(name))
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
+
+func optionalUUIDQuery(ctx *gin.Context, name string) (*uuid.UUID, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s inválido", name)
+	}
+
+	return &id, nil
+}
+
+// optionalTimeQuery aceita RFC3339 ou YYYY-MM-DD. Quando endOfDay é verdadeiro, uma data sem
+// horário vale até o último instante do dia (em UTC).
+func optionalTimeQuery(ctx *gin.Context, name string, endOfDay bool) (*time.Time, error) {
+	value := ctx.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s inválido", name)
+	}
+
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return &t, nil
+}
+
+func travelErrorResponse(err error) gin.H {
+	var policyErr *usecase.PolicyViolationError
+	if errors.As(err, &policyErr) {
+		return gin.H{"error": err.Error(), "violations": policyErr.Violations}
+	}
+
+	var overlapErr *usecase.OverlappingTravelError
+	if errors.As(err, &overlapErr) {
+		return gin.H{"error": err.Error(), "conflicting_ids": overlapErr.ConflictingIds}
+	}
+
+	return gin.H{"error": err.Error()}
+}
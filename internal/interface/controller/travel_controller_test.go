@@ -5,7 +5,10 @@ import (
 	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"challenge-travel-api/internal/utils"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -38,11 +41,27 @@ func (m *MockTravelUseCase) UpdateTravelRequest(ctx context.Context, id uuid.UUI
 	return args.Get(0).(*entity.TravelRequest), args.Error(1)
 }
 
+func (m *MockTravelUseCase) SubmitTravelRequest(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.SubmitTravelRequestDTO) (*entity.TravelRequest, error) {
+	args := m.Called(ctx, id, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelRequest), args.Error(1)
+}
+
 func (m *MockTravelUseCase) UpdateStatusTravelRequest(ctx context.Context, userId string, input dto.UpdateStatusTravelRequestDTO) error {
 	args := m.Called(ctx, userId, input)
 	return args.Error(0)
 }
 
+func (m *MockTravelUseCase) BulkUpdateStatus(ctx context.Context, userID uuid.UUID, input dto.BulkUpdateStatusDTO) (*dto.BulkUpdateStatusResponseDTO, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*dto.BulkUpdateStatusResponseDTO), args.Error(1)
+}
+
 func (m *MockTravelUseCase) GetByID(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelRequest, error) {
 	args := m.Called(ctx, id, userID)
 	if args.Get(0) == nil {
@@ -51,8 +70,35 @@ func (m *MockTravelUseCase) GetByID(ctx context.Context, id uuid.UUID, userID uu
 	return args.Get(0).(*entity.TravelRequest), args.Error(1)
 }
 
-func (m *MockTravelUseCase) ListTravelRequests(ctx context.Context, userID uuid.UUID, status *enums.TravelRequestStatus, startDate *time.Time, endDate *time.Time, destinationName *string, page int, pageSize int) ([]entity.TravelRequest, error) {
-	args := m.Called(ctx, userID, status, startDate, endDate, destinationName, page, pageSize)
+func (m *MockTravelUseCase) ListTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error) {
+	args := m.Called(ctx, userID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelRequestPage), args.Error(1)
+}
+
+func (m *MockTravelUseCase) ListApproverInbox(ctx context.Context, userID uuid.UUID, page int, pageSize int) (*entity.ApproverInbox, error) {
+	args := m.Called(ctx, userID, page, pageSize)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ApproverInbox), args.Error(1)
+}
+
+func (m *MockTravelUseCase) ListAllTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error) {
+	args := m.Called(ctx, userID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelRequestPage), args.Error(1)
+}
+
+func (m *MockTravelUseCase) ExportTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+	args := m.Called(ctx, userID, filters)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).([]entity.TravelRequest), args.Error(1)
 }
 
@@ -70,8 +116,8 @@ func TestTravelController_CreateTravelRequest(t *testing.T) {
 
 	t.Run("should create travel request successfully", func(t *testing.T) {
 		// Arrange
-		departureDate := time.Now().AddDate(0, 1, 0)
-		returnDate := time.Now().AddDate(0, 2, 0)
+		departureDate := time.Now().AddDate(0, 1, 0).UTC()
+		returnDate := time.Now().AddDate(0, 2, 0).UTC()
 		request := dto.CreateTravelRequestDTO{
 			TravelerName:    "John Doe",
 			DestinationName: "Paris",
@@ -142,8 +188,8 @@ func TestTravelController_UpdateTravelRequest(t *testing.T) {
 
 	t.Run("should update travel request successfully", func(t *testing.T) {
 		// Arrange
-		departureDate := time.Now().AddDate(0, 1, 0)
-		returnDate := time.Now().AddDate(0, 2, 0)
+		departureDate := time.Now().AddDate(0, 1, 0).UTC()
+		returnDate := time.Now().AddDate(0, 2, 0).UTC()
 		request := dto.UpdateTravelRequestDTO{
 			TravelerName:    stringPtr("John Doe Updated"),
 			DestinationName: stringPtr("London"),
@@ -200,6 +246,378 @@ func TestTravelController_UpdateTravelRequest(t *testing.T) {
 	})
 }
 
+func TestTravelController_SubmitTravelRequest(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.POST("/travels/:id/submit", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.SubmitTravelRequest(c)
+	})
+
+	t.Run("should submit a draft without a body", func(t *testing.T) {
+		// Arrange
+		travelID := uuid.New()
+		submitted := &entity.TravelRequest{Id: travelID, UserId: userID, Status: enums.TravelRequestStatusSolicited}
+		mockUseCase.On("SubmitTravelRequest", mock.Anything, travelID, userID, dto.SubmitTravelRequestDTO{}).Return(submitted, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/travels/"+travelID.String()+"/submit", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response entity.TravelRequest
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, enums.TravelRequestStatusSolicited, response.Status)
+	})
+
+	t.Run("should return conflict for requests that are not drafts", func(t *testing.T) {
+		// Arrange
+		travelID := uuid.New()
+		request := dto.SubmitTravelRequestDTO{OverrideOverlap: true}
+		mockUseCase.On("SubmitTravelRequest", mock.Anything, travelID, userID, request).Return(nil, usecase.ErrTravelNotDraft)
+
+		// Act
+		body, _ := json.Marshal(request)
+		req := httptest.NewRequest(http.MethodPost, "/travels/"+travelID.String()+"/submit", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+		mockUseCase.AssertExpectations(t)
+	})
+}
+
+func TestTravelController_BulkUpdateStatus(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.PATCH("/travels/status", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.BulkUpdateStatus(c)
+	})
+
+	t.Run("should return the per-item report", func(t *testing.T) {
+		// Arrange
+		approvedID, missingID := uuid.New(), uuid.New()
+		request := dto.BulkUpdateStatusDTO{
+			TravelRequestIds: []uuid.UUID{approvedID, missingID},
+			Status:           enums.TravelRequestStatusApproved,
+		}
+		report := &dto.BulkUpdateStatusResponseDTO{
+			Status:    enums.TravelRequestStatusApproved,
+			Succeeded: 1,
+			Failed:    1,
+			Results: []dto.BulkStatusItemDTO{
+				{TravelRequestId: approvedID, Result: dto.BulkStatusResultSuccess},
+				{TravelRequestId: missingID, Result: dto.BulkStatusResultNotFound, Error: "solicitação de viagem não encontrada"},
+			},
+		}
+
+		mockUseCase.On("BulkUpdateStatus", mock.Anything, userID, request).Return(report, nil)
+
+		// Act
+		body, _ := json.Marshal(request)
+		req := httptest.NewRequest(http.MethodPatch, "/travels/status", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response dto.BulkUpdateStatusResponseDTO
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, *report, response)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should reject an empty list", func(t *testing.T) {
+		// Act
+		body, _ := json.Marshal(dto.BulkUpdateStatusDTO{TravelRequestIds: []uuid.UUID{}, Status: enums.TravelRequestStatusApproved})
+		req := httptest.NewRequest(http.MethodPatch, "/travels/status", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTravelController_ListTravelRequests(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.GET("/travels", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.ListTravelRequests(c)
+	})
+
+	t.Run("should return the envelope with sort and Link headers", func(t *testing.T) {
+		// Arrange
+		expected := utils.TravelRequestFilters{Search: stringPtr("congresso"), Page: 2, PageSize: 10, SortBy: enums.TravelRequestSortDepartureDate, SortDesc: true}
+		page := &entity.TravelRequestPage{Items: []entity.TravelRequest{{Id: uuid.New()}}, Page: 2, PageSize: 10, Total: int64Ptr(35)}
+		mockUseCase.On("ListTravelRequests", mock.Anything, userID, expected).Return(page, nil).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/travels?page=2&page_size=10&sort=departure_date:desc&q=congresso", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response entity.TravelRequestPage
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(35), *response.Total)
+		assert.Equal(t, 2, response.Page)
+		assert.Equal(t, 10, response.PageSize)
+		assert.Equal(t,
+			`</travels?page=1&page_size=10&q=congresso&sort=departure_date%3Adesc>; rel="first", `+
+				`</travels?page=1&page_size=10&q=congresso&sort=departure_date%3Adesc>; rel="prev", `+
+				`</travels?page=3&page_size=10&q=congresso&sort=departure_date%3Adesc>; rel="next", `+
+				`</travels?page=4&page_size=10&q=congresso&sort=departure_date%3Adesc>; rel="last"`,
+			w.Header().Get("Link"))
+	})
+
+	t.Run("should follow the cursor and link only the next page", func(t *testing.T) {
+		// Arrange
+		cursor := utils.TravelRequestCursor{SortBy: enums.TravelRequestSortCreatedAt, SortDesc: true, Value: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Id: uuid.New()}
+		expected := utils.TravelRequestFilters{Page: 3, PageSize: 10, Cursor: &cursor}
+		page := &entity.TravelRequestPage{Items: []entity.TravelRequest{{Id: uuid.New()}}, PageSize: 10, NextCursor: "next"}
+		mockUseCase.On("ListTravelRequests", mock.Anything, userID, expected).Return(page, nil).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/travels?page=3&cursor="+cursor.Encode(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `</travels?cursor=next&page_size=10>; rel="next"`, w.Header().Get("Link"))
+		assert.NotContains(t, w.Body.String(), `"total"`)
+	})
+
+	t.Run("should reject unknown sort fields and malformed cursors", func(t *testing.T) {
+		for _, query := range []string{"sort=password", "sort=status:sideways", "cursor=not-a-cursor"} {
+			// Act
+			req := httptest.NewRequest(http.MethodGet, "/travels?"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+		mockUseCase.AssertNumberOfCalls(t, "ListTravelRequests", 2)
+	})
+}
+
+func TestTravelController_ListApproverInbox(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.GET("/travels/inbox", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.ListApproverInbox(c)
+	})
+
+	t.Run("should return the inbox with default pagination", func(t *testing.T) {
+		// Arrange
+		inbox := &entity.ApproverInbox{
+			StatusCounts: map[enums.TravelRequestStatus]int{enums.TravelRequestStatusSolicited: 1},
+			Items: []entity.ApproverInboxItem{
+				{TravelRequest: entity.TravelRequest{Id: uuid.New()}, DaysUntilDeparture: 2, AgeHours: 30},
+			},
+		}
+		mockUseCase.On("ListApproverInbox", mock.Anything, userID, 1, 20).Return(inbox, nil).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/travels/inbox", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response entity.ApproverInbox
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, response.StatusCounts[enums.TravelRequestStatusSolicited])
+		assert.Equal(t, 2, response.Items[0].DaysUntilDeparture)
+		assert.Equal(t, 30, response.Items[0].AgeHours)
+	})
+
+	t.Run("should reject users who are not approvers", func(t *testing.T) {
+		// Arrange
+		mockUseCase.On("ListApproverInbox", mock.Anything, userID, 2, 5).Return(nil, usecase.ErrUnauthorized).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/travels/inbox?page=2&page_size=5", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTravelController_ListAllTravelRequests(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.GET("/admin/travels", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.ListAllTravelRequests(c)
+	})
+
+	t.Run("should translate query parameters into filters", func(t *testing.T) {
+		// Arrange
+		requesterID := uuid.New()
+		departureFrom := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		departureTo := time.Date(2030, 1, 31, 23, 59, 59, 999999999, time.UTC)
+		createdFrom := time.Date(2029, 12, 1, 12, 0, 0, 0, time.UTC)
+		expected := utils.TravelRequestFilters{
+			UserId:      &requesterID,
+			Statuses:    []enums.TravelRequestStatus{enums.TravelRequestStatusSolicited, enums.TravelRequestStatusApproved, enums.TravelRequestStatusCanceled},
+			Department:  stringPtr("Vendas"),
+			Search:      stringPtr("lisboa"),
+			StartDate:   &departureFrom,
+			EndDate:     &departureTo,
+			CreatedFrom: &createdFrom,
+			Page:        2,
+			PageSize:    10,
+		}
+		page := &entity.TravelRequestPage{Items: []entity.TravelRequest{{Id: uuid.New()}}, Page: 2, PageSize: 10, Total: int64Ptr(11)}
+		mockUseCase.On("ListAllTravelRequests", mock.Anything, userID, expected).Return(page, nil).Once()
+
+		// Act
+		query := "user_id=" + requesterID.String() +
+			"&status=solicited,APPROVED&status=CANCELED&department=Vendas&q=lisboa" +
+			"&departure_from=2030-01-01&departure_to=2030-01-31&created_from=2029-12-01T12:00:00Z&page=2&page_size=10"
+		req := httptest.NewRequest(http.MethodGet, "/admin/travels?"+query, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response entity.TravelRequestPage
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), *response.Total)
+		assert.Len(t, response.Items, 1)
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should reject malformed filters", func(t *testing.T) {
+		for _, query := range []string{"approved_by=abc", "created_to=31/01/2030"} {
+			// Act
+			req := httptest.NewRequest(http.MethodGet, "/admin/travels?"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+		}
+	})
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestTravelController_ExportTravelRequests(t *testing.T) {
+	// Setup
+	mockUseCase := new(MockTravelUseCase)
+	controller := NewTravelController(mockUseCase)
+	router := setupTestRouter()
+
+	userID := uuid.New()
+	router.GET("/admin/travels/export", func(c *gin.Context) {
+		c.Set("user_id", userID)
+		controller.ExportTravelRequests(c)
+	})
+
+	t.Run("should write one csv row per allocation", func(t *testing.T) {
+		// Arrange
+		costCenterID := uuid.New()
+		commercial := &entity.CostCenter{Id: uuid.New(), Code: "CC-100", Name: "Comercial", Kind: enums.CostCenterKindCostCenter}
+		project := &entity.CostCenter{Id: uuid.New(), Code: "PRJ-7", Name: "Projeto Atlas", Kind: enums.CostCenterKindProject}
+		split := entity.TravelRequest{
+			Id:              uuid.New(),
+			Status:          enums.TravelRequestStatusApproved,
+			User:            entity.User{Name: "@Maria"},
+			TravelerName:    "John Doe",
+			DestinationName: "-Lisboa",
+			BusinessPurpose: "=Feira anual",
+			EstimatedTotal:  1000,
+			Currency:        "BRL",
+			CostCenterId:    &commercial.Id,
+			Allocations: []entity.TravelCostAllocation{
+				{CostCenterId: commercial.Id, Percentage: 70, Amount: 700, CostCenter: commercial},
+				{CostCenterId: project.Id, Percentage: 30, Amount: 300, CostCenter: project},
+			},
+		}
+		legacy := entity.TravelRequest{Id: uuid.New(), Status: enums.TravelRequestStatusSolicited, EstimatedTotal: 50, Currency: "BRL"}
+
+		expected := utils.TravelRequestFilters{CostCenterId: &costCenterID}
+		mockUseCase.On("ExportTravelRequests", mock.Anything, userID, expected).Return([]entity.TravelRequest{split, legacy}, nil).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/admin/travels/export?cost_center_id="+costCenterID.String(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+
+		rows, err := csv.NewReader(w.Body).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, rows, 4)
+		assert.Equal(t, "cost_center_code", rows[0][9])
+		assert.Equal(t, []string{"CC-100", "70.00", "700.00"}, []string{rows[1][9], rows[1][12], rows[1][13]})
+		assert.Equal(t, []string{"PRJ-7", "30.00", "300.00"}, []string{rows[2][9], rows[2][12], rows[2][13]})
+		assert.Equal(t, "'=Feira anual", rows[1][8])
+		assert.Equal(t, "'@Maria", rows[1][2])
+		assert.Equal(t, "'-Lisboa", rows[1][4])
+		assert.Equal(t, []string{legacy.Id.String(), "", "", "50.00"}, []string{rows[3][0], rows[3][9], rows[3][12], rows[3][14]})
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return forbidden for non admin users", func(t *testing.T) {
+		// Arrange
+		mockUseCase.On("ExportTravelRequests", mock.Anything, userID, utils.TravelRequestFilters{}).Return(nil, usecase.ErrUnauthorized).Once()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/admin/travels/export", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelGroupController struct {
+	travelGroupUseCase usecase.TravelGroupUseCase
+}
+
+func NewTravelGroupController(travelGroupUseCase usecase.TravelGroupUseCase) *TravelGroupController {
+	return &TravelGroupController{
+		travelGroupUseCase: travelGroupUseCase,
+	}
+}
+
+// CreateTravelGroup godoc
+// @Summary Criar solicitação de viagem em grupo
+// @Description Cria uma solicitação por viajante com o mesmo roteiro, aprovadas em conjunto (UNIT) ou individualmente (PER_TRAVELER)
+// @Tags travel-groups
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTravelGroupDTO true "Dados do grupo"
+// @Success 201 {object} entity.TravelGroup
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-groups [post]
+func (c *TravelGroupController) CreateTravelGroup(ctx *gin.Context) {
+	var request dto.CreateTravelGroupDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	group, err := c.travelGroupUseCase.CreateTravelGroup(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, group)
+}
+
+// GetTravelGroup godoc
+// @Summary Obter grupo de viagem
+// @Description Retorna o grupo com o status de cada viajante
+// @Tags travel-groups
+// @Produce json
+// @Param id path string true "ID do grupo"
+// @Success 200 {object} entity.TravelGroup
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travel-groups/{id} [get]
+func (c *TravelGroupController) GetTravelGroup(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	group, err := c.travelGroupUseCase.GetTravelGroup(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, group)
+}
+
+// UpdateTravelGroupStatus godoc
+// @Summary Atualizar status do grupo
+// @Description Aprova ou cancela todas as solicitações pendentes do grupo (somente administradores)
+// @Tags travel-groups
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do grupo"
+// @Param request body dto.UpdateTravelGroupStatusDTO true "Novo status"
+// @Success 200 {object} dto.TravelGroupStatusResponseDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-groups/{id}/status [patch]
+func (c *TravelGroupController) UpdateTravelGroupStatus(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.UpdateTravelGroupStatusDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	result, err := c.travelGroupUseCase.UpdateTravelGroupStatus(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,193 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelSeriesController struct {
+	seriesUseCase usecase.TravelSeriesUseCase
+}
+
+func NewTravelSeriesController(seriesUseCase usecase.TravelSeriesUseCase) *TravelSeriesController {
+	return &TravelSeriesController{
+		seriesUseCase: seriesUseCase,
+	}
+}
+
+// CreateTravelSeries godoc
+// @Summary Criar série recorrente
+// @Description Gera solicitações a partir de um modelo seguindo uma RRULE (FREQ=DAILY/WEEKLY/MONTHLY, INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY). As ocorrências são criadas com horizon_days de antecedência (padrão 30)
+// @Tags travel-series
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTravelSeriesDTO true "Modelo, regra e início da série"
+// @Success 201 {object} entity.TravelSeries
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series [post]
+func (c *TravelSeriesController) CreateTravelSeries(ctx *gin.Context) {
+	var request dto.CreateTravelSeriesDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	series, err := c.seriesUseCase.CreateTravelSeries(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, series)
+}
+
+// ListTravelSeries godoc
+// @Summary Listar séries recorrentes
+// @Description Lista as séries do usuário autenticado
+// @Tags travel-series
+// @Produce json
+// @Success 200 {array} entity.TravelSeries
+// @Failure 500 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series [get]
+func (c *TravelSeriesController) ListTravelSeries(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	series, err := c.seriesUseCase.ListTravelSeries(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, series)
+}
+
+// GetTravelSeries godoc
+// @Summary Obter série recorrente
+// @Description Retorna a série com as ocorrências já geradas
+// @Tags travel-series
+// @Produce json
+// @Param id path string true "ID da série"
+// @Success 200 {object} entity.TravelSeries
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series/{id} [get]
+func (c *TravelSeriesController) GetTravelSeries(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	series, err := c.seriesUseCase.GetTravelSeries(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, series)
+}
+
+// UpdateTravelSeries godoc
+// @Summary Alterar a série inteira
+// @Description Aplica a nova regra ou modelo às ocorrências futuras pendentes, que são canceladas e geradas de novo. Ocorrências aprovadas ou editadas individualmente (PUT /travels/{id}) são mantidas
+// @Tags travel-series
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da série"
+// @Param request body dto.UpdateTravelSeriesDTO true "Campos a alterar"
+// @Success 200 {object} entity.TravelSeries
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series/{id} [put]
+func (c *TravelSeriesController) UpdateTravelSeries(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.UpdateTravelSeriesDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	series, err := c.seriesUseCase.UpdateTravelSeries(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, series)
+}
+
+// CancelTravelSeries godoc
+// @Summary Cancelar a série inteira
+// @Description Encerra a série e cancela as ocorrências futuras ainda pendentes
+// @Tags travel-series
+// @Param id path string true "ID da série"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series/{id} [delete]
+func (c *TravelSeriesController) CancelTravelSeries(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.seriesUseCase.CancelTravelSeries(ctx.Request.Context(), id, userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// CancelOccurrence godoc
+// @Summary Cancelar uma ocorrência
+// @Description Cancela uma única ocorrência pendente da série, que não é gerada de novo
+// @Tags travel-series
+// @Param id path string true "ID da série"
+// @Param travelId path string true "ID da solicitação de viagem"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-series/{id}/occurrences/{travelId} [delete]
+func (c *TravelSeriesController) CancelOccurrence(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	travelID, err := uuid.Parse(ctx.Param("travelId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.seriesUseCase.CancelOccurrence(ctx.Request.Context(), id, travelID, userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,230 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelTemplateController struct {
+	templateUseCase usecase.TravelTemplateUseCase
+}
+
+func NewTravelTemplateController(templateUseCase usecase.TravelTemplateUseCase) *TravelTemplateController {
+	return &TravelTemplateController{
+		templateUseCase: templateUseCase,
+	}
+}
+
+// CreateTravelTemplate godoc
+// @Summary Criar modelo de viagem
+// @Description Salva um roteiro recorrente (destino, viajantes, duração e custos) para gerar novas solicitações
+// @Tags travel-templates
+// @Accept json
+// @Produce json
+// @Param request body dto.SaveTravelTemplateDTO true "Dados do modelo"
+// @Success 201 {object} entity.TravelTemplate
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates [post]
+func (c *TravelTemplateController) CreateTravelTemplate(ctx *gin.Context) {
+	var request dto.SaveTravelTemplateDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	template, err := c.templateUseCase.CreateTravelTemplate(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, template)
+}
+
+// ListTravelTemplates godoc
+// @Summary Listar modelos de viagem
+// @Description Lista os modelos do usuário autenticado
+// @Tags travel-templates
+// @Produce json
+// @Success 200 {array} entity.TravelTemplate
+// @Failure 500 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates [get]
+func (c *TravelTemplateController) ListTravelTemplates(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	templates, err := c.templateUseCase.ListTravelTemplates(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, templates)
+}
+
+// GetTravelTemplate godoc
+// @Summary Obter modelo de viagem
+// @Tags travel-templates
+// @Produce json
+// @Param id path string true "ID do modelo"
+// @Success 200 {object} entity.TravelTemplate
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates/{id} [get]
+func (c *TravelTemplateController) GetTravelTemplate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	template, err := c.templateUseCase.GetTravelTemplate(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, template)
+}
+
+// UpdateTravelTemplate godoc
+// @Summary Atualizar modelo de viagem
+// @Description Substitui todos os dados do modelo, inclusive viajantes e itens de custo
+// @Tags travel-templates
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do modelo"
+// @Param request body dto.SaveTravelTemplateDTO true "Dados do modelo"
+// @Success 200 {object} entity.TravelTemplate
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates/{id} [put]
+func (c *TravelTemplateController) UpdateTravelTemplate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.SaveTravelTemplateDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	template, err := c.templateUseCase.UpdateTravelTemplate(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, template)
+}
+
+// DeleteTravelTemplate godoc
+// @Summary Excluir modelo de viagem
+// @Tags travel-templates
+// @Param id path string true "ID do modelo"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates/{id} [delete]
+func (c *TravelTemplateController) DeleteTravelTemplate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.templateUseCase.DeleteTravelTemplate(ctx.Request.Context(), id, userID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// InstantiateTravelTemplate godoc
+// @Summary Gerar solicitação a partir do modelo
+// @Description Cria uma nova solicitação com o roteiro do modelo; sem data de volta, usa a duração do modelo
+// @Tags travel-templates
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do modelo"
+// @Param request body dto.InstantiateTravelTemplateDTO true "Datas da viagem"
+// @Success 201 {object} entity.TravelRequest
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travel-templates/{id}/instantiate [post]
+func (c *TravelTemplateController) InstantiateTravelTemplate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.InstantiateTravelTemplateDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	travelRequest, err := c.templateUseCase.InstantiateTravelTemplate(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, travelRequest)
+}
+
+// CloneTravelRequest godoc
+// @Summary Clonar solicitação de viagem
+// @Description Cria um rascunho com o roteiro, viajantes e custos de outra solicitação, deslocando as datas; o rascunho vai para aprovação em POST /travels/{id}/submit
+// @Tags travels
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da solicitação de viagem"
+// @Param request body dto.CloneTravelRequestDTO true "Nova data de ida ou deslocamento em dias"
+// @Success 201 {object} entity.TravelRequest
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travels/{id}/clone [post]
+func (c *TravelTemplateController) CloneTravelRequest(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.CloneTravelRequestDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	travelRequest, err := c.templateUseCase.CloneTravelRequest(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, travelErrorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, travelRequest)
+}
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TravelerController struct {
+	travelerUseCase usecase.TravelerUseCase
+}
+
+func NewTravelerController(travelerUseCase usecase.TravelerUseCase) *TravelerController {
+	return &TravelerController{
+		travelerUseCase: travelerUseCase,
+	}
+}
+
+// CreateTraveler godoc
+// @Summary Cadastrar viajante
+// @Description Cadastra o perfil de uma pessoa que viaja, com ou sem usuário no sistema
+// @Tags travelers
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateTravelerDTO true "Dados do viajante"
+// @Success 201 {object} entity.Traveler
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travelers [post]
+func (c *TravelerController) CreateTraveler(ctx *gin.Context) {
+	var request dto.CreateTravelerDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	traveler, err := c.travelerUseCase.CreateTraveler(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, traveler)
+}
+
+// ListTravelers godoc
+// @Summary Listar viajantes
+// @Description Retorna os viajantes que o usuário pode gerenciar (todos para administradores)
+// @Tags travelers
+// @Produce json
+// @Success 200 {array} entity.Traveler
+// @Security Bearer
+// @Router /travelers [get]
+func (c *TravelerController) ListTravelers(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	travelers, err := c.travelerUseCase.ListTravelers(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, travelers)
+}
+
+// GetTraveler godoc
+// @Summary Obter viajante
+// @Description Retorna o perfil de um viajante que o usuário pode gerenciar
+// @Tags travelers
+// @Produce json
+// @Param id path string true "ID do viajante"
+// @Success 200 {object} entity.Traveler
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /travelers/{id} [get]
+func (c *TravelerController) GetTraveler(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	traveler, err := c.travelerUseCase.GetTraveler(ctx.Request.Context(), id, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, traveler)
+}
+
+// UpdateTraveler godoc
+// @Summary Atualizar viajante
+// @Description Atualiza o perfil de um viajante
+// @Tags travelers
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do viajante"
+// @Param request body dto.UpdateTravelerDTO true "Dados atualizados do viajante"
+// @Success 200 {object} entity.Traveler
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travelers/{id} [put]
+func (c *TravelerController) UpdateTraveler(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.UpdateTravelerDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	traveler, err := c.travelerUseCase.UpdateTraveler(ctx.Request.Context(), id, userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, traveler)
+}
+
+// AddTravelerDelegate godoc
+// @Summary Adicionar delegado
+// @Description Autoriza um usuário a solicitar viagens em nome do viajante
+// @Tags travelers
+// @Accept json
+// @Param id path string true "ID do viajante"
+// @Param request body dto.TravelerDelegateDTO true "Usuário delegado"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travelers/{id}/delegates [post]
+func (c *TravelerController) AddTravelerDelegate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.TravelerDelegateDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.travelerUseCase.AddDelegate(ctx.Request.Context(), id, userID, request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// RemoveTravelerDelegate godoc
+// @Summary Remover delegado
+// @Description Revoga a autorização de um usuário para solicitar viagens em nome do viajante
+// @Tags travelers
+// @Param id path string true "ID do viajante"
+// @Param userId path string true "ID do usuário delegado"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /travelers/{id}/delegates/{userId} [delete]
+func (c *TravelerController) RemoveTravelerDelegate(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	delegateID, err := uuid.Parse(ctx.Param("userId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.travelerUseCase.RemoveDelegate(ctx.Request.Context(), id, userID, delegateID); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
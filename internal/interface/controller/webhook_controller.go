@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"challenge-travel-api/internal/interface/dto"
+	"challenge-travel-api/internal/usecase"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WebhookController struct {
+	webhookUseCase usecase.WebhookUseCase
+}
+
+func NewWebhookController(webhookUseCase usecase.WebhookUseCase) *WebhookController {
+	return &WebhookController{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// CreateWebhookSubscription godoc
+// @Summary Cadastrar webhook
+// @Description Cadastra uma URL que recebe os eventos escolhidos (travel.created, travel.approved, travel.canceled) assinados com HMAC-SHA256. Sem secret, um segredo é gerado; ele só é exibido nesta resposta (somente administradores)
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWebhookSubscriptionDTO true "Assinatura"
+// @Success 201 {object} dto.WebhookSubscriptionCreatedDTO
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks [post]
+func (c *WebhookController) CreateWebhookSubscription(ctx *gin.Context) {
+	var request dto.CreateWebhookSubscriptionDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	subscription, err := c.webhookUseCase.CreateSubscription(ctx.Request.Context(), userID, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, subscription)
+}
+
+// ListWebhookSubscriptions godoc
+// @Summary Listar webhooks
+// @Description Retorna as assinaturas de webhook da organização (somente administradores)
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} entity.WebhookSubscription
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks [get]
+func (c *WebhookController) ListWebhookSubscriptions(ctx *gin.Context) {
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	subscriptions, err := c.webhookUseCase.ListSubscriptions(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, subscriptions)
+}
+
+// UpdateWebhookSubscription godoc
+// @Summary Atualizar webhook
+// @Description Altera a URL, os eventos ou a situação da assinatura; o segredo é mantido (somente administradores)
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da assinatura"
+// @Param request body dto.UpdateWebhookSubscriptionDTO true "Assinatura"
+// @Success 200 {object} entity.WebhookSubscription
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks/{id} [put]
+func (c *WebhookController) UpdateWebhookSubscription(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	var request dto.UpdateWebhookSubscriptionDTO
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "Erro ao decodificar requisição"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	subscription, err := c.webhookUseCase.UpdateSubscription(ctx.Request.Context(), userID, id, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, subscription)
+}
+
+// DeleteWebhookSubscription godoc
+// @Summary Remover webhook
+// @Description Remove a assinatura e o histórico de entregas dela (somente administradores)
+// @Tags webhooks
+// @Param id path string true "ID da assinatura"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks/{id} [delete]
+func (c *WebhookController) DeleteWebhookSubscription(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	if err := c.webhookUseCase.DeleteSubscription(ctx.Request.Context(), userID, id); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary Listar entregas do webhook
+// @Description Retorna as 100 entregas mais recentes da assinatura, com o resultado da última tentativa (somente administradores)
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "ID da assinatura"
+// @Success 200 {array} entity.WebhookDelivery
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks/{id}/deliveries [get]
+func (c *WebhookController) ListWebhookDeliveries(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	deliveries, err := c.webhookUseCase.ListDeliveries(ctx.Request.Context(), userID, id)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, deliveries)
+}
+
+// RedeliverWebhook godoc
+// @Summary Reenviar entrega do webhook
+// @Description Envia de novo a entrega, com o mesmo ID e corpo; o resultado aparece no histórico de entregas (somente administradores)
+// @Tags webhooks
+// @Produce json
+// @Param deliveryId path string true "ID da entrega"
+// @Success 202 {object} entity.WebhookDelivery
+// @Failure 400 {object} map[string]string
+// @Security Bearer
+// @Router /webhooks/deliveries/{deliveryId}/redeliver [post]
+func (c *WebhookController) RedeliverWebhook(ctx *gin.Context) {
+	id, err := uuid.Parse(ctx.Param("deliveryId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "ID inválido"})
+		return
+	}
+
+	userID := ctx.MustGet("user_id").(uuid.UUID)
+
+	delivery, err := c.webhookUseCase.Redeliver(ctx.Request.Context(), userID, id)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, delivery)
+}
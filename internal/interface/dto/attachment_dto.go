@@ -0,0 +1,9 @@
+package dto
+
+// UploadAttachmentDTO é montado pelo controller a partir do formulário multipart. Checksum é
+// o SHA-256 (hex) calculado pelo cliente, opcional, usado para detectar uploads corrompidos.
+type UploadAttachmentDTO struct {
+	FileName string
+	Content  []byte
+	Checksum string
+}
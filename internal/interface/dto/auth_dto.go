@@ -1,12 +1,17 @@
 package dto
 
-import "challenge-travel-api/internal/domain/enums"
-
+// RegisterRequestDTO cadastra um usuário comum na organização padrão da instalação; o perfil e a
+// organização não são escolhidos no cadastro público. Locale define o idioma das notificações
+// (padrão pt-BR).
 type RegisterRequestDTO struct {
-	Name     string         `json:"name" binding:"required"`
-	Email    string         `json:"email" binding:"required,email"`
-	Password string         `json:"password" binding:"required,min=6"`
-	Role     enums.UserType `json:"role" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+	Locale   string `json:"locale,omitempty"`
+}
+
+type UpdateLocaleDTO struct {
+	Locale string `json:"locale" binding:"required"`
 }
 
 type LoginRequestDTO struct {
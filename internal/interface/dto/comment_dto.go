@@ -0,0 +1,10 @@
+package dto
+
+type CreateCommentDTO struct {
+	Body     string `json:"body" binding:"required"`
+	Internal bool   `json:"internal,omitempty"`
+}
+
+type UpdateCommentDTO struct {
+	Body string `json:"body" binding:"required"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+type CostItemDTO struct {
+	Category    enums.CostCategory `json:"category" binding:"required"`
+	Description string             `json:"description,omitempty"`
+	Amount      float64            `json:"amount" binding:"gte=0"`
+	Currency    string             `json:"currency" binding:"required,len=3"`
+}
+
+type SaveExchangeRateDTO struct {
+	Currency string  `json:"currency"`
+	Rate     float64 `json:"rate" binding:"required,gt=0"`
+}
+
+type SaveDepartmentBudgetDTO struct {
+	Department string  `json:"department" binding:"required"`
+	Year       int     `json:"year" binding:"required"`
+	Amount     float64 `json:"amount" binding:"gte=0"`
+}
+
+// CostAllocationDTO atribui um percentual do custo estimado a um centro de custo.
+type CostAllocationDTO struct {
+	CostCenterId uuid.UUID `json:"cost_center_id" binding:"required"`
+	Percentage   float64   `json:"percentage" binding:"required,gt=0,lte=100"`
+}
+
+type SaveCostCenterDTO struct {
+	Code   string               `json:"code" binding:"required,max=30"`
+	Name   string               `json:"name" binding:"required"`
+	Kind   enums.CostCenterKind `json:"kind" binding:"required"`
+	Active *bool                `json:"active,omitempty"`
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationTemplateDTO struct {
+	Name    string         `json:"name"`
+	Locales []enums.Locale `json:"locales"`
+}
+
+// PreviewNotificationDTO escolhe o modelo e o idioma da prévia. Sem Locale, vale o idioma do
+// administrador; sem TravelRequestId, a prévia usa uma solicitação de exemplo.
+type PreviewNotificationDTO struct {
+	Template        string     `json:"template" binding:"required"`
+	Locale          string     `json:"locale,omitempty"`
+	TravelRequestId *uuid.UUID `json:"travel_request_id,omitempty"`
+}
+
+type NotificationPreviewDTO struct {
+	Template string       `json:"template"`
+	Locale   enums.Locale `json:"locale"`
+	Subject  string       `json:"subject"`
+	TextBody string       `json:"text_body"`
+	HTMLBody string       `json:"html_body"`
+}
+
+// StatusChangeNotificationDTO é o payload das notificações STATUS_CHANGE da caixa de entrada.
+type StatusChangeNotificationDTO struct {
+	TravelRequestId uuid.UUID                 `json:"travel_request_id"`
+	DestinationName string                    `json:"destination_name"`
+	DepartureDate   time.Time                 `json:"departure_date"`
+	Status          enums.TravelRequestStatus `json:"status"`
+	PreviousStatus  enums.TravelRequestStatus `json:"previous_status"`
+}
+
+// CommentNotificationDTO é o payload das notificações COMMENT da caixa de entrada.
+type CommentNotificationDTO struct {
+	TravelRequestId uuid.UUID `json:"travel_request_id"`
+	DestinationName string    `json:"destination_name"`
+	CommentId       uuid.UUID `json:"comment_id"`
+	AuthorName      string    `json:"author_name"`
+	Body            string    `json:"body"`
+}
+
+type UnreadNotificationsDTO struct {
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type NotificationPreferenceDTO struct {
+	Type  enums.NotificationType `json:"type" binding:"required"`
+	Email bool                   `json:"email"`
+	InApp bool                   `json:"in_app"`
+}
+
+// UpdateNotificationPreferencesDTO altera apenas os tipos informados.
+type UpdateNotificationPreferencesDTO struct {
+	Preferences []NotificationPreferenceDTO `json:"preferences" binding:"required,min=1,dive"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+type SaveDepartmentDTO struct {
+	Code       string     `json:"code" binding:"required,max=30"`
+	Name       string     `json:"name" binding:"required"`
+	HeadUserId *uuid.UUID `json:"head_user_id,omitempty"`
+	Active     *bool      `json:"active,omitempty"`
+}
+
+// AssignReportingLineDTO substitui departamento e gestor do usuário; campos omitidos são
+// removidos.
+type AssignReportingLineDTO struct {
+	DepartmentId *uuid.UUID `json:"department_id,omitempty"`
+	ManagerId    *uuid.UUID `json:"manager_id,omitempty"`
+}
+
+type UserOrgDTO struct {
+	Id           uuid.UUID      `json:"id"`
+	Name         string         `json:"name"`
+	Email        string         `json:"email"`
+	Role         enums.UserType `json:"role"`
+	DepartmentId *uuid.UUID     `json:"department_id,omitempty"`
+	ManagerId    *uuid.UUID     `json:"manager_id,omitempty"`
+}
+
+// ReportingLineImportRowDTO é uma linha do CSV de hierarquia; códigos e e-mails vazios removem
+// o departamento ou o gestor do usuário.
+type ReportingLineImportRowDTO struct {
+	Line           int
+	Email          string
+	DepartmentCode string
+	ManagerEmail   string
+}
+
+type ReportingLineImportItemDTO struct {
+	Line  int    `json:"line"`
+	Email string `json:"email"`
+	Error string `json:"error,omitempty"`
+}
+
+type ReportingLineImportResponseDTO struct {
+	Succeeded int                          `json:"succeeded"`
+	Failed    int                          `json:"failed"`
+	Results   []ReportingLineImportItemDTO `json:"results"`
+}
+
+// ApproversDTO indica a quem a aprovação de um usuário é encaminhada; qualquer um dos dois pode
+// faltar.
+type ApproversDTO struct {
+	UserId         uuid.UUID   `json:"user_id"`
+	Manager        *UserOrgDTO `json:"manager,omitempty"`
+	DepartmentHead *UserOrgDTO `json:"department_head,omitempty"`
+}
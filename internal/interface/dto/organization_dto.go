@@ -0,0 +1,10 @@
+package dto
+
+// UpdateOrganizationDTO altera as configurações da organização. Sem DefaultLocale, o idioma
+// padrão atual é mantido.
+type UpdateOrganizationDTO struct {
+	Name            string `json:"name" binding:"required"`
+	BaseCurrency    string `json:"base_currency" binding:"required,len=3"`
+	DefaultTimezone string `json:"default_timezone" binding:"required"`
+	DefaultLocale   string `json:"default_locale,omitempty"`
+}
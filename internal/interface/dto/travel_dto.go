@@ -3,23 +3,90 @@ package dto
 import (
 	"challenge-travel-api/internal/domain/enums"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// CreateTravelRequestDTO descreve uma nova solicitação. Sem CostCenterId, o centro de custo
+// responsável é o de maior percentual em Allocations.
 type CreateTravelRequestDTO struct {
-	TravelerName    string     `json:"traveler_name" binding:"required"`
-	DestinationName string     `json:"destination_name" binding:"required"`
-	DepartureDate   time.Time  `json:"departure_date" binding:"required"`
-	ReturnDate      *time.Time `json:"return_date,omitempty"`
+	TravelerName      string              `json:"traveler_name"`
+	TravelerIds       []uuid.UUID         `json:"traveler_ids,omitempty"`
+	DestinationName   string              `json:"destination_name"`
+	DestinationId     *uuid.UUID          `json:"destination_id,omitempty"`
+	DepartureDate     time.Time           `json:"departure_date" binding:"required"`
+	ReturnDate        *time.Time          `json:"return_date,omitempty"`
+	DepartureTimezone string              `json:"departure_timezone,omitempty"`
+	ReturnTimezone    string              `json:"return_timezone,omitempty"`
+	Department        *string             `json:"department,omitempty"`
+	CostItems         []CostItemDTO       `json:"cost_items,omitempty" binding:"omitempty,dive"`
+	OverrideOverlap   bool                `json:"override_overlap,omitempty"`
+	BusinessPurpose   string              `json:"business_purpose"`
+	CostCenterId      *uuid.UUID          `json:"cost_center_id,omitempty"`
+	Allocations       []CostAllocationDTO `json:"allocations,omitempty" binding:"omitempty,dive"`
+
+	// Preenchidos apenas pela geração de ocorrências de uma série recorrente.
+	SeriesId       *uuid.UUID `json:"-"`
+	OccurrenceDate *time.Time `json:"-"`
+
+	// Draft grava a solicitação como rascunho, sem enviá-la para aprovação; usado pela clonagem.
+	Draft bool `json:"-"`
 }
 
+// UpdateTravelRequestDTO altera uma solicitação pendente. Allocations omitido mantém o rateio
+// atual; uma lista vazia o remove, deixando todo o custo com o centro responsável.
 type UpdateTravelRequestDTO struct {
-	TravelerName    *string    `json:"traveler_name,omitempty"`
-	DestinationName *string    `json:"destination_name,omitempty" `
-	DepartureDate   *time.Time `json:"departure_date,omitempty"`
-	ReturnDate      *time.Time `json:"return_date,omitempty"`
+	TravelerName      *string             `json:"traveler_name,omitempty"`
+	TravelerIds       []uuid.UUID         `json:"traveler_ids,omitempty"`
+	DestinationName   *string             `json:"destination_name,omitempty" `
+	DestinationId     *uuid.UUID          `json:"destination_id,omitempty"`
+	DepartureDate     *time.Time          `json:"departure_date,omitempty"`
+	ReturnDate        *time.Time          `json:"return_date,omitempty"`
+	DepartureTimezone *string             `json:"departure_timezone,omitempty"`
+	ReturnTimezone    *string             `json:"return_timezone,omitempty"`
+	Department        *string             `json:"department,omitempty"`
+	CostItems         []CostItemDTO       `json:"cost_items,omitempty" binding:"omitempty,dive"`
+	OverrideOverlap   bool                `json:"override_overlap,omitempty"`
+	BusinessPurpose   *string             `json:"business_purpose,omitempty"`
+	CostCenterId      *uuid.UUID          `json:"cost_center_id,omitempty"`
+	Allocations       []CostAllocationDTO `json:"allocations,omitempty" binding:"omitempty,dive"`
+}
+
+// SubmitTravelRequestDTO envia um rascunho para aprovação.
+type SubmitTravelRequestDTO struct {
+	OverrideOverlap bool `json:"override_overlap,omitempty"`
 }
 
 type UpdateStatusTravelRequestDTO struct {
 	TravelRequestId string                    `json:"travel_request_id" binding:"required"`
-	Status          enums.TravelRequestStatus `json:"status" binding;"required"`
+	Status          enums.TravelRequestStatus `json:"status" binding:"required"`
+}
+
+type BulkUpdateStatusDTO struct {
+	TravelRequestIds []uuid.UUID               `json:"travel_request_ids" binding:"required,min=1,max=100"`
+	Status           enums.TravelRequestStatus `json:"status" binding:"required"`
+}
+
+type BulkStatusResult string
+
+const (
+	BulkStatusResultSuccess           BulkStatusResult = "SUCCESS"
+	BulkStatusResultNotFound          BulkStatusResult = "NOT_FOUND"
+	BulkStatusResultUnauthorized      BulkStatusResult = "UNAUTHORIZED"
+	BulkStatusResultInvalidTransition BulkStatusResult = "INVALID_TRANSITION"
+	BulkStatusResultConflict          BulkStatusResult = "CONFLICT"
+	BulkStatusResultFailed            BulkStatusResult = "FAILED"
+)
+
+type BulkStatusItemDTO struct {
+	TravelRequestId uuid.UUID        `json:"travel_request_id"`
+	Result          BulkStatusResult `json:"result"`
+	Error           string           `json:"error,omitempty"`
+}
+
+type BulkUpdateStatusResponseDTO struct {
+	Status    enums.TravelRequestStatus `json:"status"`
+	Succeeded int                       `json:"succeeded"`
+	Failed    int                       `json:"failed"`
+	Results   []BulkStatusItemDTO       `json:"results"`
 }
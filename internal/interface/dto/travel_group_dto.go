@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTravelGroupDTO struct {
+	Name              string                  `json:"name" binding:"required"`
+	ApprovalMode      enums.GroupApprovalMode `json:"approval_mode,omitempty"`
+	TravelerIds       []uuid.UUID             `json:"traveler_ids" binding:"required"`
+	DestinationName   string                  `json:"destination_name"`
+	DestinationId     *uuid.UUID              `json:"destination_id,omitempty"`
+	DepartureDate     time.Time               `json:"departure_date" binding:"required"`
+	ReturnDate        *time.Time              `json:"return_date,omitempty"`
+	DepartureTimezone string                  `json:"departure_timezone,omitempty"`
+	ReturnTimezone    string                  `json:"return_timezone,omitempty"`
+	Department        *string                 `json:"department,omitempty"`
+	CostItems         []CostItemDTO           `json:"cost_items,omitempty" binding:"omitempty,dive"`
+	OverrideOverlap   bool                    `json:"override_overlap,omitempty"`
+	BusinessPurpose   string                  `json:"business_purpose"`
+	CostCenterId      *uuid.UUID              `json:"cost_center_id,omitempty"`
+	Allocations       []CostAllocationDTO     `json:"allocations,omitempty" binding:"omitempty,dive"`
+}
+
+type UpdateTravelGroupStatusDTO struct {
+	Status enums.TravelRequestStatus `json:"status" binding:"required"`
+}
+
+// TravelGroupStatusResponseDTO traz o grupo após a decisão e o resultado de cada membro que
+// estava pendente, no mesmo formato da alteração em lote.
+type TravelGroupStatusResponseDTO struct {
+	entity.TravelGroup
+	Results []BulkStatusItemDTO `json:"results"`
+}
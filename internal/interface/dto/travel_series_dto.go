@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTravelSeriesDTO struct {
+	TemplateId  uuid.UUID `json:"template_id" binding:"required"`
+	RRule       string    `json:"rrule" binding:"required"`
+	StartsAt    time.Time `json:"starts_at" binding:"required"`
+	Timezone    string    `json:"timezone,omitempty"`
+	HorizonDays int       `json:"horizon_days,omitempty"`
+}
+
+// UpdateTravelSeriesDTO altera a série inteira; os campos omitidos mantêm o valor atual.
+type UpdateTravelSeriesDTO struct {
+	TemplateId  *uuid.UUID `json:"template_id,omitempty"`
+	RRule       *string    `json:"rrule,omitempty"`
+	StartsAt    *time.Time `json:"starts_at,omitempty"`
+	Timezone    *string    `json:"timezone,omitempty"`
+	HorizonDays *int       `json:"horizon_days,omitempty"`
+}
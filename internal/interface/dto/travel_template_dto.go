@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SaveTravelTemplateDTO struct {
+	Name              string        `json:"name" binding:"required"`
+	DestinationName   string        `json:"destination_name"`
+	DestinationId     *uuid.UUID    `json:"destination_id,omitempty"`
+	TravelerName      string        `json:"traveler_name"`
+	TravelerIds       []uuid.UUID   `json:"traveler_ids,omitempty"`
+	DurationDays      int           `json:"duration_days" binding:"gte=0"`
+	Department        *string       `json:"department,omitempty"`
+	DepartureTimezone string        `json:"departure_timezone,omitempty"`
+	CostItems         []CostItemDTO `json:"cost_items,omitempty" binding:"omitempty,dive"`
+	BusinessPurpose   string        `json:"business_purpose,omitempty"`
+	CostCenterId      *uuid.UUID    `json:"cost_center_id,omitempty"`
+}
+
+type InstantiateTravelTemplateDTO struct {
+	DepartureDate   time.Time  `json:"departure_date" binding:"required"`
+	ReturnDate      *time.Time `json:"return_date,omitempty"`
+	OverrideOverlap bool       `json:"override_overlap,omitempty"`
+}
+
+// CloneTravelRequestDTO informa a nova data de ida ou quantos dias deslocar o roteiro original.
+type CloneTravelRequestDTO struct {
+	DepartureDate *time.Time `json:"departure_date,omitempty"`
+	ShiftDays     *int       `json:"shift_days,omitempty"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateTravelerDTO struct {
+	Name              string     `json:"name" binding:"required"`
+	Email             string     `json:"email" binding:"required,email"`
+	EmployeeId        *string    `json:"employee_id,omitempty"`
+	UserId            *uuid.UUID `json:"user_id,omitempty"`
+	Nationality       *string    `json:"nationality,omitempty" binding:"omitempty,len=2"`
+	PassportNumber    *string    `json:"passport_number,omitempty"`
+	PassportCountry   *string    `json:"passport_country,omitempty" binding:"omitempty,len=2"`
+	PassportExpiresAt *time.Time `json:"passport_expires_at,omitempty"`
+}
+
+type UpdateTravelerDTO struct {
+	Name              *string    `json:"name,omitempty"`
+	Email             *string    `json:"email,omitempty" binding:"omitempty,email"`
+	EmployeeId        *string    `json:"employee_id,omitempty"`
+	Nationality       *string    `json:"nationality,omitempty" binding:"omitempty,len=2"`
+	PassportNumber    *string    `json:"passport_number,omitempty"`
+	PassportCountry   *string    `json:"passport_country,omitempty" binding:"omitempty,len=2"`
+	PassportExpiresAt *time.Time `json:"passport_expires_at,omitempty"`
+}
+
+type TravelerDelegateDTO struct {
+	UserId uuid.UUID `json:"user_id" binding:"required"`
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookSubscriptionDTO cadastra a URL que recebe os eventos. Sem Secret, um segredo
+// aleatório é gerado.
+type CreateWebhookSubscriptionDTO struct {
+	Url    string               `json:"url" binding:"required"`
+	Events []enums.WebhookEvent `json:"events" binding:"required,min=1"`
+	Secret string               `json:"secret,omitempty"`
+}
+
+type UpdateWebhookSubscriptionDTO struct {
+	Url    string               `json:"url" binding:"required"`
+	Events []enums.WebhookEvent `json:"events" binding:"required,min=1"`
+	Active bool                 `json:"active"`
+}
+
+// WebhookSubscriptionCreatedDTO é a única resposta que inclui o segredo da assinatura.
+type WebhookSubscriptionCreatedDTO struct {
+	entity.WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+// WebhookEventDTO é o corpo enviado em cada entrega. Id é o mesmo em todas as tentativas e
+// reenvios do evento.
+type WebhookEventDTO struct {
+	Id         uuid.UUID               `json:"id"`
+	Event      enums.WebhookEvent      `json:"event"`
+	OccurredAt time.Time               `json:"occurred_at"`
+	Data       WebhookTravelRequestDTO `json:"data"`
+}
+
+type WebhookTravelRequestDTO struct {
+	Id                uuid.UUID                 `json:"id"`
+	Status            enums.TravelRequestStatus `json:"status"`
+	RequesterId       uuid.UUID                 `json:"requester_id"`
+	TravelerName      string                    `json:"traveler_name"`
+	DestinationName   string                    `json:"destination_name"`
+	DepartureDate     time.Time                 `json:"departure_date"`
+	ReturnDate        *time.Time                `json:"return_date"`
+	DepartureTimezone string                    `json:"departure_timezone"`
+	ReturnTimezone    string                    `json:"return_timezone"`
+	Department        *string                   `json:"department"`
+	BusinessPurpose   string                    `json:"business_purpose"`
+	Currency          string                    `json:"currency"`
+	EstimatedTotal    float64                   `json:"estimated_total"`
+	GroupId           *uuid.UUID                `json:"group_id"`
+	ApprovedBy        *uuid.UUID                `json:"approved_by"`
+	ApprovedAt        *time.Time                `json:"approved_at"`
+	CanceledBy        *uuid.UUID                `json:"canceled_by"`
+	CanceledAt        *time.Time                `json:"canceled_at"`
+	CreatedAt         time.Time                 `json:"created_at"`
+}
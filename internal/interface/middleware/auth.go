@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
 )
 
 func AuthMiddleware() gin.HandlerFunc {
@@ -41,8 +42,32 @@ func AuthMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		userID := uint(claims["user_id"].(float64))
+		userID, userOK := uuidClaim(claims, "user_id")
+		organizationID, organizationOK := uuidClaim(claims, "organization_id")
+
+		if !userOK || !organizationOK {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token inválido"})
+			c.Abort()
+			return
+		}
+
 		c.Set("user_id", userID)
+		c.Set("organization_id", organizationID)
 		c.Next()
 	}
 }
+
+// uuidClaim lê um identificador gravado no token como texto.
+func uuidClaim(claims jwt.MapClaims, name string) (uuid.UUID, bool) {
+	value, ok := claims[name].(string)
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,6 +27,9 @@ func TestAuthMiddleware(t *testing.T) {
 	os.Setenv("JWT_SECRET_KEY", secretKey)
 	defer os.Unsetenv("JWT_SECRET_KEY")
 
+	userID := uuid.New()
+	organizationID := uuid.New()
+
 	// Add test endpoint
 	router.GET("/test", AuthMiddleware(), func(c *gin.Context) {
 		userID, exists := c.Get("user_id")
@@ -33,14 +37,15 @@ func TestAuthMiddleware(t *testing.T) {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "user_id not found"})
 			return
 		}
-		c.JSON(http.StatusOK, gin.H{"user_id": userID})
+		c.JSON(http.StatusOK, gin.H{"user_id": userID, "organization_id": c.MustGet("organization_id")})
 	})
 
 	t.Run("should authenticate valid token", func(t *testing.T) {
 		// Arrange
 		claims := jwt.MapClaims{
-			"user_id": float64(123),
-			"exp":     jwt.NewNumericDate(time.Now().Add(time.Hour)).Unix(),
+			"user_id":         userID,
+			"organization_id": organizationID,
+			"exp":             jwt.NewNumericDate(time.Now().Add(time.Hour)).Unix(),
 		}
 		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 		tokenString, _ := token.SignedString([]byte(secretKey))
@@ -56,7 +61,28 @@ func TestAuthMiddleware(t *testing.T) {
 		var response map[string]interface{}
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, float64(123), response["user_id"])
+		assert.Equal(t, userID.String(), response["user_id"])
+		assert.Equal(t, organizationID.String(), response["organization_id"])
+	})
+
+	t.Run("should reject token without organization", func(t *testing.T) {
+		// Arrange
+		claims := jwt.MapClaims{
+			"user_id": userID,
+			"exp":     jwt.NewNumericDate(time.Now().Add(time.Hour)).Unix(),
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenString, _ := token.SignedString([]byte(secretKey))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "Token inválido")
 	})
 
 	t.Run("should return error for missing token", func(t *testing.T) {
@@ -91,8 +117,9 @@ func TestAuthMiddleware(t *testing.T) {
 	t.Run("should return error for expired token", func(t *testing.T) {
 		// Arrange
 		claims := jwt.MapClaims{
-			"user_id": float64(123),
-			"exp":     jwt.NewNumericDate(time.Now().Add(-time.Hour)).Unix(),
+			"user_id":         userID,
+			"organization_id": organizationID,
+			"exp":             jwt.NewNumericDate(time.Now().Add(-time.Hour)).Unix(),
 		}
 		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 		tokenString, _ := token.SignedString([]byte(secretKey))
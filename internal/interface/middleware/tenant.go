@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OrganizationLoader interface {
+	LoadOrganization(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+}
+
+// TenantMiddleware carrega a organização do token e a coloca no contexto da requisição, que os
+// repositórios usam para isolar os dados. Deve ser aplicado depois de AuthMiddleware.
+func TenantMiddleware(loader OrganizationLoader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		organizationID, ok := c.Get("organization_id")
+		if !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Token inválido"})
+			c.Abort()
+			return
+		}
+
+		organization, err := loader.LoadOrganization(tenant.AsSystem(c.Request.Context()), organizationID.(uuid.UUID))
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Organização inválida"})
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(tenant.WithOrganization(c.Request.Context(), organization))
+		c.Next()
+	}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubOrganizationLoader map[uuid.UUID]*entity.Organization
+
+func (s stubOrganizationLoader) LoadOrganization(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	if organization, ok := s[id]; ok {
+		return organization, nil
+	}
+	return nil, errors.New("organização inexistente ou inativa")
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	// Setup
+	organization := &entity.Organization{Id: uuid.New(), Code: "FILIAL", Active: true}
+	loader := stubOrganizationLoader{organization.Id: organization}
+
+	newRouter := func(organizationID uuid.UUID) *gin.Engine {
+		router := setupTestRouter()
+		router.GET("/test", func(c *gin.Context) {
+			c.Set("organization_id", organizationID)
+		}, TenantMiddleware(loader), func(c *gin.Context) {
+			current, ok := tenant.Organization(c.Request.Context())
+			if !ok {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "organization not found"})
+				return
+			}
+			c.JSON(http.StatusOK, gin.H{"code": current.Code})
+		})
+		return router
+	}
+
+	t.Run("should place the organization in the request context", func(t *testing.T) {
+		// Act
+		w := httptest.NewRecorder()
+		newRouter(organization.Id).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"code":"FILIAL"`)
+	})
+
+	t.Run("should reject unknown organizations", func(t *testing.T) {
+		// Act
+		w := httptest.NewRecorder()
+		newRouter(uuid.New()).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Contains(t, w.Body.String(), "Organização inválida")
+	})
+}
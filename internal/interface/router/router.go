@@ -2,7 +2,7 @@ package router
 
 import (
 	_ "challenge-travel-api/docs"
-	"challenge-travel-api/internal/interface/controller"
+	"challenge-travel-api/internal/infrastructure/container"
 	"challenge-travel-api/internal/interface/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -10,10 +10,25 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetupRouter(
-	authController *controller.AuthController,
-	travelController *controller.TravelController,
-) *gin.Engine {
+func SetupRouter(controllers *container.Controllers) *gin.Engine {
+	authController := controllers.Auth
+	travelController := controllers.Travel
+	costController := controllers.Cost
+	policyController := controllers.Policy
+	travelerController := controllers.Traveler
+	travelGroupController := controllers.Group
+	destinationController := controllers.Destination
+	commentController := controllers.Comment
+	attachmentController := controllers.Attachment
+	templateController := controllers.Template
+	seriesController := controllers.Series
+	hierarchyController := controllers.Hierarchy
+	organizationController := controllers.Organization
+	outboxController := controllers.Outbox
+	notificationTemplateController := controllers.Notification
+	webhookController := controllers.Webhook
+	inboxController := controllers.Inbox
+
 	router := gin.Default()
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -27,15 +42,139 @@ func SetupRouter(
 		}
 	}
 
-	baseRoute.Use(middleware.AuthMiddleware())
+	baseRoute.Use(middleware.AuthMiddleware(), controllers.Tenant)
 	{
+		me := baseRoute.Group("/me")
+		{
+			me.PUT("/locale", authController.UpdateLocale)
+			me.GET("/notifications", inboxController.ListNotifications)
+			me.GET("/notifications/unread-count", inboxController.CountUnreadNotifications)
+			me.POST("/notifications/read-all", inboxController.MarkAllNotificationsRead)
+			me.POST("/notifications/:id/read", inboxController.MarkNotificationRead)
+			me.GET("/notification-preferences", inboxController.ListNotificationPreferences)
+			me.PUT("/notification-preferences", inboxController.UpdateNotificationPreferences)
+		}
+
+		organization := baseRoute.Group("/organization")
+		{
+			organization.GET("", organizationController.GetOrganization)
+			organization.PUT("", organizationController.UpdateOrganization)
+		}
+
 		travels := baseRoute.Group("/travels")
 		{
 			travels.POST("", travelController.CreateTravelRequest)
 			travels.GET("", travelController.ListTravelRequests)
+			travels.GET("/inbox", travelController.ListApproverInbox)
 			travels.GET("/:id", travelController.GetTravelRequest)
 			travels.PUT("/:id", travelController.UpdateTravelRequest)
+			travels.PATCH("/status", travelController.BulkUpdateStatus)
 			travels.PATCH("/:id/status", travelController.UpdateStatusTravelRequest)
+			travels.POST("/:id/submit", travelController.SubmitTravelRequest)
+			travels.POST("/:id/clone", templateController.CloneTravelRequest)
+			travels.GET("/:id/comments", commentController.ListComments)
+			travels.POST("/:id/comments", commentController.AddComment)
+			travels.PUT("/:id/comments/:commentId", commentController.UpdateComment)
+			travels.DELETE("/:id/comments/:commentId", commentController.DeleteComment)
+			travels.GET("/:id/attachments", attachmentController.ListAttachments)
+			travels.POST("/:id/attachments", attachmentController.UploadAttachment)
+			travels.GET("/:id/attachments/:attachmentId", attachmentController.DownloadAttachment)
+			travels.DELETE("/:id/attachments/:attachmentId", attachmentController.DeleteAttachment)
+		}
+
+		admin := baseRoute.Group("/admin")
+		{
+			admin.GET("/travels", travelController.ListAllTravelRequests)
+			admin.GET("/travels/export", travelController.ExportTravelRequests)
+			admin.PUT("/users/:id/reporting-line", hierarchyController.AssignReportingLine)
+			admin.POST("/users/reporting-lines/import", hierarchyController.ImportReportingLines)
+			admin.GET("/outbox", outboxController.ListOutboxMessages)
+			admin.POST("/outbox/:id/retry", outboxController.RetryOutboxMessage)
+			admin.GET("/notification-templates", notificationTemplateController.ListNotificationTemplates)
+			admin.POST("/notification-templates/preview", notificationTemplateController.PreviewNotificationTemplate)
+		}
+
+		webhooks := baseRoute.Group("/webhooks")
+		{
+			webhooks.POST("", webhookController.CreateWebhookSubscription)
+			webhooks.GET("", webhookController.ListWebhookSubscriptions)
+			webhooks.PUT("/:id", webhookController.UpdateWebhookSubscription)
+			webhooks.DELETE("/:id", webhookController.DeleteWebhookSubscription)
+			webhooks.GET("/:id/deliveries", webhookController.ListWebhookDeliveries)
+			webhooks.POST("/deliveries/:deliveryId/redeliver", webhookController.RedeliverWebhook)
+		}
+
+		exchangeRates := baseRoute.Group("/exchange-rates")
+		{
+			exchangeRates.GET("", costController.ListExchangeRates)
+			exchangeRates.PUT("/:currency", costController.SaveExchangeRate)
+		}
+
+		costCenters := baseRoute.Group("/cost-centers")
+		{
+			costCenters.GET("", costController.ListCostCenters)
+			costCenters.POST("", costController.CreateCostCenter)
+			costCenters.PUT("/:id", costController.UpdateCostCenter)
+		}
+
+		departments := baseRoute.Group("/departments")
+		{
+			departments.GET("", hierarchyController.ListDepartments)
+			departments.POST("", hierarchyController.CreateDepartment)
+			departments.PUT("/:id", hierarchyController.UpdateDepartment)
+		}
+
+		baseRoute.GET("/users/:id/approvers", hierarchyController.GetApprovers)
+
+		budgets := baseRoute.Group("/budgets")
+		{
+			budgets.GET("", costController.ListDepartmentBudgets)
+			budgets.PUT("", costController.SaveDepartmentBudget)
+		}
+
+		baseRoute.GET("/policy-rules", policyController.ListPolicyRules)
+
+		travelers := baseRoute.Group("/travelers")
+		{
+			travelers.POST("", travelerController.CreateTraveler)
+			travelers.GET("", travelerController.ListTravelers)
+			travelers.GET("/:id", travelerController.GetTraveler)
+			travelers.PUT("/:id", travelerController.UpdateTraveler)
+			travelers.POST("/:id/delegates", travelerController.AddTravelerDelegate)
+			travelers.DELETE("/:id/delegates/:userId", travelerController.RemoveTravelerDelegate)
+		}
+
+		travelGroups := baseRoute.Group("/travel-groups")
+		{
+			travelGroups.POST("", travelGroupController.CreateTravelGroup)
+			travelGroups.GET("/:id", travelGroupController.GetTravelGroup)
+			travelGroups.PATCH("/:id/status", travelGroupController.UpdateTravelGroupStatus)
+		}
+
+		travelTemplates := baseRoute.Group("/travel-templates")
+		{
+			travelTemplates.POST("", templateController.CreateTravelTemplate)
+			travelTemplates.GET("", templateController.ListTravelTemplates)
+			travelTemplates.GET("/:id", templateController.GetTravelTemplate)
+			travelTemplates.PUT("/:id", templateController.UpdateTravelTemplate)
+			travelTemplates.DELETE("/:id", templateController.DeleteTravelTemplate)
+			travelTemplates.POST("/:id/instantiate", templateController.InstantiateTravelTemplate)
+		}
+
+		travelSeries := baseRoute.Group("/travel-series")
+		{
+			travelSeries.POST("", seriesController.CreateTravelSeries)
+			travelSeries.GET("", seriesController.ListTravelSeries)
+			travelSeries.GET("/:id", seriesController.GetTravelSeries)
+			travelSeries.PUT("/:id", seriesController.UpdateTravelSeries)
+			travelSeries.DELETE("/:id", seriesController.CancelTravelSeries)
+			travelSeries.DELETE("/:id/occurrences/:travelId", seriesController.CancelOccurrence)
+		}
+
+		destinations := baseRoute.Group("/destinations")
+		{
+			destinations.GET("", destinationController.SearchDestinations)
+			destinations.GET("/:id", destinationController.GetDestination)
 		}
 	}
 
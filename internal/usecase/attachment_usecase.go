@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttachmentEmpty          = errors.New("o arquivo enviado está vazio")
+	ErrAttachmentTooLarge       = errors.New("o arquivo excede o tamanho máximo permitido")
+	ErrAttachmentTypeNotAllowed = errors.New("tipo de arquivo não permitido")
+	ErrChecksumMismatch         = errors.New("o checksum informado não confere com o arquivo recebido")
+	ErrAttachmentCorrupted      = errors.New("o arquivo armazenado não confere com o checksum registrado")
+)
+
+// allowedAttachmentTypes são os tipos aceitos, identificados pelo conteúdo do arquivo e não
+// pela extensão ou pelo cabeçalho enviado pelo cliente.
+var allowedAttachmentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/webp":      true,
+	"text/plain":      true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+}
+
+// officeContentTypes distingue os documentos do Office, que são detectados como ZIP.
+var officeContentTypes = map[string]string{
+	".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	".pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+}
+
+type AttachmentUseCase interface {
+	MaxAttachmentSize() int64
+	ListAttachments(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID) ([]entity.TravelRequestAttachment, error)
+	UploadAttachment(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID, input dto.UploadAttachmentDTO) (*entity.TravelRequestAttachment, error)
+	DownloadAttachment(ctx context.Context, travelRequestID uuid.UUID, attachmentID uuid.UUID, userID uuid.UUID) (*entity.TravelRequestAttachment, []byte, error)
+	DeleteAttachment(ctx context.Context, travelRequestID uuid.UUID, attachmentID uuid.UUID, userID uuid.UUID) error
+}
+
+type AttachmentUseCaseImpl struct {
+	attachmentGateway gateway.AttachmentGateway
+	travelGateway     gateway.TravelRequestGateway
+	userGateway       gateway.UserGateway
+	storage           gateway.FileStorage
+	maxSize           int64
+}
+
+func NewAttachmentUseCase(
+	attachmentGateway gateway.AttachmentGateway,
+	travelGateway gateway.TravelRequestGateway,
+	userGateway gateway.UserGateway,
+	storage gateway.FileStorage,
+	maxSize int64,
+) *AttachmentUseCaseImpl {
+	return &AttachmentUseCaseImpl{
+		attachmentGateway: attachmentGateway,
+		travelGateway:     travelGateway,
+		userGateway:       userGateway,
+		storage:           storage,
+		maxSize:           maxSize,
+	}
+}
+
+func (uc *AttachmentUseCaseImpl) MaxAttachmentSize() int64 {
+	return uc.maxSize
+}
+
+func (uc *AttachmentUseCaseImpl) ListAttachments(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID) ([]entity.TravelRequestAttachment, error) {
+	if _, _, err := findAccessibleTravel(ctx, uc.userGateway, uc.travelGateway, travelRequestID, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.attachmentGateway.ListByTravelRequestID(ctx, travelRequestID)
+}
+
+// UploadAttachment valida tamanho, tipo e checksum antes de gravar o conteúdo no armazenamento
+// e, em seguida, os metadados no banco.
+func (uc *AttachmentUseCaseImpl) UploadAttachment(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID, input dto.UploadAttachmentDTO) (*entity.TravelRequestAttachment, error) {
+	if len(input.Content) == 0 {
+		return nil, ErrAttachmentEmpty
+	}
+
+	if int64(len(input.Content)) > uc.maxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	fileName := sanitizeFileName(input.FileName)
+
+	contentType := detectAttachmentType(fileName, input.Content)
+	if !allowedAttachmentTypes[contentType] {
+		return nil, fmt.Errorf("%w: %s", ErrAttachmentTypeNotAllowed, contentType)
+	}
+
+	checksum := sha256Hex(input.Content)
+	if input.Checksum != "" && !strings.EqualFold(strings.TrimSpace(input.Checksum), checksum) {
+		return nil, ErrChecksumMismatch
+	}
+
+	user, travelRequest, err := findAccessibleTravel(ctx, uc.userGateway, uc.travelGateway, travelRequestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &entity.TravelRequestAttachment{
+		Id:              uuid.New(),
+		TravelRequestId: travelRequest.Id,
+		UploadedBy:      user.Id,
+		FileName:        fileName,
+		ContentType:     contentType,
+		Size:            int64(len(input.Content)),
+		Checksum:        checksum,
+		CreatedAt:       time.Now(),
+	}
+	attachment.StorageKey = entity.AttachmentStorageKey(travelRequest.Id, attachment.Id)
+
+	if err := uc.storage.Put(ctx, attachment.StorageKey, input.Content, contentType); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachmentGateway.Create(ctx, attachment); err != nil {
+		if deleteErr := uc.storage.Delete(ctx, attachment.StorageKey); deleteErr != nil {
+			log.Printf("[ATTACHMENT] Erro ao remover arquivo órfão %s: %v", attachment.StorageKey, deleteErr)
+		}
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// DownloadAttachment devolve o conteúdo somente se ele ainda confere com o checksum gravado no upload.
+func (uc *AttachmentUseCaseImpl) DownloadAttachment(ctx context.Context, travelRequestID uuid.UUID, attachmentID uuid.UUID, userID uuid.UUID) (*entity.TravelRequestAttachment, []byte, error) {
+	if _, _, err := findAccessibleTravel(ctx, uc.userGateway, uc.travelGateway, travelRequestID, userID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := uc.attachmentGateway.FindByID(ctx, travelRequestID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reader, err := uc.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, attachment.Size+1))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if sha256Hex(content) != attachment.Checksum {
+		return nil, nil, ErrAttachmentCorrupted
+	}
+
+	return attachment, content, nil
+}
+
+func (uc *AttachmentUseCaseImpl) DeleteAttachment(ctx context.Context, travelRequestID uuid.UUID, attachmentID uuid.UUID, userID uuid.UUID) error {
+	user, _, err := findAccessibleTravel(ctx, uc.userGateway, uc.travelGateway, travelRequestID, userID)
+	if err != nil {
+		return err
+	}
+
+	attachment, err := uc.attachmentGateway.FindByID(ctx, travelRequestID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if !attachment.CanBeDeletedBy(user) {
+		return ErrUnauthorized
+	}
+
+	if err := uc.attachmentGateway.Delete(ctx, attachment); err != nil {
+		return err
+	}
+
+	// Um arquivo que não pôde ser removido fica apenas órfão; os metadados já não o referenciam.
+	if err := uc.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		log.Printf("[ATTACHMENT] Erro ao remover arquivo %s: %v", attachment.StorageKey, err)
+	}
+
+	return nil
+}
+
+func detectAttachmentType(fileName string, content []byte) string {
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil {
+		return "application/octet-stream"
+	}
+
+	if contentType == "application/zip" {
+		if officeType, ok := officeContentTypes[strings.ToLower(filepath.Ext(fileName))]; ok {
+			return officeType
+		}
+	}
+
+	return contentType
+}
+
+func sanitizeFileName(fileName string) string {
+	fileName = strings.TrimSpace(filepath.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "anexo"
+	}
+
+	if len(fileName) > 255 {
+		extension := filepath.Ext(fileName)
+		if len(extension) > 16 {
+			extension = ""
+		}
+		fileName = strings.ToValidUTF8(fileName[:255-len(extension)], "") + extension
+	}
+
+	return fileName
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
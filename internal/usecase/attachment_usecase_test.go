@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAttachmentGateway struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentGateway) Create(ctx context.Context, attachment *entity.TravelRequestAttachment) error {
+	args := m.Called(ctx, attachment)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentGateway) FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestAttachment, error) {
+	args := m.Called(ctx, travelRequestID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelRequestAttachment), args.Error(1)
+}
+
+func (m *MockAttachmentGateway) ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID) ([]entity.TravelRequestAttachment, error) {
+	args := m.Called(ctx, travelRequestID)
+	return args.Get(0).([]entity.TravelRequestAttachment), args.Error(1)
+}
+
+func (m *MockAttachmentGateway) Delete(ctx context.Context, attachment *entity.TravelRequestAttachment) error {
+	args := m.Called(ctx, attachment)
+	return args.Error(0)
+}
+
+type MockFileStorage struct {
+	mock.Mock
+}
+
+func (m *MockFileStorage) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	args := m.Called(ctx, key, content, contentType)
+	return args.Error(0)
+}
+
+func (m *MockFileStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockFileStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func TestAttachmentUseCase(t *testing.T) {
+	ctx := context.Background()
+	pdf := []byte("%PDF-1.7\nconvite para a conferência")
+
+	requester := &entity.User{Id: uuid.New(), Name: "Requester", Role: enums.UserTypeCommon}
+	approver := &entity.User{Id: uuid.New(), Name: "Approver", Role: enums.UserTypeAdmin}
+	travelRequest := &entity.TravelRequest{Id: uuid.New(), UserId: requester.Id}
+
+	setup := func() (*AttachmentUseCaseImpl, *MockAttachmentGateway, *MockFileStorage) {
+		attachmentGateway := new(MockAttachmentGateway)
+		travelGateway := new(MockTravelGateway)
+		userGateway := new(MockUserGateway)
+		storage := new(MockFileStorage)
+
+		userGateway.On("FindByID", ctx, requester.Id).Return(requester, nil)
+		userGateway.On("FindByID", ctx, approver.Id).Return(approver, nil)
+		travelGateway.On("FindByID", ctx, travelRequest.Id).Return(travelRequest, nil)
+
+		return NewAttachmentUseCase(attachmentGateway, travelGateway, userGateway, storage, 1024), attachmentGateway, storage
+	}
+
+	t.Run("should store the file and record its checksum", func(t *testing.T) {
+		// Arrange
+		useCase, attachmentGateway, storage := setup()
+		storage.On("Put", ctx, mock.AnythingOfType("string"), pdf, "application/pdf").Return(nil)
+		attachmentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestAttachment")).Return(nil)
+
+		// Act
+		attachment, err := useCase.UploadAttachment(ctx, travelRequest.Id, requester.Id, dto.UploadAttachmentDTO{
+			FileName: "C:\\Users\\joao\\convite.pdf",
+			Content:  pdf,
+			Checksum: sha256Hex(pdf),
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "convite.pdf", attachment.FileName)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Equal(t, int64(len(pdf)), attachment.Size)
+		assert.Equal(t, sha256Hex(pdf), attachment.Checksum)
+		assert.Equal(t, entity.AttachmentStorageKey(travelRequest.Id, attachment.Id), attachment.StorageKey)
+		storage.AssertExpectations(t)
+	})
+
+	t.Run("should reject files over the size limit", func(t *testing.T) {
+		// Arrange
+		useCase, _, storage := setup()
+
+		// Act
+		_, err := useCase.UploadAttachment(ctx, travelRequest.Id, requester.Id, dto.UploadAttachmentDTO{FileName: "big.pdf", Content: bytes.Repeat([]byte("a"), 1025)})
+
+		// Assert
+		assert.Equal(t, ErrAttachmentTooLarge, err)
+		storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should detect the type from the content instead of the extension", func(t *testing.T) {
+		// Arrange
+		useCase, _, _ := setup()
+
+		// Act
+		_, err := useCase.UploadAttachment(ctx, travelRequest.Id, requester.Id, dto.UploadAttachmentDTO{FileName: "quote.pdf", Content: []byte("MZ\x90\x00\x03\x00\x00\x00")})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrAttachmentTypeNotAllowed)
+	})
+
+	t.Run("should reject uploads whose checksum does not match", func(t *testing.T) {
+		// Arrange
+		useCase, _, _ := setup()
+
+		// Act
+		_, err := useCase.UploadAttachment(ctx, travelRequest.Id, requester.Id, dto.UploadAttachmentDTO{FileName: "convite.pdf", Content: pdf, Checksum: sha256Hex([]byte("outro"))})
+
+		// Assert
+		assert.Equal(t, ErrChecksumMismatch, err)
+	})
+
+	t.Run("should remove the stored file when metadata cannot be saved", func(t *testing.T) {
+		// Arrange
+		useCase, attachmentGateway, storage := setup()
+		storage.On("Put", ctx, mock.AnythingOfType("string"), pdf, "application/pdf").Return(nil)
+		storage.On("Delete", ctx, mock.AnythingOfType("string")).Return(nil)
+		attachmentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestAttachment")).Return(errors.New("db down"))
+
+		// Act
+		attachment, err := useCase.UploadAttachment(ctx, travelRequest.Id, requester.Id, dto.UploadAttachmentDTO{FileName: "convite.pdf", Content: pdf})
+
+		// Assert
+		assert.Nil(t, attachment)
+		assert.EqualError(t, err, "db down")
+		storage.AssertExpectations(t)
+	})
+
+	t.Run("should refuse to serve a file that no longer matches its checksum", func(t *testing.T) {
+		// Arrange
+		useCase, attachmentGateway, storage := setup()
+		attachment := &entity.TravelRequestAttachment{Id: uuid.New(), TravelRequestId: travelRequest.Id, StorageKey: "key", Size: int64(len(pdf)), Checksum: sha256Hex(pdf)}
+		attachmentGateway.On("FindByID", ctx, travelRequest.Id, attachment.Id).Return(attachment, nil)
+		storage.On("Get", ctx, "key").Return(io.NopCloser(bytes.NewReader([]byte("%PDF-1.7\nadulterado"))), nil)
+
+		// Act
+		_, content, err := useCase.DownloadAttachment(ctx, travelRequest.Id, attachment.Id, requester.Id)
+
+		// Assert
+		assert.Nil(t, content)
+		assert.Equal(t, ErrAttachmentCorrupted, err)
+	})
+
+	t.Run("should serve a file that matches its checksum", func(t *testing.T) {
+		// Arrange
+		useCase, attachmentGateway, storage := setup()
+		attachment := &entity.TravelRequestAttachment{Id: uuid.New(), TravelRequestId: travelRequest.Id, StorageKey: "key", Size: int64(len(pdf)), Checksum: sha256Hex(pdf)}
+		attachmentGateway.On("FindByID", ctx, travelRequest.Id, attachment.Id).Return(attachment, nil)
+		storage.On("Get", ctx, "key").Return(io.NopCloser(bytes.NewReader(pdf)), nil)
+
+		// Act
+		_, content, err := useCase.DownloadAttachment(ctx, travelRequest.Id, attachment.Id, approver.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, pdf, content)
+	})
+
+	t.Run("should only let the uploader or an admin delete", func(t *testing.T) {
+		// Arrange
+		useCase, attachmentGateway, storage := setup()
+		attachment := &entity.TravelRequestAttachment{Id: uuid.New(), TravelRequestId: travelRequest.Id, UploadedBy: approver.Id, StorageKey: "key"}
+		attachmentGateway.On("FindByID", ctx, travelRequest.Id, attachment.Id).Return(attachment, nil)
+		attachmentGateway.On("Delete", ctx, attachment).Return(nil)
+		storage.On("Delete", ctx, "key").Return(nil)
+
+		// Act
+		requesterErr := useCase.DeleteAttachment(ctx, travelRequest.Id, attachment.Id, requester.Id)
+		approverErr := useCase.DeleteAttachment(ctx, travelRequest.Id, attachment.Id, approver.Id)
+
+		// Assert
+		assert.Equal(t, ErrUnauthorized, requesterErr)
+		assert.NoError(t, approverErr)
+		storage.AssertExpectations(t)
+	})
+}
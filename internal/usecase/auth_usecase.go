@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
 	"challenge-travel-api/internal/interface/dto"
 	"context"
 	"errors"
@@ -10,6 +12,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
@@ -21,19 +24,30 @@ var (
 type AuthUseCase interface {
 	Register(ctx context.Context, input dto.RegisterRequestDTO) error
 	Login(ctx context.Context, input dto.LoginRequestDTO) (dto.LoginResponseDTO, error)
+	UpdateLocale(ctx context.Context, userID uuid.UUID, input dto.UpdateLocaleDTO) error
 }
 
 type AuthUseCaseImpl struct {
-	repo gateway.UserGateway
+	repo                    gateway.UserGateway
+	organizationGateway     gateway.OrganizationGateway
+	defaultOrganizationCode string
 }
 
-func NewAUthUseCase(repo gateway.UserGateway) *AuthUseCaseImpl {
+func NewAUthUseCase(repo gateway.UserGateway, organizationGateway gateway.OrganizationGateway, defaultOrganizationCode string) *AuthUseCaseImpl {
 	return &AuthUseCaseImpl{
-		repo: repo,
+		repo:                    repo,
+		organizationGateway:     organizationGateway,
+		defaultOrganizationCode: defaultOrganizationCode,
 	}
 }
 
+// Register e Login ainda não conhecem a organização do usuário, por isso consultam os usuários
+// de todas as organizações; o e-mail é único na instalação. O cadastro público cria sempre um
+// usuário comum na organização padrão: perfis de administrador e outras organizações dependem
+// de provisionamento.
 func (uc *AuthUseCaseImpl) Register(ctx context.Context, input dto.RegisterRequestDTO) error {
+	ctx = tenant.AsSystem(ctx)
+
 	existingUser, err := uc.repo.FindByEmail(ctx, input.Email)
 
 	if err == nil && existingUser != nil {
@@ -44,6 +58,20 @@ func (uc *AuthUseCaseImpl) Register(ctx context.Context, input dto.RegisterReque
 		return err
 	}
 
+	locale := enums.DefaultLocale
+	if input.Locale != "" {
+		parsed, ok := enums.ParseLocale(input.Locale)
+		if !ok {
+			return ErrInvalidLocale
+		}
+		locale = parsed
+	}
+
+	organization, err := uc.defaultOrganization(ctx)
+	if err != nil {
+		return err
+	}
+
 	hashedPassword, err := uc.hashPassword(input.Password)
 
 	if err != nil {
@@ -51,10 +79,12 @@ func (uc *AuthUseCaseImpl) Register(ctx context.Context, input dto.RegisterReque
 	}
 
 	newUser := &entity.User{
-		Name:     input.Name,
-		Email:    input.Email,
-		Password: hashedPassword,
-		Role:     input.Role,
+		OrganizationId: organization.Id,
+		Name:           input.Name,
+		Email:          input.Email,
+		Password:       hashedPassword,
+		Role:           enums.UserTypeCommon,
+		Locale:         locale,
 	}
 
 	err = uc.repo.Create(ctx, newUser)
@@ -67,7 +97,7 @@ func (uc *AuthUseCaseImpl) Register(ctx context.Context, input dto.RegisterReque
 }
 
 func (uc *AuthUseCaseImpl) Login(ctx context.Context, input dto.LoginRequestDTO) (dto.LoginResponseDTO, error) {
-	user, err := uc.repo.FindByEmail(ctx, input.Email)
+	user, err := uc.repo.FindByEmail(tenant.AsSystem(ctx), input.Email)
 
 	if err != nil {
 		return dto.LoginResponseDTO{}, err
@@ -78,8 +108,9 @@ func (uc *AuthUseCaseImpl) Login(ctx context.Context, input dto.LoginRequestDTO)
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": user.Id,
-		"exp":     time.Now().Add(time.Hour * 24).Unix(),
+		"user_id":         user.Id,
+		"organization_id": user.OrganizationId,
+		"exp":             time.Now().Add(time.Hour * 24).Unix(),
 	})
 
 	jwtSecretKey := os.Getenv("JWT_SECRET_KEY")
@@ -99,6 +130,40 @@ func (uc *AuthUseCaseImpl) Login(ctx context.Context, input dto.LoginRequestDTO)
 	}, nil
 }
 
+// UpdateLocale altera o idioma das notificações do próprio usuário.
+func (uc *AuthUseCaseImpl) UpdateLocale(ctx context.Context, userID uuid.UUID, input dto.UpdateLocaleDTO) error {
+	locale, ok := enums.ParseLocale(input.Locale)
+	if !ok {
+		return ErrInvalidLocale
+	}
+
+	user, err := uc.repo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.Locale = locale
+	user.UpdatedAt = &now
+
+	return uc.repo.Update(ctx, user)
+}
+
+// defaultOrganization localiza a organização padrão da instalação, que recebe os cadastros
+// públicos.
+func (uc *AuthUseCaseImpl) defaultOrganization(ctx context.Context) (*entity.Organization, error) {
+	organization, err := uc.organizationGateway.FindByCode(ctx, uc.defaultOrganizationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if organization == nil || !organization.Active {
+		return nil, ErrOrganizationUnavailable
+	}
+
+	return organization, nil
+}
+
 func (uc *AuthUseCaseImpl) hashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), 10)
 
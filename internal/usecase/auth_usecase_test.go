@@ -3,12 +3,14 @@ package usecase
 import (
 	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
 	"challenge-travel-api/internal/interface/dto"
 	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -46,25 +48,54 @@ func (m *MockUserGateway) Update(ctx context.Context, user *entity.User) error {
 	args := m.Called(ctx, user)
 	return args.Error(0)
 }
+
+func (m *MockUserGateway) ListActiveByRole(ctx context.Context, role enums.UserType) ([]entity.User, error) {
+	args := m.Called(ctx, role)
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *MockUserGateway) LockReportingLines(ctx context.Context) error {
+	return m.Called(ctx).Error(0)
+}
+
+func (m *MockUserGateway) FindByEmails(ctx context.Context, emails []string) ([]entity.User, error) {
+	args := m.Called(ctx, emails)
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *MockUserGateway) ListReportingLines(ctx context.Context) ([]entity.User, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *MockUserGateway) UpdateReportingLine(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
 func TestAuthUseCase_Register(t *testing.T) {
 	// Setup
 	ctx := context.Background()
+	systemCtx := tenant.AsSystem(ctx)
+	defaultOrganization := &entity.Organization{Id: uuid.New(), Code: "DEFAULT", Active: true}
 
 	t.Run("should register user successfully", func(t *testing.T) {
 		//setup
 		mockUserGateway := new(MockUserGateway)
-		useCase := NewAUthUseCase(mockUserGateway)
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewAUthUseCase(mockUserGateway, mockOrganizationGateway, "DEFAULT")
 
 		// Arrange
 		input := dto.RegisterRequestDTO{
 			Name:     "John Doe",
 			Email:    "john.doe@example.com",
 			Password: "password123",
-			Role:     enums.UserTypeCommon,
 		}
 
-		mockUserGateway.On("FindByEmail", ctx, input.Email).Return(nil, gorm.ErrRecordNotFound)
-		mockUserGateway.On("Create", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(nil, gorm.ErrRecordNotFound)
+		mockOrganizationGateway.On("FindByCode", systemCtx, "DEFAULT").Return(defaultOrganization, nil)
+		mockUserGateway.On("Create", systemCtx, mock.MatchedBy(func(user *entity.User) bool {
+			return user.OrganizationId == defaultOrganization.Id && user.Locale == enums.LocalePtBR
+		})).Return(nil)
 
 		// Act
 		err := useCase.Register(ctx, input)
@@ -72,19 +103,98 @@ func TestAuthUseCase_Register(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		mockUserGateway.AssertExpectations(t)
+		mockOrganizationGateway.AssertExpectations(t)
+	})
+
+	t.Run("should register self-registered users as common users of the default organization", func(t *testing.T) {
+		//setup
+		mockUserGateway := new(MockUserGateway)
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewAUthUseCase(mockUserGateway, mockOrganizationGateway, "DEFAULT")
+
+		// Arrange
+		input := dto.RegisterRequestDTO{
+			Name:     "Jane Doe",
+			Email:    "jane.doe@example.com",
+			Password: "password123",
+			Locale:   "en",
+		}
+
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(nil, gorm.ErrRecordNotFound)
+		mockOrganizationGateway.On("FindByCode", systemCtx, "DEFAULT").Return(defaultOrganization, nil)
+		mockUserGateway.On("Create", systemCtx, mock.MatchedBy(func(user *entity.User) bool {
+			return user.OrganizationId == defaultOrganization.Id &&
+				user.Role == enums.UserTypeCommon &&
+				user.Locale == enums.LocaleEnUS
+		})).Return(nil)
+
+		// Act
+		err := useCase.Register(ctx, input)
+
+		// Assert
+		assert.NoError(t, err)
+		mockUserGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject unsupported locales", func(t *testing.T) {
+		//setup
+		mockUserGateway := new(MockUserGateway)
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewAUthUseCase(mockUserGateway, mockOrganizationGateway, "DEFAULT")
+
+		// Arrange
+		input := dto.RegisterRequestDTO{
+			Name:     "Jane Doe",
+			Email:    "jane.doe@example.com",
+			Password: "password123",
+			Locale:   "fr-FR",
+		}
+
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := useCase.Register(ctx, input)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+		mockUserGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject an inactive default organization", func(t *testing.T) {
+		//setup
+		mockUserGateway := new(MockUserGateway)
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewAUthUseCase(mockUserGateway, mockOrganizationGateway, "DEFAULT")
+
+		// Arrange
+		input := dto.RegisterRequestDTO{
+			Name:     "Jane Doe",
+			Email:    "jane.doe@example.com",
+			Password: "password123",
+		}
+
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(nil, gorm.ErrRecordNotFound)
+		mockOrganizationGateway.On("FindByCode", systemCtx, "DEFAULT").Return(&entity.Organization{Id: uuid.New(), Code: "DEFAULT"}, nil)
+
+		// Act
+		err := useCase.Register(ctx, input)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrOrganizationUnavailable)
+		mockUserGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
 	})
 
 	t.Run("should return error for existing user", func(t *testing.T) {
 		//setup
 		mockUserGateway := new(MockUserGateway)
-		useCase := NewAUthUseCase(mockUserGateway)
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewAUthUseCase(mockUserGateway, mockOrganizationGateway, "DEFAULT")
 
 		// Arrange
 		input := dto.RegisterRequestDTO{
 			Name:     "John Doe",
 			Email:    "john.doe@example.com",
 			Password: "password123",
-			Role:     enums.UserTypeCommon,
 		}
 
 		existingUser := &entity.User{
@@ -93,7 +203,7 @@ func TestAuthUseCase_Register(t *testing.T) {
 			Email: input.Email,
 		}
 
-		mockUserGateway.On("FindByEmail", ctx, input.Email).Return(existingUser, nil)
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(existingUser, nil)
 
 		// Act
 		err := useCase.Register(ctx, input)
@@ -108,8 +218,9 @@ func TestAuthUseCase_Register(t *testing.T) {
 func TestAuthUseCase_Login(t *testing.T) {
 	// Setup
 	mockUserGateway := new(MockUserGateway)
-	useCase := NewAUthUseCase(mockUserGateway)
+	useCase := NewAUthUseCase(mockUserGateway, new(MockOrganizationGateway), "DEFAULT")
 	ctx := context.Background()
+	systemCtx := tenant.AsSystem(ctx)
 
 	// Set JWT secret key for testing
 	os.Setenv("JWT_SECRET_KEY", "test-secret-key")
@@ -124,14 +235,15 @@ func TestAuthUseCase_Login(t *testing.T) {
 
 		hashedPassword, _ := useCase.hashPassword(input.Password)
 		user := &entity.User{
-			Id:       uuid.New(),
-			Name:     "John Doe",
-			Email:    input.Email,
-			Password: hashedPassword,
-			Role:     enums.UserTypeCommon,
+			Id:             uuid.New(),
+			OrganizationId: uuid.New(),
+			Name:           "John Doe",
+			Email:          input.Email,
+			Password:       hashedPassword,
+			Role:           enums.UserTypeCommon,
 		}
 
-		mockUserGateway.On("FindByEmail", ctx, input.Email).Return(user, nil)
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(user, nil)
 
 		// Act
 		result, err := useCase.Login(ctx, input)
@@ -140,6 +252,14 @@ func TestAuthUseCase_Login(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result.AccessToken)
 		mockUserGateway.AssertExpectations(t)
+
+		claims := jwt.MapClaims{}
+		_, err = jwt.ParseWithClaims(result.AccessToken, claims, func(token *jwt.Token) (interface{}, error) {
+			return []byte("test-secret-key"), nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, user.Id.String(), claims["user_id"])
+		assert.Equal(t, user.OrganizationId.String(), claims["organization_id"])
 	})
 
 	t.Run("should return error for invalid credentials", func(t *testing.T) {
@@ -158,7 +278,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 			Role:     enums.UserTypeCommon,
 		}
 
-		mockUserGateway.On("FindByEmail", ctx, input.Email).Return(user, nil)
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(user, nil)
 
 		// Act
 		result, err := useCase.Login(ctx, input)
@@ -176,7 +296,7 @@ func TestAuthUseCase_Login(t *testing.T) {
 			Password: "password123",
 		}
 
-		mockUserGateway.On("FindByEmail", ctx, input.Email).Return(nil, gorm.ErrRecordNotFound)
+		mockUserGateway.On("FindByEmail", systemCtx, input.Email).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		result, err := useCase.Login(ctx, input)
@@ -187,3 +307,38 @@ func TestAuthUseCase_Login(t *testing.T) {
 		mockUserGateway.AssertExpectations(t)
 	})
 }
+
+func TestAuthUseCase_UpdateLocale(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should store the normalized locale", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewAUthUseCase(mockUserGateway, new(MockOrganizationGateway), "DEFAULT")
+
+		user := &entity.User{Id: uuid.New(), Locale: enums.LocalePtBR}
+		mockUserGateway.On("FindByID", ctx, user.Id).Return(user, nil)
+		mockUserGateway.On("Update", ctx, user).Return(nil)
+
+		// Act
+		err := useCase.UpdateLocale(ctx, user.Id, dto.UpdateLocaleDTO{Locale: "es_MX"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.LocaleEs, user.Locale)
+		mockUserGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject unsupported locales", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewAUthUseCase(mockUserGateway, new(MockOrganizationGateway), "DEFAULT")
+
+		// Act
+		err := useCase.UpdateLocale(ctx, uuid.New(), dto.UpdateLocaleDTO{Locale: "de"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+		mockUserGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
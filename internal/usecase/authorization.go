@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+func requireAdmin(ctx context.Context, userGateway gateway.UserGateway, userID uuid.UUID) (*entity.User, error) {
+	user, err := userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role != enums.UserTypeAdmin {
+		return nil, ErrUnauthorized
+	}
+
+	return user, nil
+}
+
+// findAccessibleTravel carrega a solicitação garantindo que o usuário participa dela
+// (solicitante ou viajante vinculado) ou é aprovador.
+func findAccessibleTravel(
+	ctx context.Context,
+	userGateway gateway.UserGateway,
+	travelGateway gateway.TravelRequestGateway,
+	travelRequestID uuid.UUID,
+	userID uuid.UUID,
+) (*entity.User, *entity.TravelRequest, error) {
+	user, err := userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	travelRequest, err := travelGateway.FindByID(ctx, travelRequestID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if user.Role != enums.UserTypeAdmin && !travelRequest.IsVisibleTo(user.Id) {
+		return nil, nil, ErrUnauthorized
+	}
+
+	return user, travelRequest, nil
+}
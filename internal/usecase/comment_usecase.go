@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmptyComment              = errors.New("o comentário não pode ser vazio")
+	ErrInternalCommentNotAllowed = errors.New("somente aprovadores podem publicar comentários internos")
+)
+
+type CommentUseCase interface {
+	ListComments(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID) ([]entity.TravelRequestComment, error)
+	AddComment(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID, input dto.CreateCommentDTO) (*entity.TravelRequestComment, error)
+	UpdateComment(ctx context.Context, travelRequestID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, input dto.UpdateCommentDTO) (*entity.TravelRequestComment, error)
+	DeleteComment(ctx context.Context, travelRequestID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) error
+}
+
+type CommentUseCaseImpl struct {
+	commentGateway      gateway.CommentGateway
+	travelGateway       gateway.TravelRequestGateway
+	userGateway         gateway.UserGateway
+	notificationService NotificationUseCae
+	transactor          gateway.Transactor
+}
+
+func NewCommentUseCase(
+	commentGateway gateway.CommentGateway,
+	travelGateway gateway.TravelRequestGateway,
+	userGateway gateway.UserGateway,
+	notificationService NotificationUseCae,
+	transactor gateway.Transactor,
+) *CommentUseCaseImpl {
+	return &CommentUseCaseImpl{
+		commentGateway:      commentGateway,
+		travelGateway:       travelGateway,
+		userGateway:         userGateway,
+		notificationService: notificationService,
+		transactor:          transactor,
+	}
+}
+
+func (uc *CommentUseCaseImpl) ListComments(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID) ([]entity.TravelRequestComment, error) {
+	user, _, err := uc.findDiscussion(ctx, travelRequestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.commentGateway.ListByTravelRequestID(ctx, travelRequestID, user.Role == enums.UserTypeAdmin)
+}
+
+// AddComment publica o comentário e avisa a outra parte: aprovadores avisam o solicitante e os
+// viajantes; os demais avisam os aprovadores. Comentários internos só notificam aprovadores.
+func (uc *CommentUseCaseImpl) AddComment(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID, input dto.CreateCommentDTO) (*entity.TravelRequestComment, error) {
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, ErrEmptyComment
+	}
+
+	user, travelRequest, err := uc.findDiscussion(ctx, travelRequestID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Internal && user.Role != enums.UserTypeAdmin {
+		return nil, ErrInternalCommentNotAllowed
+	}
+
+	comment := &entity.TravelRequestComment{
+		Id:              uuid.New(),
+		TravelRequestId: travelRequest.Id,
+		AuthorId:        user.Id,
+		AuthorName:      user.Name,
+		Body:            body,
+		Internal:        input.Internal,
+		CreatedAt:       time.Now(),
+	}
+
+	// Sem os destinatários o aviso se perderia; a falha desfaz a publicação do comentário.
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.commentGateway.Create(ctx, comment); err != nil {
+			return err
+		}
+
+		recipients, err := uc.commentRecipients(ctx, user, travelRequest, comment)
+		if err != nil {
+			return err
+		}
+
+		if len(recipients) == 0 {
+			return nil
+		}
+
+		return uc.notificationService.NotifyComment(ctx, travelRequest, comment, recipients)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (uc *CommentUseCaseImpl) UpdateComment(ctx context.Context, travelRequestID uuid.UUID, commentID uuid.UUID, userID uuid.UUID, input dto.UpdateCommentDTO) (*entity.TravelRequestComment, error) {
+	body := strings.TrimSpace(input.Body)
+	if body == "" {
+		return nil, ErrEmptyComment
+	}
+
+	user, comment, err := uc.findComment(ctx, travelRequestID, commentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comment.CanBeEditedBy(user) {
+		return nil, ErrUnauthorized
+	}
+
+	now := time.Now()
+	comment.Body = body
+	comment.UpdatedAt = &now
+
+	if err := uc.commentGateway.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+func (uc *CommentUseCaseImpl) DeleteComment(ctx context.Context, travelRequestID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) error {
+	user, comment, err := uc.findComment(ctx, travelRequestID, commentID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !comment.CanBeDeletedBy(user) {
+		return ErrUnauthorized
+	}
+
+	return uc.commentGateway.Delete(ctx, comment)
+}
+
+func (uc *CommentUseCaseImpl) findDiscussion(ctx context.Context, travelRequestID uuid.UUID, userID uuid.UUID) (*entity.User, *entity.TravelRequest, error) {
+	return findAccessibleTravel(ctx, uc.userGateway, uc.travelGateway, travelRequestID, userID)
+}
+
+func (uc *CommentUseCaseImpl) findComment(ctx context.Context, travelRequestID uuid.UUID, commentID uuid.UUID, userID uuid.UUID) (*entity.User, *entity.TravelRequestComment, error) {
+	user, _, err := uc.findDiscussion(ctx, travelRequestID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, err := uc.commentGateway.FindByID(ctx, travelRequestID, commentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !comment.IsVisibleTo(user) {
+		return nil, nil, ErrUnauthorized
+	}
+
+	return user, comment, nil
+}
+
+func (uc *CommentUseCaseImpl) commentRecipients(ctx context.Context, author *entity.User, travelRequest *entity.TravelRequest, comment *entity.TravelRequestComment) ([]entity.NotificationRecipient, error) {
+	var candidates []entity.NotificationRecipient
+
+	if author.Role == enums.UserTypeAdmin && !comment.Internal {
+		candidates = travelRequest.NotificationRecipients(organizationLocale(ctx))
+	} else {
+		approvers, err := uc.userGateway.ListActiveByRole(ctx, enums.UserTypeAdmin)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, approver := range approvers {
+			candidates = append(candidates, entity.NotificationRecipient{Name: approver.Name, Email: approver.Email, Locale: approver.Locale, UserId: &approver.Id})
+		}
+	}
+
+	recipients := make([]entity.NotificationRecipient, 0, len(candidates))
+	for _, recipient := range candidates {
+		if !strings.EqualFold(recipient.Email, author.Email) {
+			recipients = append(recipients, recipient)
+		}
+	}
+
+	return recipients, nil
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCommentGateway struct {
+	mock.Mock
+}
+
+func (m *MockCommentGateway) Create(ctx context.Context, comment *entity.TravelRequestComment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentGateway) FindByID(ctx context.Context, travelRequestID uuid.UUID, id uuid.UUID) (*entity.TravelRequestComment, error) {
+	args := m.Called(ctx, travelRequestID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelRequestComment), args.Error(1)
+}
+
+func (m *MockCommentGateway) ListByTravelRequestID(ctx context.Context, travelRequestID uuid.UUID, includeInternal bool) ([]entity.TravelRequestComment, error) {
+	args := m.Called(ctx, travelRequestID, includeInternal)
+	return args.Get(0).([]entity.TravelRequestComment), args.Error(1)
+}
+
+func (m *MockCommentGateway) Update(ctx context.Context, comment *entity.TravelRequestComment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func (m *MockCommentGateway) Delete(ctx context.Context, comment *entity.TravelRequestComment) error {
+	args := m.Called(ctx, comment)
+	return args.Error(0)
+}
+
+func TestCommentUseCase(t *testing.T) {
+	ctx := context.Background()
+
+	requester := &entity.User{Id: uuid.New(), Name: "Requester", Email: "requester@example.com", Role: enums.UserTypeCommon}
+	approver := &entity.User{Id: uuid.New(), Name: "Approver", Email: "approver@example.com", Role: enums.UserTypeAdmin}
+	otherApprover := entity.User{Id: uuid.New(), Name: "Other Approver", Email: "other@example.com", Role: enums.UserTypeAdmin}
+	outsider := &entity.User{Id: uuid.New(), Name: "Outsider", Email: "outsider@example.com", Role: enums.UserTypeCommon}
+
+	travelRequest := &entity.TravelRequest{
+		Id:              uuid.New(),
+		UserId:          requester.Id,
+		DestinationName: "Paris",
+		User:            *requester,
+		Travelers:       []entity.Traveler{{Id: uuid.New(), Name: "Traveler", Email: "traveler@example.com"}},
+	}
+
+	setup := func() (*CommentUseCaseImpl, *MockCommentGateway, *MockTravelGateway, *MockUserGateway, *MockNotificationService) {
+		commentGateway := new(MockCommentGateway)
+		travelGateway := new(MockTravelGateway)
+		userGateway := new(MockUserGateway)
+		notificationService := new(MockNotificationService)
+
+		userGateway.On("FindByID", ctx, requester.Id).Return(requester, nil)
+		userGateway.On("FindByID", ctx, approver.Id).Return(approver, nil)
+		userGateway.On("FindByID", ctx, outsider.Id).Return(outsider, nil)
+		travelGateway.On("FindByID", ctx, travelRequest.Id).Return(travelRequest, nil)
+
+		return NewCommentUseCase(commentGateway, travelGateway, userGateway, notificationService, passthroughTransactor{}), commentGateway, travelGateway, userGateway, notificationService
+	}
+
+	t.Run("should notify requester and travelers when an approver comments", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, notificationService := setup()
+		commentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestComment")).Return(nil)
+		notificationService.On("NotifyComment", mock.Anything, travelRequest, mock.AnythingOfType("*entity.TravelRequestComment"), []entity.NotificationRecipient{
+			{Name: "Requester", Email: "requester@example.com", UserId: &requester.Id},
+			{Name: "Traveler", Email: "traveler@example.com", Locale: enums.DefaultLocale},
+		}).Return(nil)
+
+		// Act
+		comment, err := useCase.AddComment(ctx, travelRequest.Id, approver.Id, dto.CreateCommentDTO{Body: "  Pode reduzir a hospedagem?  "})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Pode reduzir a hospedagem?", comment.Body)
+		assert.Equal(t, "Approver", comment.AuthorName)
+		notificationService.AssertExpectations(t)
+	})
+
+	t.Run("should notify approvers when the requester comments", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, userGateway, notificationService := setup()
+		commentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestComment")).Return(nil)
+		userGateway.On("ListActiveByRole", ctx, enums.UserTypeAdmin).Return([]entity.User{*approver, otherApprover}, nil)
+		notificationService.On("NotifyComment", mock.Anything, travelRequest, mock.AnythingOfType("*entity.TravelRequestComment"), []entity.NotificationRecipient{
+			{Name: "Approver", Email: "approver@example.com", UserId: &approver.Id},
+			{Name: "Other Approver", Email: "other@example.com", UserId: &otherApprover.Id},
+		}).Return(nil)
+
+		// Act
+		_, err := useCase.AddComment(ctx, travelRequest.Id, requester.Id, dto.CreateCommentDTO{Body: "Sim, posso."})
+
+		// Assert
+		assert.NoError(t, err)
+		notificationService.AssertExpectations(t)
+	})
+
+	t.Run("should keep internal comments among approvers", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, userGateway, notificationService := setup()
+		commentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestComment")).Return(nil)
+		userGateway.On("ListActiveByRole", ctx, enums.UserTypeAdmin).Return([]entity.User{*approver, otherApprover}, nil)
+		notificationService.On("NotifyComment", mock.Anything, travelRequest, mock.AnythingOfType("*entity.TravelRequestComment"), []entity.NotificationRecipient{
+			{Name: "Other Approver", Email: "other@example.com", UserId: &otherApprover.Id},
+		}).Return(nil)
+
+		// Act
+		comment, err := useCase.AddComment(ctx, travelRequest.Id, approver.Id, dto.CreateCommentDTO{Body: "Verificar orçamento", Internal: true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, comment.Internal)
+		notificationService.AssertExpectations(t)
+	})
+
+	t.Run("should fail when the notification cannot be queued with the comment", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, notificationService := setup()
+		commentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestComment")).Return(nil)
+		notificationService.On("NotifyComment", mock.Anything, travelRequest, mock.AnythingOfType("*entity.TravelRequestComment"), mock.Anything).Return(errors.New("conexão perdida"))
+
+		// Act
+		comment, err := useCase.AddComment(ctx, travelRequest.Id, approver.Id, dto.CreateCommentDTO{Body: "Ok"})
+
+		// Assert
+		assert.EqualError(t, err, "conexão perdida")
+		assert.Nil(t, comment)
+	})
+
+	t.Run("should fail when the recipients cannot be loaded", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, userGateway, notificationService := setup()
+		commentGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequestComment")).Return(nil)
+		userGateway.On("ListActiveByRole", ctx, enums.UserTypeAdmin).Return([]entity.User(nil), errors.New("conexão perdida"))
+
+		// Act
+		comment, err := useCase.AddComment(ctx, travelRequest.Id, requester.Id, dto.CreateCommentDTO{Body: "Sim, posso."})
+
+		// Assert
+		assert.EqualError(t, err, "conexão perdida")
+		assert.Nil(t, comment)
+		notificationService.AssertNotCalled(t, "NotifyComment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not allow requesters to post internal comments", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, _ := setup()
+
+		// Act
+		comment, err := useCase.AddComment(ctx, travelRequest.Id, requester.Id, dto.CreateCommentDTO{Body: "Oi", Internal: true})
+
+		// Assert
+		assert.Nil(t, comment)
+		assert.Equal(t, ErrInternalCommentNotAllowed, err)
+		commentGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject users outside the request", func(t *testing.T) {
+		// Arrange
+		useCase, _, _, _, _ := setup()
+
+		// Act
+		comments, err := useCase.ListComments(ctx, travelRequest.Id, outsider.Id)
+
+		// Assert
+		assert.Nil(t, comments)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("should hide internal comments from requesters", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, _ := setup()
+		commentGateway.On("ListByTravelRequestID", ctx, travelRequest.Id, false).Return([]entity.TravelRequestComment{}, nil)
+
+		// Act
+		_, err := useCase.ListComments(ctx, travelRequest.Id, requester.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		commentGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject empty comments", func(t *testing.T) {
+		// Arrange
+		useCase, _, _, _, _ := setup()
+
+		// Act
+		_, err := useCase.AddComment(ctx, travelRequest.Id, requester.Id, dto.CreateCommentDTO{Body: "   "})
+
+		// Assert
+		assert.Equal(t, ErrEmptyComment, err)
+	})
+
+	t.Run("should only let the author edit a comment", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, _ := setup()
+		comment := &entity.TravelRequestComment{Id: uuid.New(), TravelRequestId: travelRequest.Id, AuthorId: requester.Id, Body: "Oi"}
+		commentGateway.On("FindByID", ctx, travelRequest.Id, comment.Id).Return(comment, nil)
+		commentGateway.On("Update", ctx, comment).Return(nil)
+
+		// Act
+		_, approverErr := useCase.UpdateComment(ctx, travelRequest.Id, comment.Id, approver.Id, dto.UpdateCommentDTO{Body: "Editado"})
+		updated, authorErr := useCase.UpdateComment(ctx, travelRequest.Id, comment.Id, requester.Id, dto.UpdateCommentDTO{Body: "Editado"})
+
+		// Assert
+		assert.Equal(t, ErrUnauthorized, approverErr)
+		assert.NoError(t, authorErr)
+		assert.Equal(t, "Editado", updated.Body)
+		assert.NotNil(t, updated.UpdatedAt)
+	})
+
+	t.Run("should let approvers delete any visible comment", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, _ := setup()
+		comment := &entity.TravelRequestComment{Id: uuid.New(), TravelRequestId: travelRequest.Id, AuthorId: requester.Id, Body: "Oi"}
+		commentGateway.On("FindByID", ctx, travelRequest.Id, comment.Id).Return(comment, nil)
+		commentGateway.On("Delete", ctx, comment).Return(nil)
+
+		// Act
+		err := useCase.DeleteComment(ctx, travelRequest.Id, comment.Id, approver.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		commentGateway.AssertExpectations(t)
+	})
+
+	t.Run("should not expose internal comments to requesters by id", func(t *testing.T) {
+		// Arrange
+		useCase, commentGateway, _, _, _ := setup()
+		comment := &entity.TravelRequestComment{Id: uuid.New(), TravelRequestId: travelRequest.Id, AuthorId: approver.Id, Internal: true}
+		commentGateway.On("FindByID", ctx, travelRequest.Id, comment.Id).Return(comment, nil)
+
+		// Act
+		err := useCase.DeleteComment(ctx, travelRequest.Id, comment.Id, requester.Id)
+
+		// Assert
+		assert.Equal(t, ErrUnauthorized, err)
+		commentGateway.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,423 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidCostCategory = errors.New("categoria de custo inválida")
+	ErrInvalidCostAmount   = errors.New("valor do custo não pode ser negativo")
+	ErrInvalidCurrency     = errors.New("moeda inválida, utilize o código ISO 4217 com três letras")
+	ErrInvalidBudget       = errors.New("departamento, ano e valor do orçamento são obrigatórios")
+	ErrBudgetExceeded      = errors.New("orçamento do departamento excedido")
+	ErrCostCenterRequired  = errors.New("centro de custo é obrigatório")
+	ErrCostCenterInactive  = errors.New("centro de custo inexistente ou inativo")
+	ErrInvalidCostCenter   = errors.New("código, nome e tipo (COST_CENTER ou PROJECT) do centro de custo são obrigatórios")
+	ErrCostCenterCodeTaken = errors.New("já existe um centro de custo com este código")
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type CostUseCase interface {
+	BaseCurrency(ctx context.Context) string
+	EstimateCosts(ctx context.Context, items []dto.CostItemDTO) ([]entity.TravelCostItem, error)
+	ResolveDepartment(ctx context.Context, requester *entity.User, department *string) (*string, error)
+	CheckBudget(ctx context.Context, travelRequest *entity.TravelRequest) error
+	ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error)
+	SaveExchangeRate(ctx context.Context, userID uuid.UUID, input dto.SaveExchangeRateDTO) (*entity.ExchangeRate, error)
+	ListDepartmentBudgets(ctx context.Context, year *int) ([]entity.DepartmentBudget, error)
+	SaveDepartmentBudget(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentBudgetDTO) (*entity.DepartmentBudget, error)
+	ResolveCostCenter(ctx context.Context, costCenterID *uuid.UUID, allocations []dto.CostAllocationDTO) (*entity.CostCenter, []entity.TravelCostAllocation, error)
+	ListCostCenters(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error)
+	CreateCostCenter(ctx context.Context, userID uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error)
+	UpdateCostCenter(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error)
+}
+
+type CostUseCaseImpl struct {
+	exchangeRateGateway gateway.ExchangeRateGateway
+	budgetGateway       gateway.DepartmentBudgetGateway
+	costCenterGateway   gateway.CostCenterGateway
+	departmentGateway   gateway.DepartmentGateway
+	travelGateway       gateway.TravelRequestGateway
+	userGateway         gateway.UserGateway
+	baseCurrency        string
+}
+
+func NewCostUseCase(
+	exchangeRateGateway gateway.ExchangeRateGateway,
+	budgetGateway gateway.DepartmentBudgetGateway,
+	costCenterGateway gateway.CostCenterGateway,
+	departmentGateway gateway.DepartmentGateway,
+	travelGateway gateway.TravelRequestGateway,
+	userGateway gateway.UserGateway,
+	baseCurrency string,
+) *CostUseCaseImpl {
+	return &CostUseCaseImpl{
+		exchangeRateGateway: exchangeRateGateway,
+		budgetGateway:       budgetGateway,
+		costCenterGateway:   costCenterGateway,
+		departmentGateway:   departmentGateway,
+		travelGateway:       travelGateway,
+		userGateway:         userGateway,
+		baseCurrency:        strings.ToUpper(baseCurrency),
+	}
+}
+
+// BaseCurrency retorna a moeda base da organização do contexto ou, sem ela, a da instalação.
+func (uc *CostUseCaseImpl) BaseCurrency(ctx context.Context) string {
+	if organization, ok := tenant.Organization(ctx); ok && organization.BaseCurrency != "" {
+		return organization.BaseCurrency
+	}
+
+	return uc.baseCurrency
+}
+
+func (uc *CostUseCaseImpl) EstimateCosts(ctx context.Context, items []dto.CostItemDTO) ([]entity.TravelCostItem, error) {
+	now := time.Now()
+	costItems := make([]entity.TravelCostItem, 0, len(items))
+
+	for _, item := range items {
+		if !item.Category.IsValid() {
+			return nil, ErrInvalidCostCategory
+		}
+
+		if item.Amount < 0 {
+			return nil, ErrInvalidCostAmount
+		}
+
+		currency := strings.ToUpper(item.Currency)
+		if !currencyPattern.MatchString(currency) {
+			return nil, ErrInvalidCurrency
+		}
+
+		baseAmount, err := uc.convert(ctx, item.Amount, currency)
+		if err != nil {
+			return nil, err
+		}
+
+		costItems = append(costItems, entity.TravelCostItem{
+			Id:          uuid.New(),
+			Category:    item.Category,
+			Description: item.Description,
+			Amount:      entity.RoundMoney(item.Amount),
+			Currency:    currency,
+			BaseAmount:  baseAmount,
+			CreatedAt:   now,
+		})
+	}
+
+	return costItems, nil
+}
+
+func (uc *CostUseCaseImpl) convert(ctx context.Context, amount float64, currency string) (float64, error) {
+	if currency == uc.BaseCurrency(ctx) {
+		return entity.RoundMoney(amount), nil
+	}
+
+	rate, err := uc.exchangeRateGateway.FindByCurrency(ctx, currency)
+	if err != nil {
+		return 0, err
+	}
+
+	return rate.Convert(amount), nil
+}
+
+// ResolveDepartment retorna o código do departamento cujo orçamento a solicitação consome. Vale o
+// departamento do solicitante; sem ele, o código informado precisa ser de um departamento ativo.
+func (uc *CostUseCaseImpl) ResolveDepartment(ctx context.Context, requester *entity.User, department *string) (*string, error) {
+	if requester.DepartmentId != nil {
+		current, err := uc.departmentGateway.FindByID(ctx, *requester.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+
+		return &current.Code, nil
+	}
+
+	if department == nil || strings.TrimSpace(*department) == "" {
+		return nil, nil
+	}
+
+	return uc.activeDepartmentCode(ctx, *department)
+}
+
+func (uc *CostUseCaseImpl) activeDepartmentCode(ctx context.Context, code string) (*string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	department, err := uc.departmentGateway.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if department == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDepartment, code)
+	}
+
+	if !department.Active {
+		return nil, ErrDepartmentInactive
+	}
+
+	return &department.Code, nil
+}
+
+// CheckBudget confere o orçamento do ano da ida no calendário local da partida: uma viagem que
+// sai na noite de 31 de dezembro conta para aquele ano mesmo que já seja janeiro em UTC.
+func (uc *CostUseCaseImpl) CheckBudget(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	if travelRequest.Department == nil || *travelRequest.Department == "" {
+		return nil
+	}
+
+	department := *travelRequest.Department
+	departure := travelRequest.LocalDepartureDate()
+	year := departure.Year()
+
+	budget, err := uc.budgetGateway.FindByDepartmentAndYear(ctx, department, year)
+	if err != nil {
+		return err
+	}
+
+	if budget == nil {
+		return nil
+	}
+
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, departure.Location())
+	to := from.AddDate(1, 0, 0)
+
+	approved, err := uc.travelGateway.SumApprovedTotalByDepartment(ctx, department, from, to, travelRequest.Id)
+	if err != nil {
+		return err
+	}
+
+	if budget.Exceeds(approved, travelRequest.EstimatedTotal) {
+		return fmt.Errorf(
+			"%w: departamento %s possui %.2f %s para %d, já aprovados %.2f e esta solicitação soma %.2f",
+			ErrBudgetExceeded,
+			department,
+			budget.Amount,
+			uc.BaseCurrency(ctx),
+			year,
+			approved,
+			travelRequest.EstimatedTotal,
+		)
+	}
+
+	return nil
+}
+
+func (uc *CostUseCaseImpl) ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	return uc.exchangeRateGateway.List(ctx)
+}
+
+func (uc *CostUseCaseImpl) SaveExchangeRate(ctx context.Context, userID uuid.UUID, input dto.SaveExchangeRateDTO) (*entity.ExchangeRate, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := strings.ToUpper(input.Currency)
+	if !currencyPattern.MatchString(currency) {
+		return nil, ErrInvalidCurrency
+	}
+
+	if input.Rate <= 0 {
+		return nil, ErrInvalidCostAmount
+	}
+
+	rate := &entity.ExchangeRate{
+		OrganizationId: admin.OrganizationId,
+		Currency:       currency,
+		Rate:           input.Rate,
+		UpdatedAt:      time.Now(),
+	}
+
+	if err := uc.exchangeRateGateway.Save(ctx, rate); err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (uc *CostUseCaseImpl) ListDepartmentBudgets(ctx context.Context, year *int) ([]entity.DepartmentBudget, error) {
+	return uc.budgetGateway.List(ctx, year)
+}
+
+func (uc *CostUseCaseImpl) SaveDepartmentBudget(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentBudgetDTO) (*entity.DepartmentBudget, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(input.Department) == "" || input.Year <= 0 || input.Amount < 0 {
+		return nil, ErrInvalidBudget
+	}
+
+	department, err := uc.activeDepartmentCode(ctx, input.Department)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := &entity.DepartmentBudget{
+		Id:             uuid.New(),
+		OrganizationId: admin.OrganizationId,
+		Department:     *department,
+		Year:           input.Year,
+		Amount:         entity.RoundMoney(input.Amount),
+		CreatedAt:      time.Now(),
+	}
+
+	if err := uc.budgetGateway.Save(ctx, budget); err != nil {
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+// ResolveCostCenter carrega o centro de custo responsável e os centros do rateio, que precisam
+// existir e estar ativos. Sem costCenterID, o responsável é o centro de maior percentual.
+func (uc *CostUseCaseImpl) ResolveCostCenter(
+	ctx context.Context,
+	costCenterID *uuid.UUID,
+	allocations []dto.CostAllocationDTO,
+) (*entity.CostCenter, []entity.TravelCostAllocation, error) {
+	if costCenterID == nil {
+		largest := 0.0
+		for _, allocation := range allocations {
+			if allocation.Percentage > largest {
+				id := allocation.CostCenterId
+				costCenterID, largest = &id, allocation.Percentage
+			}
+		}
+	}
+
+	if costCenterID == nil {
+		return nil, nil, ErrCostCenterRequired
+	}
+
+	ids := []uuid.UUID{*costCenterID}
+	for _, allocation := range allocations {
+		ids = append(ids, allocation.CostCenterId)
+	}
+
+	found, err := uc.costCenterGateway.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	costCenters := make(map[uuid.UUID]*entity.CostCenter, len(found))
+	for i := range found {
+		costCenters[found[i].Id] = &found[i]
+	}
+
+	for _, id := range ids {
+		if costCenter, ok := costCenters[id]; !ok || !costCenter.Active {
+			return nil, nil, fmt.Errorf("%w: %s", ErrCostCenterInactive, id)
+		}
+	}
+
+	lines := make([]entity.TravelCostAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		lines = append(lines, entity.TravelCostAllocation{
+			CostCenterId: allocation.CostCenterId,
+			Percentage:   math.Round(allocation.Percentage*100) / 100,
+			CostCenter:   costCenters[allocation.CostCenterId],
+		})
+	}
+
+	return costCenters[*costCenterID], lines, nil
+}
+
+func (uc *CostUseCaseImpl) ListCostCenters(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error) {
+	return uc.costCenterGateway.List(ctx, includeInactive)
+}
+
+func (uc *CostUseCaseImpl) CreateCostCenter(ctx context.Context, userID uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	costCenter := &entity.CostCenter{
+		Id:             uuid.New(),
+		OrganizationId: admin.OrganizationId,
+		Active:         true,
+		CreatedAt:      now,
+		UpdatedAt:      &now,
+	}
+
+	if err := uc.applyCostCenterInput(ctx, costCenter, input); err != nil {
+		return nil, err
+	}
+
+	if err := uc.costCenterGateway.Create(ctx, costCenter); err != nil {
+		return nil, err
+	}
+
+	return costCenter, nil
+}
+
+// UpdateCostCenter altera o cadastro; desativar um centro impede novos usos sem afetar as
+// solicitações que já o referenciam.
+func (uc *CostUseCaseImpl) UpdateCostCenter(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	costCenter, err := uc.costCenterGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyCostCenterInput(ctx, costCenter, input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	costCenter.UpdatedAt = &now
+
+	if err := uc.costCenterGateway.Update(ctx, costCenter); err != nil {
+		return nil, err
+	}
+
+	return costCenter, nil
+}
+
+// applyCostCenterInput valida os dados e garante que o código, normalizado em maiúsculas,
+// não pertença a outro centro.
+func (uc *CostUseCaseImpl) applyCostCenterInput(ctx context.Context, costCenter *entity.CostCenter, input dto.SaveCostCenterDTO) error {
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	name := strings.TrimSpace(input.Name)
+	if code == "" || name == "" || !input.Kind.IsValid() {
+		return ErrInvalidCostCenter
+	}
+
+	existing, err := uc.costCenterGateway.FindByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.Id != costCenter.Id {
+		return ErrCostCenterCodeTaken
+	}
+
+	costCenter.Code = code
+	costCenter.Name = name
+	costCenter.Kind = input.Kind
+	if input.Active != nil {
+		costCenter.Active = *input.Active
+	}
+
+	return nil
+}
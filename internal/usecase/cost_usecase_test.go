@@ -0,0 +1,544 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExchangeRateGateway struct {
+	mock.Mock
+}
+
+func (m *MockExchangeRateGateway) FindByCurrency(ctx context.Context, currency string) (*entity.ExchangeRate, error) {
+	args := m.Called(ctx, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateGateway) List(ctx context.Context) ([]entity.ExchangeRate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockExchangeRateGateway) Save(ctx context.Context, rate *entity.ExchangeRate) error {
+	args := m.Called(ctx, rate)
+	return args.Error(0)
+}
+
+type MockDepartmentBudgetGateway struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentBudgetGateway) FindByDepartmentAndYear(ctx context.Context, department string, year int) (*entity.DepartmentBudget, error) {
+	args := m.Called(ctx, department, year)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DepartmentBudget), args.Error(1)
+}
+
+func (m *MockDepartmentBudgetGateway) List(ctx context.Context, year *int) ([]entity.DepartmentBudget, error) {
+	args := m.Called(ctx, year)
+	return args.Get(0).([]entity.DepartmentBudget), args.Error(1)
+}
+
+func (m *MockDepartmentBudgetGateway) Save(ctx context.Context, budget *entity.DepartmentBudget) error {
+	args := m.Called(ctx, budget)
+	return args.Error(0)
+}
+
+type MockCostCenterGateway struct {
+	mock.Mock
+}
+
+func (m *MockCostCenterGateway) Create(ctx context.Context, costCenter *entity.CostCenter) error {
+	args := m.Called(ctx, costCenter)
+	return args.Error(0)
+}
+
+func (m *MockCostCenterGateway) Update(ctx context.Context, costCenter *entity.CostCenter) error {
+	args := m.Called(ctx, costCenter)
+	return args.Error(0)
+}
+
+func (m *MockCostCenterGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.CostCenter, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostCenterGateway) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.CostCenter, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostCenterGateway) FindByCode(ctx context.Context, code string) (*entity.CostCenter, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostCenterGateway) List(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error) {
+	args := m.Called(ctx, includeInactive)
+	return args.Get(0).([]entity.CostCenter), args.Error(1)
+}
+
+func TestCostUseCase_EstimateCosts(t *testing.T) {
+	// Setup
+	mockRateGateway := new(MockExchangeRateGateway)
+	useCase := NewCostUseCase(mockRateGateway, new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), new(MockDepartmentGateway), new(MockTravelGateway), new(MockUserGateway), "BRL")
+	ctx := context.Background()
+
+	t.Run("should convert foreign currencies to the base currency", func(t *testing.T) {
+		// Arrange
+		items := []dto.CostItemDTO{
+			{Category: enums.CostCategoryAirfare, Amount: 1200, Currency: "usd"},
+			{Category: enums.CostCategoryPerDiem, Amount: 350.5, Currency: "BRL"},
+		}
+
+		mockRateGateway.On("FindByCurrency", ctx, "USD").Return(&entity.ExchangeRate{Currency: "USD", Rate: 5.4}, nil).Once()
+
+		// Act
+		result, err := useCase.EstimateCosts(ctx, items)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "USD", result[0].Currency)
+		assert.Equal(t, 6480.0, result[0].BaseAmount)
+		assert.Equal(t, 350.5, result[1].BaseAmount)
+		mockRateGateway.AssertExpectations(t)
+	})
+
+	t.Run("should use the base currency of the organization in context", func(t *testing.T) {
+		// Arrange
+		orgCtx := tenant.WithOrganization(ctx, &entity.Organization{Id: uuid.New(), BaseCurrency: "EUR"})
+		items := []dto.CostItemDTO{
+			{Category: enums.CostCategoryLodging, Amount: 200, Currency: "EUR"},
+			{Category: enums.CostCategoryPerDiem, Amount: 100, Currency: "BRL"},
+		}
+
+		mockRateGateway.On("FindByCurrency", orgCtx, "BRL").Return(&entity.ExchangeRate{Currency: "BRL", Rate: 0.17}, nil).Once()
+
+		// Act
+		result, err := useCase.EstimateCosts(orgCtx, items)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", useCase.BaseCurrency(orgCtx))
+		assert.Equal(t, "BRL", useCase.BaseCurrency(ctx))
+		assert.Equal(t, 200.0, result[0].BaseAmount)
+		assert.Equal(t, 17.0, result[1].BaseAmount)
+		mockRateGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject invalid category", func(t *testing.T) {
+		// Arrange
+		items := []dto.CostItemDTO{{Category: "MEALS", Amount: 10, Currency: "BRL"}}
+
+		// Act
+		result, err := useCase.EstimateCosts(ctx, items)
+
+		// Assert
+		assert.Equal(t, ErrInvalidCostCategory, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should reject invalid currency", func(t *testing.T) {
+		// Arrange
+		items := []dto.CostItemDTO{{Category: enums.CostCategoryLodging, Amount: 10, Currency: "R$"}}
+
+		// Act
+		result, err := useCase.EstimateCosts(ctx, items)
+
+		// Assert
+		assert.Equal(t, ErrInvalidCurrency, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when exchange rate is missing", func(t *testing.T) {
+		// Arrange
+		rateErr := errors.New("taxa de câmbio não encontrada para a moeda informada")
+		items := []dto.CostItemDTO{{Category: enums.CostCategoryLodging, Amount: 10, Currency: "CHF"}}
+		mockRateGateway.On("FindByCurrency", ctx, "CHF").Return(nil, rateErr).Once()
+
+		// Act
+		result, err := useCase.EstimateCosts(ctx, items)
+
+		// Assert
+		assert.Equal(t, rateErr, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCostUseCase_ResolveDepartment(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	sales := &entity.Department{Id: uuid.New(), Code: "SALES", Name: "Vendas", Active: true}
+
+	t.Run("should use the requester department and ignore the informed one", func(t *testing.T) {
+		// Arrange
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), mockDepartmentGateway, new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		requester := &entity.User{Id: uuid.New(), DepartmentId: &sales.Id}
+		informed := "Marketing"
+		mockDepartmentGateway.On("FindByID", ctx, sales.Id).Return(sales, nil)
+
+		// Act
+		result, err := useCase.ResolveDepartment(ctx, requester, &informed)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "SALES", *result)
+		mockDepartmentGateway.AssertNotCalled(t, "FindByCode", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should accept a registered code when the requester has no department", func(t *testing.T) {
+		// Arrange
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), mockDepartmentGateway, new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		informed := " sales "
+		mockDepartmentGateway.On("FindByCode", ctx, "SALES").Return(sales, nil)
+
+		// Act
+		result, err := useCase.ResolveDepartment(ctx, &entity.User{Id: uuid.New()}, &informed)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "SALES", *result)
+	})
+
+	t.Run("should reject a department that is not registered", func(t *testing.T) {
+		// Arrange
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), mockDepartmentGateway, new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		informed := "Vendas "
+		mockDepartmentGateway.On("FindByCode", ctx, "VENDAS").Return(nil, nil)
+
+		// Act
+		result, err := useCase.ResolveDepartment(ctx, &entity.User{Id: uuid.New()}, &informed)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnknownDepartment)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should leave the department empty when none applies", func(t *testing.T) {
+		// Arrange
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), new(MockDepartmentGateway), new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		// Act
+		result, err := useCase.ResolveDepartment(ctx, &entity.User{Id: uuid.New()}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestCostUseCase_CheckBudget(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	department := "SALES"
+	departureDate := time.Date(2030, time.March, 10, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2031, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	travel := &entity.TravelRequest{
+		Id:             uuid.New(),
+		Department:     &department,
+		DepartureDate:  departureDate,
+		EstimatedTotal: 3000,
+	}
+
+	budget := &entity.DepartmentBudget{Department: department, Year: 2030, Amount: 10000}
+
+	t.Run("should allow approval within budget", func(t *testing.T) {
+		// Arrange
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), new(MockDepartmentGateway), mockTravelGateway, new(MockUserGateway), "BRL")
+
+		mockBudgetGateway.On("FindByDepartmentAndYear", ctx, department, 2030).Return(budget, nil)
+		mockTravelGateway.On("SumApprovedTotalByDepartment", ctx, department, from, to, travel.Id).Return(7000.0, nil)
+
+		// Act
+		err := useCase.CheckBudget(ctx, travel)
+
+		// Assert
+		assert.NoError(t, err)
+		mockBudgetGateway.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should return error when budget would be exceeded", func(t *testing.T) {
+		// Arrange
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), new(MockDepartmentGateway), mockTravelGateway, new(MockUserGateway), "BRL")
+
+		mockBudgetGateway.On("FindByDepartmentAndYear", ctx, department, 2030).Return(budget, nil)
+		mockTravelGateway.On("SumApprovedTotalByDepartment", ctx, department, from, to, travel.Id).Return(7000.01, nil)
+
+		// Act
+		err := useCase.CheckBudget(ctx, travel)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		assert.Contains(t, err.Error(), department)
+	})
+
+	t.Run("should use the year of the local departure date", func(t *testing.T) {
+		// Arrange
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), new(MockDepartmentGateway), mockTravelGateway, new(MockUserGateway), "BRL")
+
+		saoPaulo := entity.LoadTimezone("America/Sao_Paulo")
+		newYearsEve := &entity.TravelRequest{
+			Id:                uuid.New(),
+			Department:        &department,
+			DepartureDate:     time.Date(2031, time.January, 1, 1, 0, 0, 0, time.UTC),
+			DepartureTimezone: "America/Sao_Paulo",
+			EstimatedTotal:    3000,
+		}
+		localFrom := time.Date(2030, time.January, 1, 0, 0, 0, 0, saoPaulo)
+		localTo := time.Date(2031, time.January, 1, 0, 0, 0, 0, saoPaulo)
+
+		mockBudgetGateway.On("FindByDepartmentAndYear", ctx, department, 2030).Return(budget, nil)
+		mockTravelGateway.On("SumApprovedTotalByDepartment", ctx, department, localFrom, localTo, newYearsEve.Id).Return(7000.0, nil)
+
+		// Act
+		err := useCase.CheckBudget(ctx, newYearsEve)
+
+		// Assert
+		assert.NoError(t, err)
+		mockBudgetGateway.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should skip check when department has no budget", func(t *testing.T) {
+		// Arrange
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), new(MockDepartmentGateway), mockTravelGateway, new(MockUserGateway), "BRL")
+
+		mockBudgetGateway.On("FindByDepartmentAndYear", ctx, department, 2030).Return(nil, nil)
+
+		// Act
+		err := useCase.CheckBudget(ctx, travel)
+
+		// Assert
+		assert.NoError(t, err)
+		mockTravelGateway.AssertNotCalled(t, "SumApprovedTotalByDepartment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCostUseCase_SaveDepartmentBudget(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("should reject non admin users", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), new(MockDepartmentGateway), new(MockTravelGateway), mockUserGateway, "BRL")
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(&entity.User{Id: userID, Role: enums.UserTypeCommon}, nil)
+
+		// Act
+		result, err := useCase.SaveDepartmentBudget(ctx, userID, dto.SaveDepartmentBudgetDTO{Department: "SALES", Year: 2030, Amount: 1000})
+
+		// Assert
+		assert.Equal(t, ErrUnauthorized, err)
+		assert.Nil(t, result)
+		mockBudgetGateway.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should save budget for admin users", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), mockDepartmentGateway, new(MockTravelGateway), mockUserGateway, "BRL")
+
+		organizationID := uuid.New()
+		mockUserGateway.On("FindByID", ctx, userID).Return(&entity.User{Id: userID, OrganizationId: organizationID, Role: enums.UserTypeAdmin}, nil)
+		mockDepartmentGateway.On("FindByCode", ctx, "SALES").Return(&entity.Department{Id: uuid.New(), Code: "SALES", Active: true}, nil)
+		persistedID := uuid.New()
+		mockBudgetGateway.On("Save", ctx, mock.AnythingOfType("*entity.DepartmentBudget")).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.DepartmentBudget).Id = persistedID
+		}).Return(nil)
+
+		// Act
+		result, err := useCase.SaveDepartmentBudget(ctx, userID, dto.SaveDepartmentBudgetDTO{Department: " sales ", Year: 2030, Amount: 1000})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "SALES", result.Department)
+		assert.Equal(t, 1000.0, result.Amount)
+		assert.Equal(t, organizationID, result.OrganizationId)
+		assert.Equal(t, persistedID, result.Id)
+		mockBudgetGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject departments that are not registered", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockBudgetGateway := new(MockDepartmentBudgetGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), mockBudgetGateway, new(MockCostCenterGateway), mockDepartmentGateway, new(MockTravelGateway), mockUserGateway, "BRL")
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(&entity.User{Id: userID, Role: enums.UserTypeAdmin}, nil)
+		mockDepartmentGateway.On("FindByCode", ctx, "VENDAS").Return(nil, nil)
+
+		// Act
+		result, err := useCase.SaveDepartmentBudget(ctx, userID, dto.SaveDepartmentBudgetDTO{Department: "Vendas", Year: 2030, Amount: 1000})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnknownDepartment)
+		assert.Nil(t, result)
+		mockBudgetGateway.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCostUseCase_ResolveCostCenter(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	commercial := entity.CostCenter{Id: uuid.New(), Code: "CC-100", Kind: enums.CostCenterKindCostCenter, Active: true}
+	project := entity.CostCenter{Id: uuid.New(), Code: "PRJ-7", Kind: enums.CostCenterKindProject, Active: true}
+
+	t.Run("should pick the largest allocation as responsible when none is informed", func(t *testing.T) {
+		// Arrange
+		mockCostCenterGateway := new(MockCostCenterGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), mockCostCenterGateway, new(MockDepartmentGateway), new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		allocations := []dto.CostAllocationDTO{
+			{CostCenterId: commercial.Id, Percentage: 33.333},
+			{CostCenterId: project.Id, Percentage: 66.667},
+		}
+		mockCostCenterGateway.On("FindByIDs", ctx, []uuid.UUID{project.Id, commercial.Id, project.Id}).Return([]entity.CostCenter{commercial, project}, nil)
+
+		// Act
+		costCenter, lines, err := useCase.ResolveCostCenter(ctx, nil, allocations)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, project.Id, costCenter.Id)
+		assert.Len(t, lines, 2)
+		assert.Equal(t, 33.33, lines[0].Percentage)
+		assert.Equal(t, 66.67, lines[1].Percentage)
+		assert.Equal(t, "CC-100", lines[0].CostCenter.Code)
+	})
+
+	t.Run("should require a cost center", func(t *testing.T) {
+		// Arrange
+		mockCostCenterGateway := new(MockCostCenterGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), mockCostCenterGateway, new(MockDepartmentGateway), new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		// Act
+		costCenter, _, err := useCase.ResolveCostCenter(ctx, nil, nil)
+
+		// Assert
+		assert.Nil(t, costCenter)
+		assert.Equal(t, ErrCostCenterRequired, err)
+		mockCostCenterGateway.AssertNotCalled(t, "FindByIDs", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject inactive or unknown cost centers", func(t *testing.T) {
+		// Arrange
+		mockCostCenterGateway := new(MockCostCenterGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), mockCostCenterGateway, new(MockDepartmentGateway), new(MockTravelGateway), new(MockUserGateway), "BRL")
+
+		inactive := entity.CostCenter{Id: uuid.New(), Code: "CC-OLD", Kind: enums.CostCenterKindCostCenter}
+		mockCostCenterGateway.On("FindByIDs", ctx, []uuid.UUID{inactive.Id}).Return([]entity.CostCenter{inactive}, nil)
+
+		// Act
+		costCenter, _, err := useCase.ResolveCostCenter(ctx, &inactive.Id, nil)
+
+		// Assert
+		assert.Nil(t, costCenter)
+		assert.ErrorIs(t, err, ErrCostCenterInactive)
+		assert.Contains(t, err.Error(), inactive.Id.String())
+	})
+}
+
+func TestCostUseCase_CreateCostCenter(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	userID := uuid.New()
+	admin := &entity.User{Id: userID, Role: enums.UserTypeAdmin}
+
+	t.Run("should create an active cost center with an upper case code", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockCostCenterGateway := new(MockCostCenterGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), mockCostCenterGateway, new(MockDepartmentGateway), new(MockTravelGateway), mockUserGateway, "BRL")
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(admin, nil)
+		mockCostCenterGateway.On("FindByCode", ctx, "PRJ-ATLAS").Return(nil, nil)
+		mockCostCenterGateway.On("Create", ctx, mock.AnythingOfType("*entity.CostCenter")).Return(nil)
+
+		// Act
+		result, err := useCase.CreateCostCenter(ctx, userID, dto.SaveCostCenterDTO{Code: " prj-atlas ", Name: "Projeto Atlas", Kind: enums.CostCenterKindProject})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "PRJ-ATLAS", result.Code)
+		assert.True(t, result.Active)
+		mockCostCenterGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject a code already in use", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockCostCenterGateway := new(MockCostCenterGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), mockCostCenterGateway, new(MockDepartmentGateway), new(MockTravelGateway), mockUserGateway, "BRL")
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(admin, nil)
+		mockCostCenterGateway.On("FindByCode", ctx, "CC-100").Return(&entity.CostCenter{Id: uuid.New(), Code: "CC-100"}, nil)
+
+		// Act
+		result, err := useCase.CreateCostCenter(ctx, userID, dto.SaveCostCenterDTO{Code: "CC-100", Name: "Comercial", Kind: enums.CostCenterKindCostCenter})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrCostCenterCodeTaken, err)
+		mockCostCenterGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject unknown kinds", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewCostUseCase(new(MockExchangeRateGateway), new(MockDepartmentBudgetGateway), new(MockCostCenterGateway), new(MockDepartmentGateway), new(MockTravelGateway), mockUserGateway, "BRL")
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(admin, nil)
+
+		// Act
+		result, err := useCase.CreateCostCenter(ctx, userID, dto.SaveCostCenterDTO{Code: "CC-100", Name: "Comercial", Kind: "DEPARTMENT"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrInvalidCostCenter, err)
+	})
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultDestinationSearchLimit = 10
+	maxDestinationSearchLimit     = 50
+)
+
+type DestinationUseCase interface {
+	SearchDestinations(ctx context.Context, query string, limit int) ([]entity.Destination, error)
+	GetDestination(ctx context.Context, id uuid.UUID) (*entity.Destination, error)
+}
+
+type DestinationUseCaseImpl struct {
+	destinationGateway gateway.DestinationGateway
+}
+
+func NewDestinationUseCase(destinationGateway gateway.DestinationGateway) *DestinationUseCaseImpl {
+	return &DestinationUseCaseImpl{
+		destinationGateway: destinationGateway,
+	}
+}
+
+func (uc *DestinationUseCaseImpl) SearchDestinations(ctx context.Context, query string, limit int) ([]entity.Destination, error) {
+	if limit < 1 {
+		limit = defaultDestinationSearchLimit
+	}
+
+	if limit > maxDestinationSearchLimit {
+		limit = maxDestinationSearchLimit
+	}
+
+	return uc.destinationGateway.Search(ctx, query, limit)
+}
+
+func (uc *DestinationUseCaseImpl) GetDestination(ctx context.Context, id uuid.UUID) (*entity.Destination, error) {
+	return uc.destinationGateway.FindByID(ctx, id)
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDestinationGateway struct {
+	mock.Mock
+}
+
+func (m *MockDestinationGateway) Search(ctx context.Context, query string, limit int) ([]entity.Destination, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]entity.Destination), args.Error(1)
+}
+
+func (m *MockDestinationGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.Destination, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Destination), args.Error(1)
+}
+
+func (m *MockDestinationGateway) UpsertMany(ctx context.Context, destinations []entity.Destination) error {
+	args := m.Called(ctx, destinations)
+	return args.Error(0)
+}
+
+type MockDestinationUseCase struct {
+	mock.Mock
+}
+
+func (m *MockDestinationUseCase) SearchDestinations(ctx context.Context, query string, limit int) ([]entity.Destination, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]entity.Destination), args.Error(1)
+}
+
+func (m *MockDestinationUseCase) GetDestination(ctx context.Context, id uuid.UUID) (*entity.Destination, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Destination), args.Error(1)
+}
+
+func TestDestinationUseCase_SearchDestinations(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		limit         int
+		expectedLimit int
+	}{
+		{name: "should use the default limit", limit: 0, expectedLimit: 10},
+		{name: "should keep a valid limit", limit: 5, expectedLimit: 5},
+		{name: "should cap large limits", limit: 500, expectedLimit: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockGateway := new(MockDestinationGateway)
+			useCase := NewDestinationUseCase(mockGateway)
+			mockGateway.On("Search", ctx, "sao", tt.expectedLimit).Return([]entity.Destination{{Code: "BR-SAO"}}, nil)
+
+			// Act
+			result, err := useCase.SearchDestinations(ctx, "sao", tt.limit)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Len(t, result, 1)
+			mockGateway.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidDepartment      = errors.New("código e nome do departamento são obrigatórios")
+	ErrDepartmentCodeTaken    = errors.New("já existe um departamento com este código")
+	ErrDepartmentInactive     = errors.New("o departamento está inativo")
+	ErrManagerInactive        = errors.New("o gestor informado está inativo")
+	ErrDepartmentHeadInactive = errors.New("o responsável informado está inativo")
+	ErrUnknownUserEmail       = errors.New("nenhum usuário com o e-mail informado")
+	ErrUnknownDepartment      = errors.New("nenhum departamento com o código informado")
+)
+
+// ApproverResolver localiza quem responde pelas aprovações de um usuário, para que o fluxo de
+// aprovação possa encaminhar a solicitação ao gestor do viajante ou ao responsável pelo
+// departamento. Ambos os métodos retornam nil quando não há ninguém a quem encaminhar.
+type ApproverResolver interface {
+	ManagerOf(ctx context.Context, userID uuid.UUID) (*entity.User, error)
+	DepartmentHeadOf(ctx context.Context, userID uuid.UUID) (*entity.User, error)
+}
+
+type HierarchyUseCase interface {
+	ApproverResolver
+	ListDepartments(ctx context.Context, includeInactive bool) ([]entity.Department, error)
+	CreateDepartment(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error)
+	UpdateDepartment(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error)
+	AssignReportingLine(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, input dto.AssignReportingLineDTO) (*dto.UserOrgDTO, error)
+	ImportReportingLines(ctx context.Context, userID uuid.UUID, rows []dto.ReportingLineImportRowDTO) (*dto.ReportingLineImportResponseDTO, error)
+	GetApprovers(ctx context.Context, userID uuid.UUID, targetID uuid.UUID) (*dto.ApproversDTO, error)
+}
+
+type HierarchyUseCaseImpl struct {
+	departmentGateway gateway.DepartmentGateway
+	userGateway       gateway.UserGateway
+	transactor        gateway.Transactor
+}
+
+func NewHierarchyUseCase(departmentGateway gateway.DepartmentGateway, userGateway gateway.UserGateway, transactor gateway.Transactor) *HierarchyUseCaseImpl {
+	return &HierarchyUseCaseImpl{
+		departmentGateway: departmentGateway,
+		userGateway:       userGateway,
+		transactor:        transactor,
+	}
+}
+
+func (uc *HierarchyUseCaseImpl) ListDepartments(ctx context.Context, includeInactive bool) ([]entity.Department, error) {
+	return uc.departmentGateway.List(ctx, includeInactive)
+}
+
+func (uc *HierarchyUseCaseImpl) CreateDepartment(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	department := &entity.Department{
+		Id:             uuid.New(),
+		OrganizationId: admin.OrganizationId,
+		Active:         true,
+		CreatedAt:      now,
+		UpdatedAt:      &now,
+	}
+
+	if err := uc.applyDepartmentInput(ctx, department, input); err != nil {
+		return nil, err
+	}
+
+	if err := uc.departmentGateway.Create(ctx, department); err != nil {
+		return nil, err
+	}
+
+	return department, nil
+}
+
+// UpdateDepartment altera o cadastro; desativar um departamento impede novas atribuições sem
+// retirar os usuários que já pertencem a ele.
+func (uc *HierarchyUseCaseImpl) UpdateDepartment(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveDepartmentDTO) (*entity.Department, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	department, err := uc.departmentGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyDepartmentInput(ctx, department, input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	department.UpdatedAt = &now
+
+	if err := uc.departmentGateway.Update(ctx, department); err != nil {
+		return nil, err
+	}
+
+	return department, nil
+}
+
+// applyDepartmentInput valida os dados, garante a unicidade do código (gravado em maiúsculas) e
+// que o responsável seja um usuário ativo.
+func (uc *HierarchyUseCaseImpl) applyDepartmentInput(ctx context.Context, department *entity.Department, input dto.SaveDepartmentDTO) error {
+	code := strings.ToUpper(strings.TrimSpace(input.Code))
+	name := strings.TrimSpace(input.Name)
+	if code == "" || name == "" {
+		return ErrInvalidDepartment
+	}
+
+	existing, err := uc.departmentGateway.FindByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil && existing.Id != department.Id {
+		return ErrDepartmentCodeTaken
+	}
+
+	if input.HeadUserId != nil {
+		head, err := uc.userGateway.FindByID(ctx, *input.HeadUserId)
+		if err != nil {
+			return err
+		}
+
+		if !isActiveUser(head) {
+			return ErrDepartmentHeadInactive
+		}
+	}
+
+	department.Code = code
+	department.Name = name
+	department.HeadUserId = input.HeadUserId
+	if input.Active != nil {
+		department.Active = *input.Active
+	}
+
+	return nil
+}
+
+// AssignReportingLine substitui o departamento e o gestor de um usuário, recusando gestores
+// que tornariam a hierarquia circular. A hierarquia da organização fica bloqueada da leitura
+// até a gravação, para que duas atribuições simultâneas não fechem um ciclo.
+func (uc *HierarchyUseCaseImpl) AssignReportingLine(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, input dto.AssignReportingLineDTO) (*dto.UserOrgDTO, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	target, err := uc.userGateway.FindByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.DepartmentId != nil {
+		department, err := uc.departmentGateway.FindByID(ctx, *input.DepartmentId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !department.Active {
+			return nil, ErrDepartmentInactive
+		}
+	}
+
+	if input.ManagerId != nil {
+		manager, err := uc.userGateway.FindByID(ctx, *input.ManagerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isActiveUser(manager) {
+			return nil, ErrManagerInactive
+		}
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		lines, err := uc.lockReportingLines(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := lines.Assign(target.Id, input.ManagerId); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		target.DepartmentId = input.DepartmentId
+		target.ManagerId = input.ManagerId
+		target.UpdatedAt = &now
+
+		return uc.userGateway.UpdateReportingLine(ctx, target)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserOrgDTO(target), nil
+}
+
+// ImportReportingLines aplica as linhas do CSV na ordem do arquivo, com a hierarquia da
+// organização bloqueada durante toda a importação. Cada linha é validada e gravada
+// isoladamente, de forma que uma falha não impede as demais; a checagem de ciclos considera as
+// linhas já aplicadas.
+func (uc *HierarchyUseCaseImpl) ImportReportingLines(ctx context.Context, userID uuid.UUID, rows []dto.ReportingLineImportRowDTO) (*dto.ReportingLineImportResponseDTO, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, 0, len(rows)*2)
+	for _, row := range rows {
+		emails = append(emails, strings.ToLower(row.Email))
+		if row.ManagerEmail != "" {
+			emails = append(emails, strings.ToLower(row.ManagerEmail))
+		}
+	}
+
+	users, err := uc.userGateway.FindByEmails(ctx, emails)
+	if err != nil {
+		return nil, err
+	}
+
+	byEmail := make(map[string]*entity.User, len(users))
+	for i := range users {
+		byEmail[strings.ToLower(users[i].Email)] = &users[i]
+	}
+
+	departments, err := uc.departmentGateway.List(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	byCode := make(map[string]*entity.Department, len(departments))
+	for i := range departments {
+		byCode[departments[i].Code] = &departments[i]
+	}
+
+	report := &dto.ReportingLineImportResponseDTO{
+		Results: make([]dto.ReportingLineImportItemDTO, 0, len(rows)),
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		lines, err := uc.lockReportingLines(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			item := dto.ReportingLineImportItemDTO{Line: row.Line, Email: row.Email}
+
+			// Cada linha grava no seu próprio savepoint: uma falha desfaz apenas a linha.
+			err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				return uc.importReportingLine(ctx, row, byEmail, byCode, lines)
+			})
+			if err != nil {
+				item.Error = err.Error()
+				report.Failed++
+			} else {
+				report.Succeeded++
+			}
+
+			report.Results = append(report.Results, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (uc *HierarchyUseCaseImpl) importReportingLine(
+	ctx context.Context,
+	row dto.ReportingLineImportRowDTO,
+	byEmail map[string]*entity.User,
+	byCode map[string]*entity.Department,
+	lines entity.ReportingLines,
+) error {
+	user, found := byEmail[strings.ToLower(row.Email)]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownUserEmail, row.Email)
+	}
+
+	var departmentID *uuid.UUID
+	if row.DepartmentCode != "" {
+		department, found := byCode[strings.ToUpper(row.DepartmentCode)]
+		if !found {
+			return fmt.Errorf("%w: %s", ErrUnknownDepartment, row.DepartmentCode)
+		}
+
+		if !department.Active {
+			return ErrDepartmentInactive
+		}
+		departmentID = &department.Id
+	}
+
+	var managerID *uuid.UUID
+	if row.ManagerEmail != "" {
+		manager, found := byEmail[strings.ToLower(row.ManagerEmail)]
+		if !found {
+			return fmt.Errorf("%w: %s", ErrUnknownUserEmail, row.ManagerEmail)
+		}
+
+		if !isActiveUser(manager) {
+			return ErrManagerInactive
+		}
+		managerID = &manager.Id
+	}
+
+	previousManager, hadManager := lines[user.Id]
+
+	if err := lines.Assign(user.Id, managerID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	updated := *user
+	updated.DepartmentId = departmentID
+	updated.ManagerId = managerID
+	updated.UpdatedAt = &now
+
+	if err := uc.userGateway.UpdateReportingLine(ctx, &updated); err != nil {
+		if hadManager {
+			lines[user.Id] = previousManager
+		} else {
+			delete(lines, user.Id)
+		}
+		return err
+	}
+
+	*user = updated
+
+	return nil
+}
+
+// GetApprovers mostra a quem as aprovações do usuário são encaminhadas; cada usuário pode
+// consultar a própria hierarquia e administradores a de qualquer um.
+func (uc *HierarchyUseCaseImpl) GetApprovers(ctx context.Context, userID uuid.UUID, targetID uuid.UUID) (*dto.ApproversDTO, error) {
+	if userID != targetID {
+		if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	manager, err := uc.ManagerOf(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := uc.DepartmentHeadOf(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := &dto.ApproversDTO{UserId: targetID}
+	if manager != nil {
+		approvers.Manager = toUserOrgDTO(manager)
+	}
+	if head != nil {
+		approvers.DepartmentHead = toUserOrgDTO(head)
+	}
+
+	return approvers, nil
+}
+
+// ManagerOf retorna o gestor direto do usuário. Gestores inativos são pulados e a aprovação
+// sobe para o próximo nível da hierarquia.
+func (uc *HierarchyUseCaseImpl) ManagerOf(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	visited := map[uuid.UUID]bool{user.Id: true}
+	for user.ManagerId != nil && !visited[*user.ManagerId] {
+		visited[*user.ManagerId] = true
+
+		user, err = uc.userGateway.FindByID(ctx, *user.ManagerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if isActiveUser(user) {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DepartmentHeadOf retorna o responsável pelo departamento do usuário. Não há responsável a
+// quem encaminhar quando o departamento não tem um, quando ele está inativo ou quando é o
+// próprio usuário.
+func (uc *HierarchyUseCaseImpl) DepartmentHeadOf(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.DepartmentId == nil {
+		return nil, nil
+	}
+
+	department, err := uc.departmentGateway.FindByID(ctx, *user.DepartmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if department.HeadUserId == nil || *department.HeadUserId == user.Id {
+		return nil, nil
+	}
+
+	head, err := uc.userGateway.FindByID(ctx, *department.HeadUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isActiveUser(head) {
+		return nil, nil
+	}
+
+	return head, nil
+}
+
+// lockReportingLines bloqueia a hierarquia da organização até o fim da transação e a carrega.
+func (uc *HierarchyUseCaseImpl) lockReportingLines(ctx context.Context) (entity.ReportingLines, error) {
+	if err := uc.userGateway.LockReportingLines(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := uc.userGateway.ListReportingLines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewReportingLines(users), nil
+}
+
+func isActiveUser(user *entity.User) bool {
+	return user.IsActive && user.DeletedAt == nil
+}
+
+func toUserOrgDTO(user *entity.User) *dto.UserOrgDTO {
+	return &dto.UserOrgDTO{
+		Id:           user.Id,
+		Name:         user.Name,
+		Email:        user.Email,
+		Role:         user.Role,
+		DepartmentId: user.DepartmentId,
+		ManagerId:    user.ManagerId,
+	}
+}
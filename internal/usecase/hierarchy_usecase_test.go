@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDepartmentGateway struct {
+	mock.Mock
+}
+
+func (m *MockDepartmentGateway) Create(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentGateway) Update(ctx context.Context, department *entity.Department) error {
+	args := m.Called(ctx, department)
+	return args.Error(0)
+}
+
+func (m *MockDepartmentGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.Department, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentGateway) FindByCode(ctx context.Context, code string) (*entity.Department, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Department), args.Error(1)
+}
+
+func (m *MockDepartmentGateway) List(ctx context.Context, includeInactive bool) ([]entity.Department, error) {
+	args := m.Called(ctx, includeInactive)
+	return args.Get(0).([]entity.Department), args.Error(1)
+}
+
+func TestHierarchyUseCase_CreateDepartment(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	userID := uuid.New()
+	admin := &entity.User{Id: userID, Role: enums.UserTypeAdmin, IsActive: true}
+
+	t.Run("should create an active department with an upper case code", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(admin, nil)
+		mockDepartmentGateway.On("FindByCode", ctx, "FIN").Return(nil, nil)
+		mockDepartmentGateway.On("Create", ctx, mock.AnythingOfType("*entity.Department")).Return(nil)
+
+		// Act
+		result, err := useCase.CreateDepartment(ctx, userID, dto.SaveDepartmentDTO{Code: " fin ", Name: "Financeiro", HeadUserId: &userID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "FIN", result.Code)
+		assert.Equal(t, &userID, result.HeadUserId)
+		assert.True(t, result.Active)
+		mockDepartmentGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject an inactive department head", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+		head := &entity.User{Id: uuid.New(), IsActive: false}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(admin, nil)
+		mockUserGateway.On("FindByID", ctx, head.Id).Return(head, nil)
+		mockDepartmentGateway.On("FindByCode", ctx, "FIN").Return(nil, nil)
+
+		// Act
+		result, err := useCase.CreateDepartment(ctx, userID, dto.SaveDepartmentDTO{Code: "FIN", Name: "Financeiro", HeadUserId: &head.Id})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrDepartmentHeadInactive, err)
+		mockDepartmentGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should only allow admins", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewHierarchyUseCase(new(MockDepartmentGateway), mockUserGateway, passthroughTransactor{})
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(&entity.User{Id: userID, Role: enums.UserTypeCommon}, nil)
+
+		// Act
+		result, err := useCase.CreateDepartment(ctx, userID, dto.SaveDepartmentDTO{Code: "FIN", Name: "Financeiro"})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
+func TestHierarchyUseCase_AssignReportingLine(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &entity.User{Id: adminID, Role: enums.UserTypeAdmin, IsActive: true}
+	director := &entity.User{Id: uuid.New(), IsActive: true}
+	manager := &entity.User{Id: uuid.New(), IsActive: true, ManagerId: &director.Id}
+	department := &entity.Department{Id: uuid.New(), Code: "FIN", Active: true}
+
+	t.Run("should set the department and manager", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+		analyst := &entity.User{Id: uuid.New(), Name: "Ana", IsActive: true}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockUserGateway.On("FindByID", ctx, analyst.Id).Return(analyst, nil)
+		mockUserGateway.On("FindByID", ctx, manager.Id).Return(manager, nil)
+		mockDepartmentGateway.On("FindByID", ctx, department.Id).Return(department, nil)
+		mockUserGateway.On("LockReportingLines", ctx).Return(nil)
+		mockUserGateway.On("ListReportingLines", ctx).Return([]entity.User{*manager}, nil)
+		mockUserGateway.On("UpdateReportingLine", ctx, analyst).Return(nil)
+
+		// Act
+		result, err := useCase.AssignReportingLine(ctx, adminID, analyst.Id, dto.AssignReportingLineDTO{DepartmentId: &department.Id, ManagerId: &manager.Id})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &manager.Id, result.ManagerId)
+		assert.Equal(t, &department.Id, result.DepartmentId)
+		mockUserGateway.AssertExpectations(t)
+		assert.Equal(t, []string{"LockReportingLines", "ListReportingLines", "UpdateReportingLine"}, reportingLineCalls(mockUserGateway))
+	})
+
+	t.Run("should reject a manager that would create a cycle", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewHierarchyUseCase(new(MockDepartmentGateway), mockUserGateway, passthroughTransactor{})
+		target := *director
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockUserGateway.On("FindByID", ctx, director.Id).Return(&target, nil)
+		mockUserGateway.On("FindByID", ctx, manager.Id).Return(manager, nil)
+		mockUserGateway.On("LockReportingLines", ctx).Return(nil)
+		mockUserGateway.On("ListReportingLines", ctx).Return([]entity.User{*manager}, nil)
+
+		// Act
+		result, err := useCase.AssignReportingLine(ctx, adminID, director.Id, dto.AssignReportingLineDTO{ManagerId: &manager.Id})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, entity.ErrManagerCycle, err)
+		mockUserGateway.AssertNotCalled(t, "UpdateReportingLine", mock.Anything, mock.Anything)
+	})
+}
+
+// reportingLineCalls lista, na ordem, as chamadas que leem, bloqueiam ou gravam a hierarquia.
+func reportingLineCalls(userGateway *MockUserGateway) []string {
+	var calls []string
+	for _, call := range userGateway.Calls {
+		switch call.Method {
+		case "LockReportingLines", "ListReportingLines", "UpdateReportingLine":
+			calls = append(calls, call.Method)
+		}
+	}
+	return calls
+}
+
+func TestHierarchyUseCase_ImportReportingLines(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &entity.User{Id: adminID, Role: enums.UserTypeAdmin, IsActive: true}
+
+	t.Run("should apply each row independently and report failures", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+
+		director := entity.User{Id: uuid.New(), Email: "diretora@empresa.com", IsActive: true}
+		manager := entity.User{Id: uuid.New(), Email: "Gestor@empresa.com", IsActive: true}
+		department := entity.Department{Id: uuid.New(), Code: "FIN", Active: true}
+		rows := []dto.ReportingLineImportRowDTO{
+			{Line: 2, Email: "gestor@empresa.com", DepartmentCode: "fin", ManagerEmail: "diretora@empresa.com"},
+			{Line: 3, Email: "diretora@empresa.com", ManagerEmail: "gestor@empresa.com"},
+			{Line: 4, Email: "ninguem@empresa.com"},
+			{Line: 5, Email: "diretora@empresa.com", DepartmentCode: "XYZ"},
+		}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockUserGateway.On("FindByEmails", ctx, mock.Anything).Return([]entity.User{director, manager}, nil)
+		mockDepartmentGateway.On("List", ctx, true).Return([]entity.Department{department}, nil)
+		mockUserGateway.On("LockReportingLines", ctx).Return(nil)
+		mockUserGateway.On("ListReportingLines", ctx).Return([]entity.User{}, nil)
+		mockUserGateway.On("UpdateReportingLine", ctx, mock.AnythingOfType("*entity.User")).Return(nil)
+
+		// Act
+		result, err := useCase.ImportReportingLines(ctx, adminID, rows)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Succeeded)
+		assert.Equal(t, 3, result.Failed)
+		assert.Empty(t, result.Results[0].Error)
+		assert.Equal(t, entity.ErrManagerCycle.Error(), result.Results[1].Error)
+		assert.Contains(t, result.Results[2].Error, ErrUnknownUserEmail.Error())
+		assert.Contains(t, result.Results[3].Error, ErrUnknownDepartment.Error())
+		mockUserGateway.AssertNumberOfCalls(t, "UpdateReportingLine", 1)
+		updated := mockUserGateway.Calls[len(mockUserGateway.Calls)-1].Arguments.Get(1).(*entity.User)
+		assert.Equal(t, manager.Id, updated.Id)
+		assert.Equal(t, &director.Id, updated.ManagerId)
+		assert.Equal(t, &department.Id, updated.DepartmentId)
+		assert.Equal(t, []string{"LockReportingLines", "ListReportingLines", "UpdateReportingLine"}, reportingLineCalls(mockUserGateway))
+	})
+
+	t.Run("should keep the hierarchy consistent when a row fails to save", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+
+		first := entity.User{Id: uuid.New(), Email: "a@empresa.com", IsActive: true}
+		second := entity.User{Id: uuid.New(), Email: "b@empresa.com", IsActive: true}
+		rows := []dto.ReportingLineImportRowDTO{
+			{Line: 2, Email: "a@empresa.com", ManagerEmail: "b@empresa.com"},
+			{Line: 3, Email: "b@empresa.com", ManagerEmail: "a@empresa.com"},
+		}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockUserGateway.On("FindByEmails", ctx, mock.Anything).Return([]entity.User{first, second}, nil)
+		mockDepartmentGateway.On("List", ctx, true).Return([]entity.Department{}, nil)
+		mockUserGateway.On("LockReportingLines", ctx).Return(nil)
+		mockUserGateway.On("ListReportingLines", ctx).Return([]entity.User{}, nil)
+		mockUserGateway.On("UpdateReportingLine", ctx, mock.MatchedBy(func(user *entity.User) bool { return user.Id == first.Id })).Return(errors.New("falha ao gravar")).Once()
+		mockUserGateway.On("UpdateReportingLine", ctx, mock.MatchedBy(func(user *entity.User) bool { return user.Id == second.Id })).Return(nil).Once()
+
+		// Act
+		result, err := useCase.ImportReportingLines(ctx, adminID, rows)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "falha ao gravar", result.Results[0].Error)
+		assert.Empty(t, result.Results[1].Error)
+		mockUserGateway.AssertExpectations(t)
+	})
+}
+
+func TestHierarchyUseCase_ApproverResolver(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+
+	t.Run("should skip inactive managers", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewHierarchyUseCase(new(MockDepartmentGateway), mockUserGateway, passthroughTransactor{})
+		director := &entity.User{Id: uuid.New(), IsActive: true}
+		manager := &entity.User{Id: uuid.New(), IsActive: false, ManagerId: &director.Id}
+		analyst := &entity.User{Id: uuid.New(), IsActive: true, ManagerId: &manager.Id}
+
+		mockUserGateway.On("FindByID", ctx, analyst.Id).Return(analyst, nil)
+		mockUserGateway.On("FindByID", ctx, manager.Id).Return(manager, nil)
+		mockUserGateway.On("FindByID", ctx, director.Id).Return(director, nil)
+
+		// Act
+		result, err := useCase.ManagerOf(ctx, analyst.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, director, result)
+	})
+
+	t.Run("should return nil without a manager", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewHierarchyUseCase(new(MockDepartmentGateway), mockUserGateway, passthroughTransactor{})
+		analyst := &entity.User{Id: uuid.New(), IsActive: true}
+
+		mockUserGateway.On("FindByID", ctx, analyst.Id).Return(analyst, nil)
+
+		// Act
+		result, err := useCase.ManagerOf(ctx, analyst.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should resolve the department head", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+		head := &entity.User{Id: uuid.New(), IsActive: true}
+		department := &entity.Department{Id: uuid.New(), HeadUserId: &head.Id}
+		analyst := &entity.User{Id: uuid.New(), IsActive: true, DepartmentId: &department.Id}
+
+		mockUserGateway.On("FindByID", ctx, analyst.Id).Return(analyst, nil)
+		mockUserGateway.On("FindByID", ctx, head.Id).Return(head, nil)
+		mockDepartmentGateway.On("FindByID", ctx, department.Id).Return(department, nil)
+
+		// Act
+		result, err := useCase.DepartmentHeadOf(ctx, analyst.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, head, result)
+	})
+
+	t.Run("should not route the department head to themselves", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockDepartmentGateway := new(MockDepartmentGateway)
+		useCase := NewHierarchyUseCase(mockDepartmentGateway, mockUserGateway, passthroughTransactor{})
+		head := &entity.User{Id: uuid.New(), IsActive: true}
+		department := &entity.Department{Id: uuid.New(), HeadUserId: &head.Id}
+		head.DepartmentId = &department.Id
+
+		mockUserGateway.On("FindByID", ctx, head.Id).Return(head, nil)
+		mockDepartmentGateway.On("FindByID", ctx, department.Id).Return(department, nil)
+
+		// Act
+		result, err := useCase.DepartmentHeadOf(ctx, head.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
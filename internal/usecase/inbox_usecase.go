@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const inboxMaxPageSize = 100
+
+var (
+	ErrNotificationNotFound    = errors.New("notificação não encontrada")
+	ErrInvalidNotificationType = errors.New("tipo de notificação inválido")
+)
+
+// InboxUseCase é a caixa de entrada do próprio usuário autenticado e as preferências de entrega
+// das notificações dele.
+type InboxUseCase interface {
+	ListNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page int, pageSize int) (*entity.NotificationPage, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) error
+	ListPreferences(ctx context.Context, userID uuid.UUID) ([]entity.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, input dto.UpdateNotificationPreferencesDTO) ([]entity.NotificationPreference, error)
+}
+
+type InboxUseCaseImpl struct {
+	notificationGateway gateway.NotificationGateway
+	preferenceGateway   gateway.NotificationPreferenceGateway
+	now                 func() time.Time
+}
+
+func NewInboxUseCase(notificationGateway gateway.NotificationGateway, preferenceGateway gateway.NotificationPreferenceGateway) *InboxUseCaseImpl {
+	return &InboxUseCaseImpl{
+		notificationGateway: notificationGateway,
+		preferenceGateway:   preferenceGateway,
+		now:                 time.Now,
+	}
+}
+
+func (uc *InboxUseCaseImpl) ListNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page int, pageSize int) (*entity.NotificationPage, error) {
+	if pageSize > inboxMaxPageSize {
+		pageSize = inboxMaxPageSize
+	}
+
+	notifications, total, err := uc.notificationGateway.ListByUser(ctx, userID, unreadOnly, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	unread, err := uc.notificationGateway.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if notifications == nil {
+		notifications = []entity.Notification{}
+	}
+
+	return &entity.NotificationPage{
+		Items:       notifications,
+		Page:        page,
+		PageSize:    pageSize,
+		Total:       total,
+		UnreadCount: unread,
+	}, nil
+}
+
+func (uc *InboxUseCaseImpl) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return uc.notificationGateway.CountUnread(ctx, userID)
+}
+
+// MarkRead marca a notificação como lida; marcar de novo uma notificação já lida não é erro.
+func (uc *InboxUseCaseImpl) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	marked, err := uc.notificationGateway.MarkRead(ctx, userID, id, uc.now())
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (uc *InboxUseCaseImpl) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	return uc.notificationGateway.MarkAllRead(ctx, userID, uc.now())
+}
+
+// ListPreferences retorna a preferência de cada tipo de notificação, inclusive dos tipos que o
+// usuário nunca alterou.
+func (uc *InboxUseCaseImpl) ListPreferences(ctx context.Context, userID uuid.UUID) ([]entity.NotificationPreference, error) {
+	saved, err := uc.preferenceGateway.ListByUsers(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[enums.NotificationType]entity.NotificationPreference, len(saved))
+	for _, preference := range saved {
+		byType[preference.Type] = preference
+	}
+
+	preferences := make([]entity.NotificationPreference, 0, len(enums.NotificationTypes))
+	for _, notificationType := range enums.NotificationTypes {
+		preference, ok := byType[notificationType]
+		if !ok {
+			preference = entity.DefaultNotificationPreference(userID, notificationType)
+		}
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+func (uc *InboxUseCaseImpl) UpdatePreferences(ctx context.Context, userID uuid.UUID, input dto.UpdateNotificationPreferencesDTO) ([]entity.NotificationPreference, error) {
+	now := uc.now()
+	preferences := make([]entity.NotificationPreference, 0, len(input.Preferences))
+	positions := make(map[enums.NotificationType]int, len(input.Preferences))
+	for _, item := range input.Preferences {
+		if !item.Type.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidNotificationType, item.Type)
+		}
+
+		preference := entity.NotificationPreference{
+			UserId:    userID,
+			Type:      item.Type,
+			Email:     item.Email,
+			InApp:     item.InApp,
+			UpdatedAt: &now,
+		}
+
+		// Um tipo repetido na requisição vale pela última ocorrência.
+		if position, ok := positions[item.Type]; ok {
+			preferences[position] = preference
+			continue
+		}
+		positions[item.Type] = len(preferences)
+		preferences = append(preferences, preference)
+	}
+
+	if err := uc.preferenceGateway.Save(ctx, preferences); err != nil {
+		return nil, err
+	}
+
+	return uc.ListPreferences(ctx, userID)
+}
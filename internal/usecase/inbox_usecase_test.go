@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockNotificationGateway struct {
+	mock.Mock
+}
+
+func (m *MockNotificationGateway) Create(ctx context.Context, notifications []entity.Notification) error {
+	args := m.Called(ctx, notifications)
+	return args.Error(0)
+}
+
+func (m *MockNotificationGateway) ListByUser(ctx context.Context, userID uuid.UUID, unreadOnly bool, page int, pageSize int) ([]entity.Notification, int64, error) {
+	args := m.Called(ctx, userID, unreadOnly, page, pageSize)
+	notifications, _ := args.Get(0).([]entity.Notification)
+	return notifications, args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationGateway) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockNotificationGateway) MarkRead(ctx context.Context, userID uuid.UUID, id uuid.UUID, readAt time.Time) (bool, error) {
+	args := m.Called(ctx, userID, id, readAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationGateway) MarkAllRead(ctx context.Context, userID uuid.UUID, readAt time.Time) error {
+	args := m.Called(ctx, userID, readAt)
+	return args.Error(0)
+}
+
+type MockNotificationPreferenceGateway struct {
+	mock.Mock
+}
+
+func (m *MockNotificationPreferenceGateway) ListByUsers(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).([]entity.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceGateway) Save(ctx context.Context, preferences []entity.NotificationPreference) error {
+	args := m.Called(ctx, preferences)
+	return args.Error(0)
+}
+
+func TestInboxUseCase_ListNotifications(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("should cap the page size and return an empty page instead of null", func(t *testing.T) {
+		// Arrange
+		notifications := new(MockNotificationGateway)
+		useCase := NewInboxUseCase(notifications, new(MockNotificationPreferenceGateway))
+		notifications.On("ListByUser", mock.Anything, userID, true, 2, inboxMaxPageSize).Return(nil, int64(0), nil)
+		notifications.On("CountUnread", mock.Anything, userID).Return(int64(3), nil)
+
+		// Act
+		page, err := useCase.ListNotifications(context.Background(), userID, true, 2, 500)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotNil(t, page.Items)
+		assert.Empty(t, page.Items)
+		assert.Equal(t, inboxMaxPageSize, page.PageSize)
+		assert.Equal(t, int64(3), page.UnreadCount)
+		notifications.AssertExpectations(t)
+	})
+}
+
+func TestInboxUseCase_MarkRead(t *testing.T) {
+	userID := uuid.New()
+	notificationID := uuid.New()
+	now := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should mark the notification as read", func(t *testing.T) {
+		// Arrange
+		notifications := new(MockNotificationGateway)
+		useCase := NewInboxUseCase(notifications, new(MockNotificationPreferenceGateway))
+		useCase.now = func() time.Time { return now }
+		notifications.On("MarkRead", mock.Anything, userID, notificationID, now).Return(true, nil)
+
+		// Act
+		err := useCase.MarkRead(context.Background(), userID, notificationID)
+
+		// Assert
+		require.NoError(t, err)
+		notifications.AssertExpectations(t)
+	})
+
+	t.Run("should fail when the notification does not belong to the user", func(t *testing.T) {
+		// Arrange
+		notifications := new(MockNotificationGateway)
+		useCase := NewInboxUseCase(notifications, new(MockNotificationPreferenceGateway))
+		useCase.now = func() time.Time { return now }
+		notifications.On("MarkRead", mock.Anything, userID, notificationID, now).Return(false, nil)
+
+		// Act
+		err := useCase.MarkRead(context.Background(), userID, notificationID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotificationNotFound)
+	})
+}
+
+func TestInboxUseCase_Preferences(t *testing.T) {
+	userID := uuid.New()
+	now := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should fill in the defaults for types the user never changed", func(t *testing.T) {
+		// Arrange
+		preferences := new(MockNotificationPreferenceGateway)
+		useCase := NewInboxUseCase(new(MockNotificationGateway), preferences)
+		saved := entity.NotificationPreference{UserId: userID, Type: enums.NotificationTypeComment, Email: false, InApp: true}
+		preferences.On("ListByUsers", mock.Anything, []uuid.UUID{userID}).Return([]entity.NotificationPreference{saved}, nil)
+
+		// Act
+		result, err := useCase.ListPreferences(context.Background(), userID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []entity.NotificationPreference{
+			entity.DefaultNotificationPreference(userID, enums.NotificationTypeStatusChange),
+			saved,
+		}, result)
+	})
+
+	t.Run("should save one preference per type keeping the last occurrence", func(t *testing.T) {
+		// Arrange
+		preferences := new(MockNotificationPreferenceGateway)
+		useCase := NewInboxUseCase(new(MockNotificationGateway), preferences)
+		useCase.now = func() time.Time { return now }
+		preferences.On("Save", mock.Anything, []entity.NotificationPreference{
+			{UserId: userID, Type: enums.NotificationTypeStatusChange, Email: false, InApp: true, UpdatedAt: &now},
+		}).Return(nil)
+		preferences.On("ListByUsers", mock.Anything, []uuid.UUID{userID}).Return([]entity.NotificationPreference{}, nil)
+
+		// Act
+		_, err := useCase.UpdatePreferences(context.Background(), userID, dto.UpdateNotificationPreferencesDTO{
+			Preferences: []dto.NotificationPreferenceDTO{
+				{Type: enums.NotificationTypeStatusChange, Email: true, InApp: false},
+				{Type: enums.NotificationTypeStatusChange, Email: false, InApp: true},
+			},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		preferences.AssertExpectations(t)
+	})
+
+	t.Run("should reject an unknown notification type", func(t *testing.T) {
+		// Arrange
+		preferences := new(MockNotificationPreferenceGateway)
+		useCase := NewInboxUseCase(new(MockNotificationGateway), preferences)
+
+		// Act
+		_, err := useCase.UpdatePreferences(context.Background(), userID, dto.UpdateNotificationPreferencesDTO{
+			Preferences: []dto.NotificationPreferenceDTO{{Type: "DIGEST", Email: true}},
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidNotificationType)
+		preferences.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownNotificationTemplate = errors.New("modelo de notificação desconhecido")
+	ErrInvalidLocale               = errors.New("idioma não suportado; use pt-BR, en-US ou es")
+)
+
+// previewComments é o comentário de exemplo da prévia do modelo de comentário em cada idioma.
+var previewComments = map[enums.Locale]string{
+	enums.LocalePtBR: "Pode confirmar o horário do voo de volta?",
+	enums.LocaleEnUS: "Could you confirm the return flight time?",
+	enums.LocaleEs:   "¿Puedes confirmar el horario del vuelo de regreso?",
+}
+
+type NotificationTemplateUseCase interface {
+	ListTemplates(ctx context.Context, userID uuid.UUID) ([]dto.NotificationTemplateDTO, error)
+	PreviewTemplate(ctx context.Context, userID uuid.UUID, input dto.PreviewNotificationDTO) (*dto.NotificationPreviewDTO, error)
+}
+
+type NotificationTemplateUseCaseImpl struct {
+	userGateway   gateway.UserGateway
+	travelGateway gateway.TravelRequestGateway
+	now           func() time.Time
+}
+
+func NewNotificationTemplateUseCase(userGateway gateway.UserGateway, travelGateway gateway.TravelRequestGateway) *NotificationTemplateUseCaseImpl {
+	return &NotificationTemplateUseCaseImpl{
+		userGateway:   userGateway,
+		travelGateway: travelGateway,
+		now:           time.Now,
+	}
+}
+
+func (uc *NotificationTemplateUseCaseImpl) ListTemplates(ctx context.Context, userID uuid.UUID) ([]dto.NotificationTemplateDTO, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	templates := make([]dto.NotificationTemplateDTO, 0, len(emailTemplateNames))
+	for _, name := range emailTemplateNames {
+		templates = append(templates, dto.NotificationTemplateDTO{Name: name, Locales: enums.Locales})
+	}
+
+	return templates, nil
+}
+
+// PreviewTemplate renderiza o modelo como ele seria enviado ao próprio administrador. A prévia
+// usa a solicitação informada, da organização dele, ou uma solicitação de exemplo; solicitações
+// ainda sem decisão aparecem como aprovadas.
+func (uc *NotificationTemplateUseCaseImpl) PreviewTemplate(ctx context.Context, userID uuid.UUID, input dto.PreviewNotificationDTO) (*dto.NotificationPreviewDTO, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(emailTemplateNames, input.Template) {
+		return nil, ErrUnknownNotificationTemplate
+	}
+
+	locale := admin.Locale
+	if input.Locale != "" {
+		parsed, ok := enums.ParseLocale(input.Locale)
+		if !ok {
+			return nil, ErrInvalidLocale
+		}
+		locale = parsed
+	}
+
+	travelRequest := sampleTravelRequest(admin, uc.now())
+	if input.TravelRequestId != nil {
+		if travelRequest, err = uc.travelGateway.FindByID(ctx, *input.TravelRequestId); err != nil {
+			return nil, err
+		}
+	}
+
+	preview := *travelRequest
+	if preview.Status != enums.TravelRequestStatusCanceled {
+		preview.Status = enums.TravelRequestStatusApproved
+	}
+
+	recipient := entity.NotificationRecipient{Name: admin.Name, Email: admin.Email, Locale: locale}
+	locale = recipientLocale(recipient)
+
+	var data any
+	switch input.Template {
+	case emailTemplateStatusChange:
+		data = newStatusChangeEmail(recipient, &preview)
+	case emailTemplateStatusDigest:
+		data = statusDigestEmail{RecipientName: recipient.Name, Items: []statusDigestItem{newStatusDigestItem(locale, &preview)}}
+	case emailTemplateComment:
+		comment := &entity.TravelRequestComment{AuthorName: admin.Name, Body: previewComments[locale]}
+		data = newCommentEmail(recipient, &preview, comment)
+	}
+
+	message, err := renderEmail(input.Template, recipient, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.NotificationPreviewDTO{
+		Template: input.Template,
+		Locale:   locale,
+		Subject:  message.Subject,
+		TextBody: message.TextBody,
+		HTMLBody: message.HTMLBody,
+	}, nil
+}
+
+// sampleTravelRequest é uma viagem de ida e volta a Lisboa daqui a um mês, com cada trecho em
+// um fuso horário diferente.
+func sampleTravelRequest(requester *entity.User, now time.Time) *entity.TravelRequest {
+	departure := time.Date(now.Year(), now.Month()+1, 10, 22, 30, 0, 0, time.UTC)
+	returnDate := departure.AddDate(0, 0, 7).Add(-8 * time.Hour)
+
+	return &entity.TravelRequest{
+		UserId:            requester.Id,
+		User:              *requester,
+		OrganizationId:    requester.OrganizationId,
+		DestinationName:   "Lisboa",
+		DepartureDate:     departure,
+		ReturnDate:        &returnDate,
+		DepartureTimezone: "America/Sao_Paulo",
+		ReturnTimezone:    "Europe/Lisbon",
+		Status:            enums.TravelRequestStatusApproved,
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNotificationTemplateUseCase_PreviewTemplate(t *testing.T) {
+	ctx := context.Background()
+	admin := &entity.User{Id: uuid.New(), Name: "Carla", Email: "carla@empresa.com", Role: enums.UserTypeAdmin, Locale: enums.LocalePtBR}
+
+	setup := func() (*NotificationTemplateUseCaseImpl, *MockUserGateway, *MockTravelGateway) {
+		mockUserGateway := new(MockUserGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewNotificationTemplateUseCase(mockUserGateway, mockTravelGateway)
+		useCase.now = func() time.Time { return time.Date(2030, 4, 20, 12, 0, 0, 0, time.UTC) }
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		return useCase, mockUserGateway, mockTravelGateway
+	}
+
+	t.Run("should render the sample travel request in the requested locale", func(t *testing.T) {
+		// Arrange
+		useCase, _, mockTravelGateway := setup()
+
+		// Act
+		preview, err := useCase.PreviewTemplate(ctx, admin.Id, dto.PreviewNotificationDTO{Template: "status_change", Locale: "en-us"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.LocaleEnUS, preview.Locale)
+		assert.Equal(t, "Travel request to Lisboa approved", preview.Subject)
+		assert.Contains(t, preview.TextBody, "Hello Carla,")
+		assert.Contains(t, preview.TextBody, "05/10/2030 7:30 PM (America/Sao_Paulo) to 05/17/2030 3:30 PM (Europe/Lisbon)")
+		mockTravelGateway.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should render an organization travel request in the admin locale", func(t *testing.T) {
+		// Arrange
+		useCase, _, mockTravelGateway := setup()
+
+		travelRequest := &entity.TravelRequest{
+			Id:              uuid.New(),
+			DestinationName: "Recife",
+			DepartureDate:   time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC),
+			Status:          enums.TravelRequestStatusSolicited,
+		}
+		mockTravelGateway.On("FindByID", ctx, travelRequest.Id).Return(travelRequest, nil)
+
+		// Act
+		preview, err := useCase.PreviewTemplate(ctx, admin.Id, dto.PreviewNotificationDTO{Template: "comment", TravelRequestId: &travelRequest.Id})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.LocalePtBR, preview.Locale)
+		assert.Equal(t, "Novo comentário no pedido de viagem para Recife", preview.Subject)
+		assert.Contains(t, preview.TextBody, previewComments[enums.LocalePtBR])
+		assert.Equal(t, enums.TravelRequestStatusSolicited, travelRequest.Status)
+	})
+
+	t.Run("should reject unknown templates and locales", func(t *testing.T) {
+		// Arrange
+		useCase, _, _ := setup()
+
+		// Act
+		_, templateErr := useCase.PreviewTemplate(ctx, admin.Id, dto.PreviewNotificationDTO{Template: "welcome"})
+		_, localeErr := useCase.PreviewTemplate(ctx, admin.Id, dto.PreviewNotificationDTO{Template: "comment", Locale: "ja"})
+
+		// Assert
+		assert.ErrorIs(t, templateErr, ErrUnknownNotificationTemplate)
+		assert.ErrorIs(t, localeErr, ErrInvalidLocale)
+	})
+
+	t.Run("should restrict previews to admins", func(t *testing.T) {
+		// Arrange
+		useCase, mockUserGateway, _ := setup()
+		common := &entity.User{Id: uuid.New(), Role: enums.UserTypeCommon}
+		mockUserGateway.On("FindByID", ctx, common.Id).Return(common, nil)
+
+		// Act
+		_, err := useCase.PreviewTemplate(ctx, common.Id, dto.PreviewNotificationDTO{Template: "comment"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"bytes"
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Os modelos de e-mail ficam em templates/email/<locale>, um par .txt/.html por notificação em
+// cada idioma suportado. O modelo em texto define também o bloco "subject" com o assunto. Um
+// idioma sem algum dos modelos impede a inicialização.
+//
+//go:embed templates/email
+var emailTemplateFiles embed.FS
+
+const (
+	emailTemplateStatusChange = "status_change"
+	emailTemplateStatusDigest = "status_digest"
+	emailTemplateComment      = "comment"
+)
+
+var emailTemplateNames = []string{emailTemplateStatusChange, emailTemplateStatusDigest, emailTemplateComment}
+
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+var emailTemplates = mustLoadEmailTemplates(enums.Locales, emailTemplateNames)
+
+func mustLoadEmailTemplates(locales []enums.Locale, names []string) map[enums.Locale]map[string]emailTemplate {
+	templates := make(map[enums.Locale]map[string]emailTemplate, len(locales))
+	for _, locale := range locales {
+		templates[locale] = make(map[string]emailTemplate, len(names))
+		for _, name := range names {
+			path := fmt.Sprintf("templates/email/%s/%s", locale, name)
+			templates[locale][name] = emailTemplate{
+				text: texttemplate.Must(texttemplate.ParseFS(emailTemplateFiles, path+".txt")),
+				html: htmltemplate.Must(htmltemplate.ParseFS(emailTemplateFiles, path+".html")),
+			}
+		}
+	}
+	return templates
+}
+
+// localeFormat define como as datas aparecem nas notificações de cada idioma.
+type localeFormat struct {
+	dateTime string
+	period   string
+}
+
+var localeFormats = map[enums.Locale]localeFormat{
+	enums.LocalePtBR: {dateTime: "02/01/2006 15:04", period: "%s a %s"},
+	enums.LocaleEnUS: {dateTime: "01/02/2006 3:04 PM", period: "%s to %s"},
+	enums.LocaleEs:   {dateTime: "02/01/2006 15:04", period: "%s al %s"},
+}
+
+// recipientLocale devolve o idioma do destinatário ou o padrão, para destinatários sem idioma
+// definido ou com um idioma que deixou de ser suportado.
+func recipientLocale(recipient entity.NotificationRecipient) enums.Locale {
+	if recipient.Locale.IsValid() {
+		return recipient.Locale
+	}
+	return enums.DefaultLocale
+}
+
+// organizationLocale devolve o idioma padrão da organização do contexto, usado para os viajantes
+// sem conta na aplicação.
+func organizationLocale(ctx context.Context) enums.Locale {
+	if organization, ok := tenant.Organization(ctx); ok && organization.DefaultLocale.IsValid() {
+		return organization.DefaultLocale
+	}
+	return enums.DefaultLocale
+}
+
+type statusChangeEmail struct {
+	RecipientName string
+	Destination   string
+	Period        string
+	Approved      bool
+}
+
+type statusDigestItem struct {
+	Destination string
+	Period      string
+	Approved    bool
+}
+
+type statusDigestEmail struct {
+	RecipientName string
+	Items         []statusDigestItem
+}
+
+type commentEmail struct {
+	RecipientName string
+	AuthorName    string
+	Destination   string
+	Body          string
+}
+
+func newStatusChangeEmail(recipient entity.NotificationRecipient, travelRequest *entity.TravelRequest) statusChangeEmail {
+	return statusChangeEmail{
+		RecipientName: recipient.Name,
+		Destination:   travelRequest.DestinationName,
+		Period:        formatTravelPeriod(recipientLocale(recipient), travelRequest),
+		Approved:      travelRequest.Status == enums.TravelRequestStatusApproved,
+	}
+}
+
+func newStatusDigestItem(locale enums.Locale, travelRequest *entity.TravelRequest) statusDigestItem {
+	return statusDigestItem{
+		Destination: travelRequest.DestinationName,
+		Period:      formatTravelPeriod(locale, travelRequest),
+		Approved:    travelRequest.Status == enums.TravelRequestStatusApproved,
+	}
+}
+
+func newCommentEmail(recipient entity.NotificationRecipient, travelRequest *entity.TravelRequest, comment *entity.TravelRequestComment) commentEmail {
+	return commentEmail{
+		RecipientName: recipient.Name,
+		AuthorName:    comment.AuthorName,
+		Destination:   travelRequest.DestinationName,
+		Body:          comment.Body,
+	}
+}
+
+// renderEmail monta o e-mail do destinatário, no idioma dele, a partir do par de modelos; a
+// versão em HTML escapa os dados, que podem conter texto digitado pelos usuários.
+func renderEmail(name string, recipient entity.NotificationRecipient, data any) (entity.EmailMessage, error) {
+	template, ok := emailTemplates[recipientLocale(recipient)][name]
+	if !ok {
+		return entity.EmailMessage{}, fmt.Errorf("modelo de e-mail desconhecido: %s", name)
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := template.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return entity.EmailMessage{}, err
+	}
+	if err := template.text.Execute(&text, data); err != nil {
+		return entity.EmailMessage{}, err
+	}
+	if err := template.html.Execute(&html, data); err != nil {
+		return entity.EmailMessage{}, err
+	}
+
+	return entity.EmailMessage{
+		To:       recipient,
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: strings.TrimSpace(text.String()) + "\n",
+		HTMLBody: html.String(),
+	}, nil
+}
+
+// formatTravelPeriod apresenta cada trecho no fuso horário local da partida, evitando que um voo
+// noturno apareça com a data do servidor.
+func formatTravelPeriod(locale enums.Locale, travelRequest *entity.TravelRequest) string {
+	format := localeFormats[locale]
+
+	period := formatLocalDate(format, travelRequest.LocalDepartureDate())
+	if returnDate := travelRequest.LocalReturnDate(); returnDate != nil {
+		period = fmt.Sprintf(format.period, period, formatLocalDate(format, *returnDate))
+	}
+
+	return period
+}
+
+func formatLocalDate(format localeFormat, date time.Time) string {
+	return fmt.Sprintf("%s (%s)", date.Format(format.dateTime), date.Location())
+}
@@ -3,45 +3,303 @@ package usecase
 import (
 	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+// StatusChange é uma mudança de status já gravada, notificada em lote.
+type StatusChange struct {
+	TravelRequest  *entity.TravelRequest
+	PreviousStatus enums.TravelRequestStatus
+}
+
+// NotificationUseCae registra as notificações de uma alteração. Chamado dentro da transação
+// da alteração, garante que a notificação seja gravada se, e somente se, a alteração for.
 type NotificationUseCae interface {
-	NotifyStatusChange(travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus)
+	NotifyStatusChange(ctx context.Context, travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) error
+	NotifyStatusChanges(ctx context.Context, changes []StatusChange) error
+	NotifyComment(ctx context.Context, travelRequest *entity.TravelRequest, comment *entity.TravelRequestComment, recipients []entity.NotificationRecipient) error
+}
+
+// OutboxTopicEmail identifica as mensagens do outbox entregues pelo EmailOutboxHandler.
+const OutboxTopicEmail = "email"
+
+// NotificationService entrega cada notificação por e-mail e na caixa de entrada da aplicação,
+// conforme as preferências de cada destinatário. Os e-mails são gravados no outbox e enviados
+// depois pelo worker; destinatários sem conta na aplicação recebem apenas o e-mail.
+type NotificationService struct {
+	outboxGateway     gateway.OutboxGateway
+	inboxGateway      gateway.NotificationGateway
+	preferenceGateway gateway.NotificationPreferenceGateway
+	now               func() time.Time
+}
+
+func NewNotificationService(
+	outboxGateway gateway.OutboxGateway,
+	inboxGateway gateway.NotificationGateway,
+	preferenceGateway gateway.NotificationPreferenceGateway,
+) NotificationUseCae {
+	return &NotificationService{
+		outboxGateway:     outboxGateway,
+		inboxGateway:      inboxGateway,
+		preferenceGateway: preferenceGateway,
+		now:               time.Now,
+	}
+}
+
+func (s *NotificationService) NotifyStatusChange(ctx context.Context, travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) error {
+	if travelRequest.Status != enums.TravelRequestStatusApproved && travelRequest.Status != enums.TravelRequestStatusCanceled ||
+		travelRequest.Status == previousStatus {
+		return nil
+	}
+
+	recipients := travelRequest.NotificationRecipients(organizationLocale(ctx))
+	preferences, err := s.preferences(ctx, recipients)
+	if err != nil {
+		return err
+	}
+
+	var emails []queuedEmail
+	var inbox []inboxItem
+	for _, recipient := range recipients {
+		email, inApp := preferences.channels(recipient, enums.NotificationTypeStatusChange)
+		if email {
+			emails = append(emails, queuedEmail{recipient, emailTemplateStatusChange, newStatusChangeEmail(recipient, travelRequest)})
+		}
+		if inApp {
+			inbox = append(inbox, inboxItem{*recipient.UserId, enums.NotificationTypeStatusChange, newStatusChangeNotification(travelRequest, previousStatus)})
+		}
+	}
+
+	return s.deliver(ctx, travelRequest.OrganizationId, emails, inbox)
+}
+
+// NotifyStatusChanges envia uma única mensagem por destinatário com todas as decisões que o
+// envolvem, em vez de uma mensagem por solicitação. Na caixa de entrada, cada decisão continua
+// sendo uma notificação.
+func (s *NotificationService) NotifyStatusChanges(ctx context.Context, changes []StatusChange) error {
+	var all []entity.NotificationRecipient
+	for _, change := range changes {
+		if change.TravelRequest.Status != change.PreviousStatus {
+			all = append(all, change.TravelRequest.NotificationRecipients(organizationLocale(ctx))...)
+		}
+	}
+
+	preferences, err := s.preferences(ctx, all)
+	if err != nil {
+		return err
+	}
+
+	var order []string
+	var organizationID uuid.UUID
+	digests := make(map[string]*statusDigestEmail)
+	recipients := make(map[string]entity.NotificationRecipient)
+	var inbox []inboxItem
+
+	for _, change := range changes {
+		travelRequest := change.TravelRequest
+		if travelRequest.Status == change.PreviousStatus {
+			continue
+		}
+		organizationID = travelRequest.OrganizationId
+
+		for _, recipient := range travelRequest.NotificationRecipients(organizationLocale(ctx)) {
+			email, inApp := preferences.channels(recipient, enums.NotificationTypeStatusChange)
+			if inApp {
+				inbox = append(inbox, inboxItem{*recipient.UserId, enums.NotificationTypeStatusChange, newStatusChangeNotification(travelRequest, change.PreviousStatus)})
+			}
+			if !email {
+				continue
+			}
+
+			key := strings.ToLower(recipient.Email)
+			if digests[key] == nil {
+				digests[key] = &statusDigestEmail{RecipientName: recipient.Name}
+				recipients[key] = recipient
+				order = append(order, key)
+			}
+			item := newStatusDigestItem(recipientLocale(recipients[key]), travelRequest)
+			digests[key].Items = append(digests[key].Items, item)
+		}
+	}
+
+	emails := make([]queuedEmail, 0, len(order))
+	for _, key := range order {
+		emails = append(emails, queuedEmail{recipients[key], emailTemplateStatusDigest, *digests[key]})
+	}
+
+	return s.deliver(ctx, organizationID, emails, inbox)
+}
+
+func (s *NotificationService) NotifyComment(ctx context.Context, travelRequest *entity.TravelRequest, comment *entity.TravelRequestComment, recipients []entity.NotificationRecipient) error {
+	preferences, err := s.preferences(ctx, recipients)
+	if err != nil {
+		return err
+	}
+
+	emails := make([]queuedEmail, 0, len(recipients))
+	var inbox []inboxItem
+	for _, recipient := range recipients {
+		email, inApp := preferences.channels(recipient, enums.NotificationTypeComment)
+		if email {
+			emails = append(emails, queuedEmail{recipient, emailTemplateComment, newCommentEmail(recipient, travelRequest, comment)})
+		}
+		if inApp {
+			inbox = append(inbox, inboxItem{*recipient.UserId, enums.NotificationTypeComment, newCommentNotification(travelRequest, comment)})
+		}
+	}
+
+	return s.deliver(ctx, travelRequest.OrganizationId, emails, inbox)
 }
 
-type EmailNotificationService struct {
+type queuedEmail struct {
+	recipient entity.NotificationRecipient
+	template  string
+	data      any
 }
 
-func NewEmailNotificationService() NotificationUseCae {
-	return &EmailNotificationService{}
+type inboxItem struct {
+	userID           uuid.UUID
+	notificationType enums.NotificationType
+	payload          any
 }
 
-func (s *EmailNotificationService) NotifyStatusChange(travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) {
-	if (travelRequest.Status == enums.TravelRequestStatusApproved || travelRequest.Status == enums.TravelRequestStatusCanceled) &&
-		travelRequest.Status != previousStatus {
+// notificationPreferences são as preferências gravadas dos destinatários, por usuário e tipo.
+type notificationPreferences map[uuid.UUID]map[enums.NotificationType]entity.NotificationPreference
 
-		user := travelRequest.User
-		message := ""
+// preferences carrega, em uma consulta, as preferências dos destinatários com conta.
+func (s *NotificationService) preferences(ctx context.Context, recipients []entity.NotificationRecipient) (notificationPreferences, error) {
+	var userIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, recipient := range recipients {
+		if recipient.UserId != nil && !seen[*recipient.UserId] {
+			seen[*recipient.UserId] = true
+			userIDs = append(userIDs, *recipient.UserId)
+		}
+	}
+
+	preferences := make(notificationPreferences, len(userIDs))
+	if len(userIDs) == 0 {
+		return preferences, nil
+	}
+
+	saved, err := s.preferenceGateway.ListByUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, preference := range saved {
+		if preferences[preference.UserId] == nil {
+			preferences[preference.UserId] = make(map[enums.NotificationType]entity.NotificationPreference)
+		}
+		preferences[preference.UserId][preference.Type] = preference
+	}
+
+	return preferences, nil
+}
+
+// channels informa se o destinatário recebe o tipo por e-mail e na caixa de entrada.
+func (p notificationPreferences) channels(recipient entity.NotificationRecipient, notificationType enums.NotificationType) (email bool, inApp bool) {
+	if recipient.UserId == nil {
+		return true, false
+	}
+
+	preference, ok := p[*recipient.UserId][notificationType]
+	if !ok {
+		preference = entity.DefaultNotificationPreference(*recipient.UserId, notificationType)
+	}
+
+	return preference.Email, preference.InApp
+}
+
+// deliver grava as notificações da caixa de entrada e enfileira os e-mails, na transação do
+// contexto.
+func (s *NotificationService) deliver(ctx context.Context, organizationID uuid.UUID, emails []queuedEmail, inbox []inboxItem) error {
+	if len(inbox) > 0 {
+		now := s.now()
+		notifications := make([]entity.Notification, 0, len(inbox))
+		for _, item := range inbox {
+			notification, err := entity.NewNotification(organizationID, item.userID, item.notificationType, item.payload, now)
+			if err != nil {
+				return err
+			}
+			notifications = append(notifications, *notification)
+		}
+
+		if err := s.inboxGateway.Create(ctx, notifications); err != nil {
+			return err
+		}
+	}
+
+	return s.enqueue(ctx, organizationID, emails)
+}
+
+// enqueue monta cada e-mail e grava uma mensagem do outbox por destinatário, usando o ID da
+// mensagem como chave de idempotência do envio.
+func (s *NotificationService) enqueue(ctx context.Context, organizationID uuid.UUID, emails []queuedEmail) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	now := s.now()
+	messages := make([]entity.OutboxMessage, 0, len(emails))
+	for _, email := range emails {
+		rendered, err := renderEmail(email.template, email.recipient, email.data)
+		if err != nil {
+			return fmt.Errorf("erro ao montar e-mail %s: %w", email.template, err)
+		}
+
+		message, err := entity.NewOutboxMessage(organizationID, OutboxTopicEmail, nil, now)
+		if err != nil {
+			return err
+		}
+
+		rendered.IdempotencyKey = message.Id.String()
+		if message.Payload, err = json.Marshal(rendered); err != nil {
+			return err
+		}
+
+		messages = append(messages, *message)
+	}
+
+	return s.outboxGateway.Enqueue(ctx, messages)
+}
+
+func newStatusChangeNotification(travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) dto.StatusChangeNotificationDTO {
+	return dto.StatusChangeNotificationDTO{
+		TravelRequestId: travelRequest.Id,
+		DestinationName: travelRequest.DestinationName,
+		DepartureDate:   travelRequest.DepartureDate,
+		Status:          travelRequest.Status,
+		PreviousStatus:  previousStatus,
+	}
+}
+
+func newCommentNotification(travelRequest *entity.TravelRequest, comment *entity.TravelRequestComment) dto.CommentNotificationDTO {
+	return dto.CommentNotificationDTO{
+		TravelRequestId: travelRequest.Id,
+		DestinationName: travelRequest.DestinationName,
+		CommentId:       comment.Id,
+		AuthorName:      comment.AuthorName,
+		Body:            comment.Body,
+	}
+}
 
-		switch travelRequest.Status {
-		case enums.TravelRequestStatusApproved:
-			message = fmt.Sprintf(
-				"Olá %s, seu pedido de viagem para %s foi APROVADO! Datas: %s a %s",
-				user.Name,
-				travelRequest.DestinationName,
-				travelRequest.DepartureDate.Format("02/01/2006"),
-				travelRequest.ReturnDate.Format("02/01/2006"),
-			)
-		case enums.TravelRequestStatusCanceled:
-			message = fmt.Sprintf(
-				"Olá %s, seu pedido de viagem para %s foi CANCELADO.",
-				user.Name,
-				travelRequest.DestinationName,
-			)
+// EmailOutboxHandler entrega as mensagens do tópico OutboxTopicEmail pelo Mailer.
+func EmailOutboxHandler(mailer gateway.Mailer) OutboxHandler {
+	return func(ctx context.Context, message entity.OutboxMessage) error {
+		var email entity.EmailMessage
+		if err := json.Unmarshal(message.Payload, &email); err != nil {
+			return fmt.Errorf("%w: %v", ErrOutboxPayloadInvalid, err)
 		}
 
-		log.Printf("[NOTIFICATION] E-mail enviado para %s: %s", user.Email, message)
+		return mailer.Send(ctx, email)
 	}
 }
@@ -3,16 +3,106 @@ package usecase
 import (
 	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestEmailNotificationService_NotifyStatusChange(t *testing.T) {
+// recordingMailer guarda as mensagens em vez de enviá-las.
+type recordingMailer struct {
+	mu       sync.Mutex
+	messages []entity.EmailMessage
+	err      error
+}
+
+func (m *recordingMailer) Send(ctx context.Context, message entity.EmailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, message)
+	return m.err
+}
+
+func (m *recordingMailer) sent() []entity.EmailMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	messages := m.messages
+	m.messages = nil
+	return messages
+}
+
+// recordingOutbox guarda as mensagens enfileiradas; os demais métodos do gateway não são usados.
+type recordingOutbox struct {
+	gateway.OutboxGateway
+	messages []entity.OutboxMessage
+	err      error
+}
+
+func (o *recordingOutbox) Enqueue(ctx context.Context, messages []entity.OutboxMessage) error {
+	if o.err != nil {
+		return o.err
+	}
+	o.messages = append(o.messages, messages...)
+	return nil
+}
+
+// emails decodifica os e-mails enfileirados desde a última chamada.
+func (o *recordingOutbox) emails(t *testing.T) []entity.EmailMessage {
+	var emails []entity.EmailMessage
+	for _, message := range o.messages {
+		assert.Equal(t, OutboxTopicEmail, message.Topic)
+
+		var email entity.EmailMessage
+		require.NoError(t, json.Unmarshal(message.Payload, &email))
+		assert.Equal(t, message.Id.String(), email.IdempotencyKey)
+		emails = append(emails, email)
+	}
+	o.messages = nil
+	return emails
+}
+
+// recordingInbox guarda as notificações criadas; os demais métodos do gateway não são usados.
+type recordingInbox struct {
+	gateway.NotificationGateway
+	notifications []entity.Notification
+}
+
+func (i *recordingInbox) Create(ctx context.Context, notifications []entity.Notification) error {
+	i.notifications = append(i.notifications, notifications...)
+	return nil
+}
+
+// memoryPreferences devolve as preferências gravadas dos usuários consultados.
+type memoryPreferences []entity.NotificationPreference
+
+func (p memoryPreferences) ListByUsers(ctx context.Context, userIDs []uuid.UUID) ([]entity.NotificationPreference, error) {
+	var preferences []entity.NotificationPreference
+	for _, preference := range p {
+		for _, userID := range userIDs {
+			if preference.UserId == userID {
+				preferences = append(preferences, preference)
+			}
+		}
+	}
+	return preferences, nil
+}
+
+func (p memoryPreferences) Save(ctx context.Context, preferences []entity.NotificationPreference) error {
+	return nil
+}
+
+func TestNotificationService_NotifyStatusChange(t *testing.T) {
 	// Setup
-	service := NewEmailNotificationService()
+	outbox := &recordingOutbox{}
+	service := NewNotificationService(outbox, &recordingInbox{}, memoryPreferences{})
 
 	t.Run("should notify when status changes to approved", func(t *testing.T) {
 		// Arrange
@@ -37,12 +127,17 @@ func TestEmailNotificationService_NotifyStatusChange(t *testing.T) {
 
 		previousStatus := enums.TravelRequestStatusSolicited
 
-		// Act & Assert
-		// Como o serviço apenas loga a mensagem, não há retorno para verificar
-		// Mas podemos garantir que não há panics
-		assert.NotPanics(t, func() {
-			service.NotifyStatusChange(travelRequest, previousStatus)
-		})
+		// Act
+		err := service.NotifyStatusChange(context.Background(), travelRequest, previousStatus)
+
+		// Assert
+		assert.NoError(t, err)
+		messages := outbox.emails(t)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "john.doe@example.com", messages[0].To.Email)
+		assert.Equal(t, "Pedido de viagem para Paris aprovado", messages[0].Subject)
+		assert.Contains(t, messages[0].TextBody, "foi APROVADO!")
+		assert.Contains(t, messages[0].HTMLBody, "APROVADO")
 	})
 
 	t.Run("should notify when status changes to canceled", func(t *testing.T) {
@@ -68,12 +163,15 @@ func TestEmailNotificationService_NotifyStatusChange(t *testing.T) {
 
 		previousStatus := enums.TravelRequestStatusSolicited
 
-		// Act & Assert
-		// Como o serviço apenas loga a mensagem, não há retorno para verificar
-		// Mas podemos garantir que não há panics
-		assert.NotPanics(t, func() {
-			service.NotifyStatusChange(travelRequest, previousStatus)
-		})
+		// Act
+		err := service.NotifyStatusChange(context.Background(), travelRequest, previousStatus)
+
+		// Assert
+		assert.NoError(t, err)
+		messages := outbox.emails(t)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "Pedido de viagem para Paris cancelado", messages[0].Subject)
+		assert.Contains(t, messages[0].TextBody, "foi CANCELADO.")
 	})
 
 	t.Run("should not notify when status remains the same", func(t *testing.T) {
@@ -99,12 +197,12 @@ func TestEmailNotificationService_NotifyStatusChange(t *testing.T) {
 
 		previousStatus := enums.TravelRequestStatusApproved
 
-		// Act & Assert
-		// Como o serviço apenas loga a mensagem, não há retorno para verificar
-		// Mas podemos garantir que não há panics
-		assert.NotPanics(t, func() {
-			service.NotifyStatusChange(travelRequest, previousStatus)
-		})
+		// Act
+		err := service.NotifyStatusChange(context.Background(), travelRequest, previousStatus)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, outbox.emails(t))
 	})
 
 	t.Run("should not notify for other status changes", func(t *testing.T) {
@@ -130,11 +228,362 @@ func TestEmailNotificationService_NotifyStatusChange(t *testing.T) {
 
 		previousStatus := enums.TravelRequestStatusSolicited
 
-		// Act & Assert
-		// Como o serviço apenas loga a mensagem, não há retorno para verificar
-		// Mas podemos garantir que não há panics
-		assert.NotPanics(t, func() {
-			service.NotifyStatusChange(travelRequest, previousStatus)
+		// Act
+		err := service.NotifyStatusChange(context.Background(), travelRequest, previousStatus)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, outbox.emails(t))
+	})
+}
+
+func TestNotificationService_NotifyStatusChanges(t *testing.T) {
+	t.Run("should send one digest per recipient", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		service := NewNotificationService(outbox, &recordingInbox{}, memoryPreferences{})
+
+		requester := entity.User{Name: "Ana", Email: "ana@empresa.com"}
+		changes := []StatusChange{
+			{
+				TravelRequest:  &entity.TravelRequest{DestinationName: "Recife", DepartureDate: time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC), Status: enums.TravelRequestStatusApproved, User: requester},
+				PreviousStatus: enums.TravelRequestStatusSolicited,
+			},
+			{
+				TravelRequest:  &entity.TravelRequest{DestinationName: "Lima", DepartureDate: time.Date(2030, 6, 1, 10, 0, 0, 0, time.UTC), Status: enums.TravelRequestStatusCanceled, User: requester},
+				PreviousStatus: enums.TravelRequestStatusSolicited,
+			},
+		}
+
+		// Act
+		err := service.NotifyStatusChanges(context.Background(), changes)
+
+		// Assert
+		assert.NoError(t, err)
+		messages := outbox.emails(t)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "2 pedido(s) de viagem atualizados", messages[0].Subject)
+		assert.Contains(t, messages[0].TextBody, "- Recife (15/05/2030 10:00 (UTC)): APROVADO")
+		assert.Contains(t, messages[0].TextBody, "- Lima (01/06/2030 10:00 (UTC)): CANCELADO")
+		assert.Contains(t, messages[0].HTMLBody, "<li><strong>Lima</strong>")
+	})
+
+	t.Run("should render each digest in the recipient locale", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		service := NewNotificationService(outbox, &recordingInbox{}, memoryPreferences{})
+		ctx := tenant.WithOrganization(context.Background(), &entity.Organization{Id: uuid.New(), DefaultLocale: enums.LocaleEs})
+
+		requester := entity.User{Name: "Ann", Email: "ann@company.com", Locale: enums.LocaleEnUS}
+		carlaID := uuid.New()
+		changes := []StatusChange{
+			{
+				TravelRequest: &entity.TravelRequest{
+					DestinationName: "Recife",
+					DepartureDate:   time.Date(2030, 5, 15, 18, 0, 0, 0, time.UTC),
+					Status:          enums.TravelRequestStatusApproved,
+					User:            requester,
+					Travelers: []entity.Traveler{
+						{Name: "Bruno", Email: "bruno@company.com"},
+						{Name: "Carla", Email: "carla@company.com", UserId: &carlaID, User: &entity.User{Id: carlaID, Locale: enums.LocalePtBR}},
+					},
+				},
+				PreviousStatus: enums.TravelRequestStatusSolicited,
+			},
+		}
+
+		// Act
+		err := service.NotifyStatusChanges(ctx, changes)
+
+		// Assert
+		assert.NoError(t, err)
+		messages := outbox.emails(t)
+		assert.Len(t, messages, 3)
+		assert.Equal(t, "1 travel request(s) updated", messages[0].Subject)
+		assert.Contains(t, messages[0].TextBody, "- Recife (05/15/2030 6:00 PM (UTC)): APPROVED")
+		assert.Contains(t, messages[0].HTMLBody, `<html lang="en-US">`)
+		assert.Equal(t, "1 solicitud(es) de viaje actualizada(s)", messages[1].Subject)
+		assert.Contains(t, messages[1].TextBody, "- Recife (15/05/2030 18:00 (UTC)): APROBADA")
+		assert.Equal(t, "1 pedido(s) de viagem atualizados", messages[2].Subject)
+	})
+}
+
+func TestNotificationService_NotifyComment(t *testing.T) {
+	t.Run("should escape user content in the HTML version only", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		service := NewNotificationService(outbox, &recordingInbox{}, memoryPreferences{})
+
+		travelRequest := &entity.TravelRequest{DestinationName: "Paris"}
+		comment := &entity.TravelRequestComment{AuthorName: "Bruno", Body: "Hotel <b>perto</b> & barato"}
+		recipients := []entity.NotificationRecipient{{Name: "Ana", Email: "ana@empresa.com"}}
+
+		// Act
+		err := service.NotifyComment(context.Background(), travelRequest, comment, recipients)
+
+		// Assert
+		assert.NoError(t, err)
+		messages := outbox.emails(t)
+		assert.Len(t, messages, 1)
+		assert.Equal(t, "Novo comentário no pedido de viagem para Paris", messages[0].Subject)
+		assert.Contains(t, messages[0].TextBody, "Hotel <b>perto</b> & barato")
+		assert.Contains(t, messages[0].HTMLBody, "Hotel &lt;b&gt;perto&lt;/b&gt; &amp; barato")
+	})
+}
+
+func TestNotificationService_EnqueueError(t *testing.T) {
+	t.Run("should return the outbox error so the change is rolled back", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{err: errors.New("conexão perdida")}
+		service := NewNotificationService(outbox, &recordingInbox{}, memoryPreferences{})
+
+		travelRequest := &entity.TravelRequest{DestinationName: "Paris"}
+		comment := &entity.TravelRequestComment{AuthorName: "Bruno", Body: "Ok"}
+		recipients := []entity.NotificationRecipient{{Name: "Ana", Email: "ana@empresa.com"}}
+
+		// Act
+		err := service.NotifyComment(context.Background(), travelRequest, comment, recipients)
+
+		// Assert
+		assert.EqualError(t, err, "conexão perdida")
+	})
+}
+
+func TestNotificationService_Preferences(t *testing.T) {
+	requesterID := uuid.New()
+	travelerID := uuid.New()
+	organizationID := uuid.New()
+
+	newTravelRequest := func() *entity.TravelRequest {
+		return &entity.TravelRequest{
+			Id:              uuid.New(),
+			OrganizationId:  organizationID,
+			UserId:          requesterID,
+			DestinationName: "Recife",
+			DepartureDate:   time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC),
+			Status:          enums.TravelRequestStatusApproved,
+			User:            entity.User{Id: requesterID, Name: "Ana", Email: "ana@empresa.com"},
+			Travelers: []entity.Traveler{
+				{Name: "Bruno", Email: "bruno@empresa.com", UserId: &travelerID},
+				{Name: "Carla", Email: "carla@externo.com"},
+			},
+		}
+	}
+
+	t.Run("should deliver by email and in-app by default, in-app only to account holders", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		inbox := &recordingInbox{}
+		service := NewNotificationService(outbox, inbox, memoryPreferences{})
+		travelRequest := newTravelRequest()
+
+		// Act
+		err := service.NotifyStatusChange(context.Background(), travelRequest, enums.TravelRequestStatusSolicited)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, outbox.emails(t), 3)
+		require.Len(t, inbox.notifications, 2)
+		assert.Equal(t, requesterID, inbox.notifications[0].UserId)
+		assert.Equal(t, travelerID, inbox.notifications[1].UserId)
+		assert.Equal(t, organizationID, inbox.notifications[0].OrganizationId)
+		assert.Equal(t, enums.NotificationTypeStatusChange, inbox.notifications[0].Type)
+		assert.Nil(t, inbox.notifications[0].ReadAt)
+		assert.JSONEq(t, `{
+			"travel_request_id": "`+travelRequest.Id.String()+`",
+			"destination_name": "Recife",
+			"departure_date": "2030-05-15T10:00:00Z",
+			"status": "APPROVED",
+			"previous_status": "SOLICITED"
+		}`, string(inbox.notifications[0].Payload))
+	})
+
+	t.Run("should follow each user's channels per notification type", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		inbox := &recordingInbox{}
+		service := NewNotificationService(outbox, inbox, memoryPreferences{
+			{UserId: requesterID, Type: enums.NotificationTypeStatusChange, Email: false, InApp: true},
+			{UserId: travelerID, Type: enums.NotificationTypeStatusChange, Email: true, InApp: false},
+			{UserId: travelerID, Type: enums.NotificationTypeComment, Email: false, InApp: false},
+		})
+
+		// Act
+		err := service.NotifyStatusChanges(context.Background(), []StatusChange{
+			{TravelRequest: newTravelRequest(), PreviousStatus: enums.TravelRequestStatusSolicited},
+			{TravelRequest: newTravelRequest(), PreviousStatus: enums.TravelRequestStatusSolicited},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		var addresses []string
+		for _, email := range outbox.emails(t) {
+			addresses = append(addresses, email.To.Email)
+		}
+		assert.Equal(t, []string{"bruno@empresa.com", "carla@externo.com"}, addresses)
+		require.Len(t, inbox.notifications, 2)
+		for _, notification := range inbox.notifications {
+			assert.Equal(t, requesterID, notification.UserId)
+		}
+	})
+
+	t.Run("should skip users that turned off comment notifications", func(t *testing.T) {
+		// Arrange
+		outbox := &recordingOutbox{}
+		inbox := &recordingInbox{}
+		service := NewNotificationService(outbox, inbox, memoryPreferences{
+			{UserId: travelerID, Type: enums.NotificationTypeComment, Email: false, InApp: false},
 		})
+		comment := &entity.TravelRequestComment{Id: uuid.New(), AuthorName: "Carla", Body: "Ok"}
+		recipients := []entity.NotificationRecipient{
+			{Name: "Ana", Email: "ana@empresa.com", UserId: &requesterID},
+			{Name: "Bruno", Email: "bruno@empresa.com", UserId: &travelerID},
+		}
+
+		// Act
+		err := service.NotifyComment(context.Background(), newTravelRequest(), comment, recipients)
+
+		// Assert
+		require.NoError(t, err)
+		emails := outbox.emails(t)
+		require.Len(t, emails, 1)
+		assert.Equal(t, "ana@empresa.com", emails[0].To.Email)
+		require.Len(t, inbox.notifications, 1)
+		assert.Equal(t, enums.NotificationTypeComment, inbox.notifications[0].Type)
+		assert.Contains(t, string(inbox.notifications[0].Payload), comment.Id.String())
+	})
+}
+
+func TestEmailOutboxHandler(t *testing.T) {
+	t.Run("should send the queued email", func(t *testing.T) {
+		// Arrange
+		mailer := &recordingMailer{}
+		handler := EmailOutboxHandler(mailer)
+		email := entity.EmailMessage{
+			To:             entity.NotificationRecipient{Name: "Ana", Email: "ana@empresa.com"},
+			Subject:        "Assunto",
+			TextBody:       "Texto\n",
+			IdempotencyKey: "chave",
+		}
+		message, err := entity.NewOutboxMessage(uuid.New(), OutboxTopicEmail, email, time.Now())
+		require.NoError(t, err)
+
+		// Act
+		err = handler(context.Background(), *message)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.EmailMessage{email}, mailer.sent())
+	})
+
+	t.Run("should return the mailer error for a retry", func(t *testing.T) {
+		// Arrange
+		mailer := &recordingMailer{err: errors.New("servidor indisponível")}
+		handler := EmailOutboxHandler(mailer)
+		message, err := entity.NewOutboxMessage(uuid.New(), OutboxTopicEmail, entity.EmailMessage{}, time.Now())
+		require.NoError(t, err)
+
+		// Act
+		err = handler(context.Background(), *message)
+
+		// Assert
+		assert.EqualError(t, err, "servidor indisponível")
+	})
+
+	t.Run("should reject an undecodable payload", func(t *testing.T) {
+		// Arrange
+		handler := EmailOutboxHandler(&recordingMailer{})
+		message := entity.OutboxMessage{Topic: OutboxTopicEmail, Payload: json.RawMessage(`"texto"`)}
+
+		// Act
+		err := handler(context.Background(), message)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrOutboxPayloadInvalid)
+	})
+}
+
+func TestRenderEmail(t *testing.T) {
+	travelRequest := &entity.TravelRequest{
+		DestinationName: "Lima",
+		DepartureDate:   time.Date(2030, 6, 1, 10, 0, 0, 0, time.UTC),
+		Status:          enums.TravelRequestStatusCanceled,
+	}
+
+	t.Run("should render the Spanish template", func(t *testing.T) {
+		// Arrange
+		recipient := entity.NotificationRecipient{Name: "Lucía", Email: "lucia@empresa.com", Locale: enums.LocaleEs}
+
+		// Act
+		message, err := renderEmail(emailTemplateStatusChange, recipient, newStatusChangeEmail(recipient, travelRequest))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Solicitud de viaje a Lima cancelada", message.Subject)
+		assert.Contains(t, message.TextBody, "Hola Lucía,")
+		assert.Contains(t, message.HTMLBody, "CANCELADA")
+	})
+
+	t.Run("should fall back to pt-BR for recipients without a supported locale", func(t *testing.T) {
+		// Arrange
+		recipient := entity.NotificationRecipient{Name: "Ana", Email: "ana@empresa.com", Locale: "fr-FR"}
+
+		// Act
+		message, err := renderEmail(emailTemplateStatusChange, recipient, newStatusChangeEmail(recipient, travelRequest))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Pedido de viagem para Lima cancelado", message.Subject)
+	})
+}
+
+func TestFormatTravelPeriod(t *testing.T) {
+	t.Run("should render each leg in its local time zone", func(t *testing.T) {
+		// Arrange
+		departureDate := time.Date(2030, 5, 15, 2, 30, 0, 0, time.UTC)
+		returnDate := time.Date(2030, 5, 20, 23, 0, 0, 0, time.UTC)
+
+		travelRequest := &entity.TravelRequest{
+			DepartureDate:     departureDate,
+			ReturnDate:        &returnDate,
+			DepartureTimezone: "America/Sao_Paulo",
+			ReturnTimezone:    "Asia/Tokyo",
+		}
+
+		// Act
+		period := formatTravelPeriod(enums.LocalePtBR, travelRequest)
+
+		// Assert
+		assert.Equal(t, "14/05/2030 23:30 (America/Sao_Paulo) a 21/05/2030 08:00 (Asia/Tokyo)", period)
+	})
+
+	t.Run("should use the locale date format", func(t *testing.T) {
+		// Arrange
+		returnDate := time.Date(2030, 5, 20, 13, 5, 0, 0, time.UTC)
+		travelRequest := &entity.TravelRequest{
+			DepartureDate: time.Date(2030, 5, 15, 2, 30, 0, 0, time.UTC),
+			ReturnDate:    &returnDate,
+		}
+
+		// Act
+		english := formatTravelPeriod(enums.LocaleEnUS, travelRequest)
+		spanish := formatTravelPeriod(enums.LocaleEs, travelRequest)
+
+		// Assert
+		assert.Equal(t, "05/15/2030 2:30 AM (UTC) to 05/20/2030 1:05 PM (UTC)", english)
+		assert.Equal(t, "15/05/2030 02:30 (UTC) al 20/05/2030 13:05 (UTC)", spanish)
+	})
+
+	t.Run("should fall back to UTC without a time zone", func(t *testing.T) {
+		// Arrange
+		travelRequest := &entity.TravelRequest{
+			DepartureDate: time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC),
+		}
+
+		// Act
+		period := formatTravelPeriod(enums.LocalePtBR, travelRequest)
+
+		// Assert
+		assert.Equal(t, "15/05/2030 10:00 (UTC)", period)
 	})
 }
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationUnavailable = errors.New("organização inexistente ou inativa")
+	ErrInvalidOrganization     = errors.New("nome da organização é obrigatório")
+)
+
+type OrganizationUseCase interface {
+	LoadOrganization(ctx context.Context, id uuid.UUID) (*entity.Organization, error)
+	GetOrganization(ctx context.Context, userID uuid.UUID) (*entity.Organization, error)
+	UpdateOrganization(ctx context.Context, userID uuid.UUID, input dto.UpdateOrganizationDTO) (*entity.Organization, error)
+	ForEachOrganization(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type OrganizationUseCaseImpl struct {
+	organizationGateway gateway.OrganizationGateway
+	userGateway         gateway.UserGateway
+}
+
+func NewOrganizationUseCase(organizationGateway gateway.OrganizationGateway, userGateway gateway.UserGateway) *OrganizationUseCaseImpl {
+	return &OrganizationUseCaseImpl{
+		organizationGateway: organizationGateway,
+		userGateway:         userGateway,
+	}
+}
+
+// LoadOrganization carrega a organização do token; organizações desativadas perdem o acesso.
+func (uc *OrganizationUseCaseImpl) LoadOrganization(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	organization, err := uc.organizationGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !organization.Active {
+		return nil, ErrOrganizationUnavailable
+	}
+
+	return organization, nil
+}
+
+func (uc *OrganizationUseCaseImpl) GetOrganization(ctx context.Context, userID uuid.UUID) (*entity.Organization, error) {
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.organizationGateway.FindByID(ctx, user.OrganizationId)
+}
+
+// UpdateOrganization altera as configurações da organização do administrador. A nova moeda base
+// vale para as próximas estimativas; as cotações cadastradas continuam expressas na moeda
+// anterior e precisam ser atualizadas.
+func (uc *OrganizationUseCaseImpl) UpdateOrganization(ctx context.Context, userID uuid.UUID, input dto.UpdateOrganizationDTO) (*entity.Organization, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, ErrInvalidOrganization
+	}
+
+	currency := strings.ToUpper(input.BaseCurrency)
+	if !currencyPattern.MatchString(currency) {
+		return nil, ErrInvalidCurrency
+	}
+
+	if err := validateTimezones(input.DefaultTimezone); err != nil {
+		return nil, err
+	}
+
+	var locale enums.Locale
+	if input.DefaultLocale != "" {
+		parsed, ok := enums.ParseLocale(input.DefaultLocale)
+		if !ok {
+			return nil, ErrInvalidLocale
+		}
+		locale = parsed
+	}
+
+	organization, err := uc.organizationGateway.FindByID(ctx, admin.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	organization.Name = name
+	organization.BaseCurrency = currency
+	organization.DefaultTimezone = input.DefaultTimezone
+	if locale != "" {
+		organization.DefaultLocale = locale
+	}
+	organization.UpdatedAt = &now
+
+	if err := uc.organizationGateway.Update(ctx, organization); err != nil {
+		return nil, err
+	}
+
+	return organization, nil
+}
+
+// ForEachOrganization executa fn no contexto de cada organização ativa. É usado pelas tarefas em
+// segundo plano, que não partem de um usuário autenticado; a falha em uma organização não
+// impede o processamento das demais.
+func (uc *OrganizationUseCaseImpl) ForEachOrganization(ctx context.Context, fn func(ctx context.Context) error) error {
+	organizations, err := uc.organizationGateway.ListActive(tenant.AsSystem(ctx))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range organizations {
+		if err := fn(tenant.WithOrganization(ctx, &organizations[i])); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", organizations[i].Code, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
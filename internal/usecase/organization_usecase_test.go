@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOrganizationGateway struct {
+	mock.Mock
+}
+
+func (m *MockOrganizationGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.Organization, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationGateway) FindByCode(ctx context.Context, code string) (*entity.Organization, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationGateway) ListActive(ctx context.Context) ([]entity.Organization, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.Organization), args.Error(1)
+}
+
+func (m *MockOrganizationGateway) Update(ctx context.Context, organization *entity.Organization) error {
+	args := m.Called(ctx, organization)
+	return args.Error(0)
+}
+
+func TestOrganizationUseCase_UpdateOrganization(t *testing.T) {
+	ctx := context.Background()
+	organizationID := uuid.New()
+	admin := &entity.User{Id: uuid.New(), OrganizationId: organizationID, Role: enums.UserTypeAdmin}
+
+	t.Run("should update the admin organization settings", func(t *testing.T) {
+		// Arrange
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOrganizationUseCase(mockOrganizationGateway, mockUserGateway)
+
+		organization := &entity.Organization{Id: organizationID, Code: "FILIAL", Name: "Filial", BaseCurrency: "BRL", DefaultTimezone: "America/Sao_Paulo", Active: true}
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mockOrganizationGateway.On("FindByID", ctx, organizationID).Return(organization, nil)
+		mockOrganizationGateway.On("Update", ctx, organization).Return(nil)
+
+		// Act
+		result, err := useCase.UpdateOrganization(ctx, admin.Id, dto.UpdateOrganizationDTO{
+			Name:            " Filial Lisboa ",
+			BaseCurrency:    "eur",
+			DefaultTimezone: "Europe/Lisbon",
+			DefaultLocale:   "es",
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Filial Lisboa", result.Name)
+		assert.Equal(t, "EUR", result.BaseCurrency)
+		assert.Equal(t, "Europe/Lisbon", result.DefaultTimezone)
+		assert.Equal(t, enums.LocaleEs, result.DefaultLocale)
+		assert.NotNil(t, result.UpdatedAt)
+		mockOrganizationGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject invalid timezone", func(t *testing.T) {
+		// Arrange
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOrganizationUseCase(mockOrganizationGateway, mockUserGateway)
+
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+
+		// Act
+		_, err := useCase.UpdateOrganization(ctx, admin.Id, dto.UpdateOrganizationDTO{
+			Name:            "Filial",
+			BaseCurrency:    "BRL",
+			DefaultTimezone: "Marte/Olympus",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+		mockOrganizationGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject non admin users", func(t *testing.T) {
+		// Arrange
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOrganizationUseCase(mockOrganizationGateway, mockUserGateway)
+
+		user := &entity.User{Id: uuid.New(), OrganizationId: organizationID, Role: enums.UserTypeCommon}
+		mockUserGateway.On("FindByID", ctx, user.Id).Return(user, nil)
+
+		// Act
+		_, err := useCase.UpdateOrganization(ctx, user.Id, dto.UpdateOrganizationDTO{
+			Name:            "Filial",
+			BaseCurrency:    "BRL",
+			DefaultTimezone: "America/Sao_Paulo",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestOrganizationUseCase_LoadOrganization(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should refuse inactive organizations", func(t *testing.T) {
+		// Arrange
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewOrganizationUseCase(mockOrganizationGateway, new(MockUserGateway))
+
+		organization := &entity.Organization{Id: uuid.New(), Active: false}
+		mockOrganizationGateway.On("FindByID", ctx, organization.Id).Return(organization, nil)
+
+		// Act
+		result, err := useCase.LoadOrganization(ctx, organization.Id)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrOrganizationUnavailable)
+	})
+}
+
+func TestOrganizationUseCase_ForEachOrganization(t *testing.T) {
+	t.Run("should run in the context of each organization and keep going after failures", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		mockOrganizationGateway := new(MockOrganizationGateway)
+		useCase := NewOrganizationUseCase(mockOrganizationGateway, new(MockUserGateway))
+
+		organizations := []entity.Organization{
+			{Id: uuid.New(), Code: "MATRIZ", Active: true},
+			{Id: uuid.New(), Code: "FILIAL", Active: true},
+		}
+		mockOrganizationGateway.On("ListActive", tenant.AsSystem(ctx)).Return(organizations, nil)
+
+		var visited []uuid.UUID
+
+		// Act
+		err := useCase.ForEachOrganization(ctx, func(ctx context.Context) error {
+			organization, ok := tenant.Organization(ctx)
+			assert.True(t, ok)
+			visited = append(visited, organization.Id)
+			if organization.Code == "MATRIZ" {
+				return errors.New("falha")
+			}
+			return nil
+		})
+
+		// Assert
+		assert.ErrorContains(t, err, "MATRIZ: falha")
+		assert.Equal(t, []uuid.UUID{organizations[0].Id, organizations[1].Id}, visited)
+	})
+}
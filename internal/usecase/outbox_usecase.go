@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const outboxListLimit = 100
+
+var (
+	ErrOutboxMessageNotFound = errors.New("mensagem inexistente ou fora da fila de falhas")
+	ErrOutboxPayloadInvalid  = errors.New("conteúdo da mensagem inválido")
+	ErrOutboxTopicUnknown    = errors.New("tópico sem entregador")
+	ErrInvalidOutboxStatus   = errors.New("status de mensagem inválido")
+)
+
+// OutboxHandler entrega uma mensagem do outbox. A entrega é at-least-once: após uma falha ou a
+// expiração da reserva a mesma mensagem é entregue de novo, então o handler deve tolerar
+// repetições (o e-mail, por exemplo, reaproveita o Message-ID).
+type OutboxHandler func(ctx context.Context, message entity.OutboxMessage) error
+
+// OutboxConfig define o tamanho dos lotes, o limite de tentativas antes de a mensagem passar a
+// DEAD e por quanto tempo uma mensagem fica reservada ao worker que a pegou.
+type OutboxConfig struct {
+	BatchSize   int
+	MaxAttempts int
+	Lease       time.Duration
+}
+
+type OutboxUseCase interface {
+	ProcessDue(ctx context.Context) error
+	ListMessages(ctx context.Context, userID uuid.UUID, status enums.OutboxStatus) ([]entity.OutboxMessage, error)
+	RetryMessage(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+}
+
+type OutboxUseCaseImpl struct {
+	outboxGateway gateway.OutboxGateway
+	userGateway   gateway.UserGateway
+	handlers      map[string]OutboxHandler
+	config        OutboxConfig
+	now           func() time.Time
+}
+
+func NewOutboxUseCase(outboxGateway gateway.OutboxGateway, userGateway gateway.UserGateway, handlers map[string]OutboxHandler, config OutboxConfig) *OutboxUseCaseImpl {
+	if config.BatchSize <= 0 {
+		config.BatchSize = 20
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = 8
+	}
+	if config.Lease <= 0 {
+		config.Lease = 5 * time.Minute
+	}
+
+	return &OutboxUseCaseImpl{
+		outboxGateway: outboxGateway,
+		userGateway:   userGateway,
+		handlers:      handlers,
+		config:        config,
+		now:           time.Now,
+	}
+}
+
+// ProcessDue entrega as mensagens vencidas de todas as organizações, em lotes, até esvaziar a
+// fila ou o contexto ser cancelado. A falha de uma entrega fica registrada na própria mensagem
+// e não interrompe as demais.
+func (uc *OutboxUseCaseImpl) ProcessDue(ctx context.Context) error {
+	ctx = tenant.AsSystem(ctx)
+
+	for ctx.Err() == nil {
+		messages, err := uc.outboxGateway.ClaimDue(ctx, uc.now(), uc.config.BatchSize, uc.config.Lease)
+		if err != nil {
+			return err
+		}
+
+		for i := range messages {
+			if err := uc.deliver(ctx, &messages[i]); err != nil {
+				return err
+			}
+		}
+
+		if len(messages) < uc.config.BatchSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (uc *OutboxUseCaseImpl) deliver(ctx context.Context, message *entity.OutboxMessage) error {
+	handler, ok := uc.handlers[message.Topic]
+
+	var err error
+	if !ok {
+		err = fmt.Errorf("%w: %s", ErrOutboxTopicUnknown, message.Topic)
+	} else {
+		err = handler(ctx, *message)
+	}
+
+	if err == nil {
+		return uc.outboxGateway.MarkDelivered(ctx, message.Id, uc.now())
+	}
+
+	maxAttempts := uc.config.MaxAttempts
+	if !ok || errors.Is(err, ErrOutboxPayloadInvalid) {
+		maxAttempts = message.Attempts + 1
+	}
+
+	message.RecordFailure(err, uc.now(), maxAttempts)
+	if message.Status == enums.OutboxStatusDead {
+		log.Printf("mensagem %s (%s) movida para a fila de falhas após %d tentativas: %v", message.Id, message.Topic, message.Attempts, err)
+	}
+
+	return uc.outboxGateway.MarkFailed(ctx, message)
+}
+
+func (uc *OutboxUseCaseImpl) ListMessages(ctx context.Context, userID uuid.UUID, status enums.OutboxStatus) ([]entity.OutboxMessage, error) {
+	if !status.IsValid() {
+		return nil, ErrInvalidOutboxStatus
+	}
+
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.outboxGateway.List(ctx, status, outboxListLimit)
+}
+
+// RetryMessage devolve à fila uma mensagem DEAD da organização, com as tentativas zeradas.
+func (uc *OutboxUseCaseImpl) RetryMessage(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return err
+	}
+
+	requeued, err := uc.outboxGateway.Requeue(ctx, id, uc.now())
+	if err != nil {
+		return err
+	}
+	if !requeued {
+		return ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
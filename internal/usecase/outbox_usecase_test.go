@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/tenant"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxGateway struct {
+	mock.Mock
+}
+
+func (m *MockOutboxGateway) Enqueue(ctx context.Context, messages []entity.OutboxMessage) error {
+	args := m.Called(ctx, messages)
+	return args.Error(0)
+}
+
+func (m *MockOutboxGateway) ClaimDue(ctx context.Context, now time.Time, limit int, lease time.Duration) ([]entity.OutboxMessage, error) {
+	args := m.Called(ctx, now, limit, lease)
+	return args.Get(0).([]entity.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxGateway) MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error {
+	args := m.Called(ctx, id, deliveredAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxGateway) MarkFailed(ctx context.Context, message *entity.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockOutboxGateway) List(ctx context.Context, status enums.OutboxStatus, limit int) ([]entity.OutboxMessage, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]entity.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxGateway) Requeue(ctx context.Context, id uuid.UUID, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func TestOutboxUseCase_ProcessDue(t *testing.T) {
+	ctx := context.Background()
+	systemCtx := tenant.AsSystem(ctx)
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+	config := OutboxConfig{BatchSize: 2, MaxAttempts: 3, Lease: time.Minute}
+
+	setup := func(handler OutboxHandler) (*OutboxUseCaseImpl, *MockOutboxGateway) {
+		mockOutboxGateway := new(MockOutboxGateway)
+		useCase := NewOutboxUseCase(mockOutboxGateway, new(MockUserGateway), map[string]OutboxHandler{"email": handler}, config)
+		useCase.now = func() time.Time { return now }
+		return useCase, mockOutboxGateway
+	}
+
+	t.Run("should mark delivered messages and keep claiming while batches are full", func(t *testing.T) {
+		// Arrange
+		var delivered []uuid.UUID
+		useCase, mockOutboxGateway := setup(func(ctx context.Context, message entity.OutboxMessage) error {
+			delivered = append(delivered, message.Id)
+			return nil
+		})
+
+		first := entity.OutboxMessage{Id: uuid.New(), Topic: "email", Status: enums.OutboxStatusPending}
+		second := entity.OutboxMessage{Id: uuid.New(), Topic: "email", Status: enums.OutboxStatusPending}
+		third := entity.OutboxMessage{Id: uuid.New(), Topic: "email", Status: enums.OutboxStatusPending}
+		mockOutboxGateway.On("ClaimDue", systemCtx, now, 2, time.Minute).Return([]entity.OutboxMessage{first, second}, nil).Once()
+		mockOutboxGateway.On("ClaimDue", systemCtx, now, 2, time.Minute).Return([]entity.OutboxMessage{third}, nil).Once()
+		mockOutboxGateway.On("MarkDelivered", systemCtx, mock.Anything, now).Return(nil)
+
+		// Act
+		err := useCase.ProcessDue(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first.Id, second.Id, third.Id}, delivered)
+		mockOutboxGateway.AssertNumberOfCalls(t, "ClaimDue", 2)
+		mockOutboxGateway.AssertNumberOfCalls(t, "MarkDelivered", 3)
+	})
+
+	t.Run("should reschedule failed deliveries with backoff", func(t *testing.T) {
+		// Arrange
+		useCase, mockOutboxGateway := setup(func(ctx context.Context, message entity.OutboxMessage) error {
+			return errors.New("servidor indisponível")
+		})
+
+		message := entity.OutboxMessage{Id: uuid.New(), Topic: "email", Status: enums.OutboxStatusPending}
+		mockOutboxGateway.On("ClaimDue", systemCtx, now, 2, time.Minute).Return([]entity.OutboxMessage{message}, nil)
+		mockOutboxGateway.On("MarkFailed", systemCtx, mock.AnythingOfType("*entity.OutboxMessage")).Return(nil)
+
+		// Act
+		err := useCase.ProcessDue(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		failed := mockOutboxGateway.Calls[1].Arguments.Get(1).(*entity.OutboxMessage)
+		assert.Equal(t, enums.OutboxStatusPending, failed.Status)
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, now.Add(30*time.Second), failed.AvailableAt)
+		assert.Equal(t, "servidor indisponível", *failed.LastError)
+	})
+
+	t.Run("should dead-letter a message on its last attempt", func(t *testing.T) {
+		// Arrange
+		useCase, mockOutboxGateway := setup(func(ctx context.Context, message entity.OutboxMessage) error {
+			return errors.New("caixa inexistente")
+		})
+
+		message := entity.OutboxMessage{Id: uuid.New(), Topic: "email", Status: enums.OutboxStatusPending, Attempts: 2}
+		mockOutboxGateway.On("ClaimDue", systemCtx, now, 2, time.Minute).Return([]entity.OutboxMessage{message}, nil)
+		mockOutboxGateway.On("MarkFailed", systemCtx, mock.AnythingOfType("*entity.OutboxMessage")).Return(nil)
+
+		// Act
+		err := useCase.ProcessDue(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		failed := mockOutboxGateway.Calls[1].Arguments.Get(1).(*entity.OutboxMessage)
+		assert.Equal(t, enums.OutboxStatusDead, failed.Status)
+		assert.Equal(t, 3, failed.Attempts)
+	})
+
+	t.Run("should dead-letter messages without a handler right away", func(t *testing.T) {
+		// Arrange
+		useCase, mockOutboxGateway := setup(nil)
+
+		message := entity.OutboxMessage{Id: uuid.New(), Topic: "sms", Status: enums.OutboxStatusPending}
+		mockOutboxGateway.On("ClaimDue", systemCtx, now, 2, time.Minute).Return([]entity.OutboxMessage{message}, nil)
+		mockOutboxGateway.On("MarkFailed", systemCtx, mock.AnythingOfType("*entity.OutboxMessage")).Return(nil)
+
+		// Act
+		err := useCase.ProcessDue(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		failed := mockOutboxGateway.Calls[1].Arguments.Get(1).(*entity.OutboxMessage)
+		assert.Equal(t, enums.OutboxStatusDead, failed.Status)
+		assert.Contains(t, *failed.LastError, ErrOutboxTopicUnknown.Error())
+	})
+}
+
+func TestOutboxUseCase_RetryMessage(t *testing.T) {
+	ctx := context.Background()
+	admin := &entity.User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+	common := &entity.User{Id: uuid.New(), Role: enums.UserTypeCommon}
+
+	t.Run("should requeue a dead message", func(t *testing.T) {
+		// Arrange
+		mockOutboxGateway := new(MockOutboxGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOutboxUseCase(mockOutboxGateway, mockUserGateway, nil, OutboxConfig{})
+
+		id := uuid.New()
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mockOutboxGateway.On("Requeue", ctx, id, mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		// Act
+		err := useCase.RetryMessage(ctx, admin.Id, id)
+
+		// Assert
+		assert.NoError(t, err)
+		mockOutboxGateway.AssertExpectations(t)
+	})
+
+	t.Run("should report messages that are not dead", func(t *testing.T) {
+		// Arrange
+		mockOutboxGateway := new(MockOutboxGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOutboxUseCase(mockOutboxGateway, mockUserGateway, nil, OutboxConfig{})
+
+		id := uuid.New()
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mockOutboxGateway.On("Requeue", ctx, id, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+		// Act
+		err := useCase.RetryMessage(ctx, admin.Id, id)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrOutboxMessageNotFound)
+	})
+
+	t.Run("should restrict retries to admins", func(t *testing.T) {
+		// Arrange
+		mockOutboxGateway := new(MockOutboxGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewOutboxUseCase(mockOutboxGateway, mockUserGateway, nil, OutboxConfig{})
+
+		mockUserGateway.On("FindByID", ctx, common.Id).Return(common, nil)
+
+		// Act
+		err := useCase.RetryMessage(ctx, common.Id, uuid.New())
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		mockOutboxGateway.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
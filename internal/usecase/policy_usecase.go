@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/gateway"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+var (
+	ErrPolicyViolation = errors.New("a solicitação viola a política de viagens")
+)
+
+// PolicyViolationError carrega as violações bloqueantes encontradas na avaliação.
+type PolicyViolationError struct {
+	Violations []entity.TravelPolicyViolation
+}
+
+func (e *PolicyViolationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+
+	return fmt.Sprintf("%s: %s", ErrPolicyViolation.Error(), strings.Join(messages, "; "))
+}
+
+func (e *PolicyViolationError) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+type PolicyUseCase interface {
+	Evaluate(ctx context.Context, travelRequest *entity.TravelRequest) ([]entity.TravelPolicyViolation, error)
+	ListRules(ctx context.Context) ([]entity.TravelPolicyRule, error)
+}
+
+type PolicyUseCaseImpl struct {
+	ruleGateway gateway.PolicyRuleGateway
+}
+
+func NewPolicyUseCase(ruleGateway gateway.PolicyRuleGateway) *PolicyUseCaseImpl {
+	return &PolicyUseCaseImpl{
+		ruleGateway: ruleGateway,
+	}
+}
+
+func (uc *PolicyUseCaseImpl) Evaluate(ctx context.Context, travelRequest *entity.TravelRequest) ([]entity.TravelPolicyViolation, error) {
+	rules, err := uc.ruleGateway.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	facts := entity.NewPolicyFacts(travelRequest, now)
+	violations := []entity.TravelPolicyViolation{}
+
+	for _, rule := range rules {
+		matches, err := rule.Matches(facts)
+		if err != nil {
+			log.Printf("[POLICY] Regra ignorada: %v", err)
+			continue
+		}
+
+		if matches {
+			violations = append(violations, rule.NewViolation(travelRequest.Id, now))
+		}
+	}
+
+	return violations, nil
+}
+
+func (uc *PolicyUseCaseImpl) ListRules(ctx context.Context) ([]entity.TravelPolicyRule, error) {
+	return uc.ruleGateway.ListActive(ctx)
+}
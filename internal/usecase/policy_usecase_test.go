@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPolicyRuleGateway struct {
+	mock.Mock
+}
+
+func (m *MockPolicyRuleGateway) ListActive(ctx context.Context) ([]entity.TravelPolicyRule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TravelPolicyRule), args.Error(1)
+}
+
+func TestPolicyUseCase_Evaluate(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	ruleID := uuid.New()
+	departureDate := time.Now().AddDate(0, 0, 7)
+	returnDate := departureDate.AddDate(0, 0, 8)
+
+	travel := &entity.TravelRequest{
+		Id:              uuid.New(),
+		DestinationName: "Tokyo",
+		DepartureDate:   departureDate,
+		ReturnDate:      &returnDate,
+	}
+
+	rules := []entity.TravelPolicyRule{
+		{Id: ruleID, Name: "antecedencia_minima", Field: enums.PolicyFieldAdvanceDays, Operator: enums.PolicyOperatorLessThan, Value: "14", Severity: enums.PolicySeverityBlock, Message: "Antecedência mínima de 14 dias"},
+		{Name: "limite_pernoites", Field: enums.PolicyFieldNights, Operator: enums.PolicyOperatorGreaterThan, Value: "5", Severity: enums.PolicySeverityWarn, Message: "Mais de 5 pernoites"},
+		{Name: "destinos_restritos", Field: enums.PolicyFieldDestinationName, Operator: enums.PolicyOperatorIn, Value: "Caracas, Cabul", Severity: enums.PolicySeverityBlock, Message: "Destino restrito"},
+		{Name: "regra_quebrada", Field: "unknown", Operator: enums.PolicyOperatorEqual, Value: "x", Severity: enums.PolicySeverityBlock, Message: "Nunca aplicada"},
+	}
+
+	t.Run("should return violations for matching rules only", func(t *testing.T) {
+		// Arrange
+		mockRuleGateway := new(MockPolicyRuleGateway)
+		useCase := NewPolicyUseCase(mockRuleGateway)
+		mockRuleGateway.On("ListActive", ctx).Return(rules, nil)
+
+		// Act
+		violations, err := useCase.Evaluate(ctx, travel)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, violations, 2)
+		assert.Equal(t, "antecedencia_minima", violations[0].RuleName)
+		assert.Equal(t, enums.PolicySeverityBlock, violations[0].Severity)
+		assert.Equal(t, ruleID, *violations[0].RuleId)
+		assert.Equal(t, "limite_pernoites", violations[1].RuleName)
+		assert.Nil(t, violations[1].RuleId)
+		assert.Equal(t, travel.Id, violations[1].TravelRequestId)
+	})
+
+	t.Run("should return error when rules cannot be loaded", func(t *testing.T) {
+		// Arrange
+		mockRuleGateway := new(MockPolicyRuleGateway)
+		useCase := NewPolicyUseCase(mockRuleGateway)
+		loadErr := errors.New("connection refused")
+		mockRuleGateway.On("ListActive", ctx).Return(nil, loadErr)
+
+		// Act
+		violations, err := useCase.Evaluate(ctx, travel)
+
+		// Assert
+		assert.Equal(t, loadErr, err)
+		assert.Nil(t, violations)
+	})
+}
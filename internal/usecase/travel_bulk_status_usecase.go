@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidBulkStatus      = errors.New("o status em lote deve ser APPROVED ou CANCELED")
+	ErrInvalidTransition      = errors.New("a solicitação não está pendente de decisão")
+	ErrStatusChangedMeanwhile = errors.New("o status da solicitação foi alterado por outra operação")
+	ErrTravelRequestMissing   = errors.New("solicitação de viagem não encontrada")
+)
+
+// BulkUpdateStatus aplica a mesma decisão a várias solicitações. Cada item é validado e
+// gravado isoladamente (em um savepoint), de forma que uma falha não desfaz nem impede os demais,
+// e o resultado de cada um é devolvido no relatório. As notificações são gravadas em lote ao
+// final, na mesma transação das decisões.
+func (uc *TravelRequestUseCaseImpl) BulkUpdateStatus(ctx context.Context, userID uuid.UUID, input dto.BulkUpdateStatusDTO) (*dto.BulkUpdateStatusResponseDTO, error) {
+	if input.Status != enums.TravelRequestStatusApproved && input.Status != enums.TravelRequestStatusCanceled {
+		return nil, ErrInvalidBulkStatus
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := uniqueIDs(input.TravelRequestIds)
+
+	travels, err := uc.travelGateway.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*entity.TravelRequest, len(travels))
+	for i := range travels {
+		byID[travels[i].Id] = &travels[i]
+	}
+
+	report := &dto.BulkUpdateStatusResponseDTO{
+		Status:  input.Status,
+		Results: make([]dto.BulkStatusItemDTO, 0, len(ids)),
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		changes := make([]StatusChange, 0, len(ids))
+
+		for _, id := range ids {
+			item := dto.BulkStatusItemDTO{TravelRequestId: id, Result: dto.BulkStatusResultSuccess}
+
+			travel, found := byID[id]
+			if !found {
+				item.Result, item.Error = dto.BulkStatusResultNotFound, ErrTravelRequestMissing.Error()
+			} else if previousStatus, err := uc.applyBulkStatusIsolated(ctx, user, travel, input.Status); err != nil {
+				item.Result, item.Error = bulkStatusResult(err), err.Error()
+			} else {
+				changes = append(changes, StatusChange{TravelRequest: travel, PreviousStatus: previousStatus})
+			}
+
+			if item.Result == dto.BulkStatusResultSuccess {
+				report.Succeeded++
+			} else {
+				report.Failed++
+			}
+			report.Results = append(report.Results, item)
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+
+		return uc.notificationService.NotifyStatusChanges(ctx, changes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// applyBulkStatusIsolated aplica a decisão de um item em um savepoint, para que a falha dele
+// não comprometa a transação do lote.
+func (uc *TravelRequestUseCaseImpl) applyBulkStatusIsolated(ctx context.Context, user *entity.User, travel *entity.TravelRequest, status enums.TravelRequestStatus) (enums.TravelRequestStatus, error) {
+	previousStatus := travel.Status
+
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		previousStatus, err = uc.applyBulkStatus(ctx, user, travel, status)
+		if err != nil {
+			return err
+		}
+
+		return uc.publishStatusEvent(ctx, travel, previousStatus)
+	})
+
+	return previousStatus, err
+}
+
+// applyBulkStatus valida e grava a decisão de uma solicitação, devolvendo o status anterior.
+func (uc *TravelRequestUseCaseImpl) applyBulkStatus(ctx context.Context, user *entity.User, travel *entity.TravelRequest, status enums.TravelRequestStatus) (enums.TravelRequestStatus, error) {
+	previousStatus := travel.Status
+
+	if err := uc.authorizeStatusChange(user, travel); err != nil {
+		return previousStatus, err
+	}
+
+	if travel.Group != nil && travel.Group.ApprovesAsUnit() {
+		return previousStatus, ErrGroupApprovedAsUnit
+	}
+
+	if !travel.CanTransitionTo(status) {
+		return previousStatus, ErrInvalidTransition
+	}
+
+	if status == enums.TravelRequestStatusApproved {
+		if err := uc.costUseCase.CheckBudget(ctx, travel); err != nil {
+			return previousStatus, err
+		}
+	}
+
+	applyStatus(user, travel, status)
+
+	applied, err := uc.travelGateway.UpdateStatus(ctx, travel, previousStatus)
+	if err != nil {
+		return previousStatus, err
+	}
+
+	if !applied {
+		return previousStatus, ErrStatusChangedMeanwhile
+	}
+
+	return previousStatus, nil
+}
+
+func bulkStatusResult(err error) dto.BulkStatusResult {
+	switch {
+	case errors.Is(err, ErrUnauthorized):
+		return dto.BulkStatusResultUnauthorized
+	case errors.Is(err, ErrInvalidTransition), errors.Is(err, ErrGroupApprovedAsUnit):
+		return dto.BulkStatusResultInvalidTransition
+	case errors.Is(err, ErrStatusChangedMeanwhile):
+		return dto.BulkStatusResultConflict
+	}
+	return dto.BulkStatusResultFailed
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
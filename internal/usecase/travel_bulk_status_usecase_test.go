@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTravelUseCase_BulkUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+	admin := &entity.User{Id: adminID, Name: "Admin", Role: enums.UserTypeAdmin}
+
+	t.Run("should apply each item independently and notify once", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		pending := entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+		own := entity.TravelRequest{Id: uuid.New(), UserId: adminID, Status: enums.TravelRequestStatusSolicited}
+		approved := entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusApproved}
+		raced := entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+		missingID := uuid.New()
+		ids := []uuid.UUID{pending.Id, own.Id, approved.Id, raced.Id, missingID}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByIDs", ctx, ids).Return([]entity.TravelRequest{pending, own, approved, raced}, nil)
+		mockCostUseCase.On("CheckBudget", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockTravelGateway.On("UpdateStatus", ctx, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == pending.Id }), enums.TravelRequestStatusSolicited).Return(true, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == raced.Id }), enums.TravelRequestStatusSolicited).Return(false, nil)
+		mockNotificationService.On("NotifyStatusChanges", mock.Anything, mock.Anything).Return(nil)
+
+		// Act
+		report, err := useCase.BulkUpdateStatus(ctx, adminID, dto.BulkUpdateStatusDTO{
+			TravelRequestIds: append(ids, pending.Id),
+			Status:           enums.TravelRequestStatusApproved,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, report.Succeeded)
+		assert.Equal(t, 4, report.Failed)
+		assert.Equal(t, []dto.BulkStatusResult{
+			dto.BulkStatusResultSuccess,
+			dto.BulkStatusResultUnauthorized,
+			dto.BulkStatusResultInvalidTransition,
+			dto.BulkStatusResultConflict,
+			dto.BulkStatusResultNotFound,
+		}, []dto.BulkStatusResult{report.Results[0].Result, report.Results[1].Result, report.Results[2].Result, report.Results[3].Result, report.Results[4].Result})
+		assert.Equal(t, ErrStatusChangedMeanwhile.Error(), report.Results[3].Error)
+
+		mockNotificationService.AssertNumberOfCalls(t, "NotifyStatusChanges", 1)
+		changes := mockNotificationService.Calls[0].Arguments.Get(1).([]StatusChange)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, pending.Id, changes[0].TravelRequest.Id)
+		assert.Equal(t, enums.TravelRequestStatusApproved, changes[0].TravelRequest.Status)
+		assert.Equal(t, adminID, *changes[0].TravelRequest.ApprovedBy)
+		assert.Equal(t, enums.TravelRequestStatusSolicited, changes[0].PreviousStatus)
+	})
+
+	t.Run("should report items over budget as failed", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		travel := entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByIDs", ctx, []uuid.UUID{travel.Id}).Return([]entity.TravelRequest{travel}, nil)
+		mockCostUseCase.On("CheckBudget", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(ErrBudgetExceeded)
+
+		// Act
+		report, err := useCase.BulkUpdateStatus(ctx, adminID, dto.BulkUpdateStatusDTO{
+			TravelRequestIds: []uuid.UUID{travel.Id},
+			Status:           enums.TravelRequestStatusApproved,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, dto.BulkStatusResultFailed, report.Results[0].Result)
+		mockTravelGateway.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+		mockNotificationService.AssertNotCalled(t, "NotifyStatusChanges", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject statuses other than approved or canceled", func(t *testing.T) {
+		// Arrange
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		// Act
+		report, err := useCase.BulkUpdateStatus(ctx, adminID, dto.BulkUpdateStatusDTO{
+			TravelRequestIds: []uuid.UUID{uuid.New()},
+			Status:           enums.TravelRequestStatusSolicited,
+		})
+
+		// Assert
+		assert.Nil(t, report)
+		assert.Equal(t, ErrInvalidBulkStatus, err)
+	})
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidApprovalMode    = errors.New("modo de aprovação inválido")
+	ErrGroupTravelersRequired = errors.New("a solicitação em grupo exige ao menos dois viajantes")
+	ErrGroupApprovedAsUnit    = errors.New("este grupo é aprovado em conjunto; altere o status pelo grupo")
+	ErrGroupNothingPending    = errors.New("não há solicitações pendentes neste grupo")
+	ErrInvalidGroupStatus     = errors.New("o status do grupo deve ser APPROVED ou CANCELED")
+)
+
+type TravelGroupUseCase interface {
+	CreateTravelGroup(ctx context.Context, userID uuid.UUID, input dto.CreateTravelGroupDTO) (*entity.TravelGroup, error)
+	GetTravelGroup(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelGroup, error)
+	UpdateTravelGroupStatus(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelGroupStatusDTO) (*dto.TravelGroupStatusResponseDTO, error)
+}
+
+// CreateTravelGroup cria uma solicitação por viajante com o mesmo roteiro, todas ligadas ao grupo.
+func (uc *TravelRequestUseCaseImpl) CreateTravelGroup(
+	ctx context.Context,
+	userID uuid.UUID,
+	input dto.CreateTravelGroupDTO,
+) (*entity.TravelGroup, error) {
+	if input.ApprovalMode == "" {
+		input.ApprovalMode = enums.GroupApprovalModeUnit
+	}
+
+	if !input.ApprovalMode.IsValid() {
+		return nil, ErrInvalidApprovalMode
+	}
+
+	destination, err := uc.resolveDestination(ctx, input.DestinationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if destination != nil {
+		input.DestinationName = destination.DisplayName()
+	}
+
+	if err := validateItinerary(input.DestinationName, input.DepartureDate, input.ReturnDate); err != nil {
+		return nil, err
+	}
+
+	input.DepartureTimezone, input.ReturnTimezone, err = uc.resolveTimezones(ctx, input.DepartureTimezone, input.ReturnTimezone, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	travelers, err := uc.travelerUseCase.ResolveForBooking(ctx, user, input.TravelerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(travelers) < 2 {
+		return nil, ErrGroupTravelersRequired
+	}
+
+	now := time.Now()
+	group := &entity.TravelGroup{
+		Id:                uuid.New(),
+		OrganizationId:    user.OrganizationId,
+		Name:              input.Name,
+		UserId:            user.Id,
+		ApprovalMode:      input.ApprovalMode,
+		DestinationName:   input.DestinationName,
+		DestinationId:     input.DestinationId,
+		DepartureDate:     input.DepartureDate,
+		ReturnDate:        input.ReturnDate,
+		DepartureTimezone: input.DepartureTimezone,
+		ReturnTimezone:    input.ReturnTimezone,
+		CreatedAt:         now,
+		UpdatedAt:         &now,
+	}
+
+	// Os membros são montados na transação, que mantém os viajantes bloqueados até a gravação.
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, traveler := range travelers {
+			member, err := uc.buildTravelRequest(ctx, user, dto.CreateTravelRequestDTO{
+				TravelerName:      traveler.Name,
+				DestinationName:   input.DestinationName,
+				DepartureDate:     input.DepartureDate,
+				ReturnDate:        input.ReturnDate,
+				DepartureTimezone: input.DepartureTimezone,
+				ReturnTimezone:    input.ReturnTimezone,
+				Department:        input.Department,
+				CostItems:         input.CostItems,
+				OverrideOverlap:   input.OverrideOverlap,
+				BusinessPurpose:   input.BusinessPurpose,
+				CostCenterId:      input.CostCenterId,
+				Allocations:       input.Allocations,
+			}, []entity.Traveler{traveler}, destination)
+			if err != nil {
+				return err
+			}
+
+			member.GroupId = &group.Id
+			group.Members = append(group.Members, *member)
+		}
+
+		if err := uc.travelGroupGateway.Create(ctx, group); err != nil {
+			return err
+		}
+
+		for i := range group.Members {
+			if err := uc.eventPublisher.PublishTravelEvent(ctx, enums.WebhookEventTravelCreated, &group.Members[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	group.Summarize()
+
+	return group, nil
+}
+
+func (uc *TravelRequestUseCaseImpl) GetTravelGroup(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelGroup, error) {
+	group, err := uc.travelGroupGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !group.IsVisibleTo(userID) {
+		user, err := uc.userGateway.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if user.Role != enums.UserTypeAdmin {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	group.Summarize()
+
+	return group, nil
+}
+
+// UpdateTravelGroupStatus aplica o status a todos os membros pendentes do grupo. Na aprovação
+// em conjunto o orçamento do total do grupo e as alterações de todos os membros ficam em uma
+// única transação, e o grupo muda por inteiro ou não muda; na aprovação por viajante cada
+// membro é verificado e aprovado na sua própria transação, a falha de um não impede os demais
+// e o resultado de cada um é devolvido como na alteração em lote.
+func (uc *TravelRequestUseCaseImpl) UpdateTravelGroupStatus(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	input dto.UpdateTravelGroupStatusDTO,
+) (*dto.TravelGroupStatusResponseDTO, error) {
+	if input.Status != enums.TravelRequestStatusApproved && input.Status != enums.TravelRequestStatusCanceled {
+		return nil, ErrInvalidGroupStatus
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := uc.travelGroupGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := group.PendingMembers()
+	if len(pending) == 0 {
+		return nil, ErrGroupNothingPending
+	}
+
+	for _, member := range pending {
+		if err := uc.authorizeStatusChange(user, member); err != nil {
+			return nil, err
+		}
+	}
+
+	approving := input.Status == enums.TravelRequestStatusApproved
+	results := make([]dto.BulkStatusItemDTO, 0, len(pending))
+
+	if group.ApprovesAsUnit() {
+		err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if approving {
+				if err := uc.checkGroupBudget(ctx, group, pending); err != nil {
+					return err
+				}
+			}
+
+			for _, member := range pending {
+				if err := uc.changeStatus(ctx, user, member, input.Status); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range pending {
+			results = append(results, dto.BulkStatusItemDTO{TravelRequestId: member.Id, Result: dto.BulkStatusResultSuccess})
+		}
+	} else {
+		for _, member := range pending {
+			item := dto.BulkStatusItemDTO{TravelRequestId: member.Id, Result: dto.BulkStatusResultSuccess}
+			original := *member
+
+			err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+				if approving {
+					if err := uc.costUseCase.CheckBudget(ctx, member); err != nil {
+						return err
+					}
+				}
+
+				return uc.changeStatus(ctx, user, member, input.Status)
+			})
+			if err != nil {
+				// O membro volta ao estado lido para que a contagem reflita o que foi gravado.
+				*member = original
+				item.Result, item.Error = bulkStatusResult(err), err.Error()
+			}
+
+			results = append(results, item)
+		}
+	}
+
+	group.Summarize()
+
+	return &dto.TravelGroupStatusResponseDTO{TravelGroup: *group, Results: results}, nil
+}
+
+// checkGroupBudget verifica o orçamento considerando a soma dos membros pendentes como uma
+// única solicitação, para que o grupo seja aprovado por inteiro ou não seja aprovado.
+func (uc *TravelRequestUseCaseImpl) checkGroupBudget(ctx context.Context, group *entity.TravelGroup, pending []*entity.TravelRequest) error {
+	combined := *pending[0]
+	combined.Id = group.Id
+	combined.EstimatedTotal = 0
+	for _, member := range pending {
+		combined.EstimatedTotal += member.EstimatedTotal
+	}
+	combined.EstimatedTotal = entity.RoundMoney(combined.EstimatedTotal)
+
+	return uc.costUseCase.CheckBudget(ctx, &combined)
+}
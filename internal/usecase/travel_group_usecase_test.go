@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelGroupGateway struct {
+	mock.Mock
+}
+
+func (m *MockTravelGroupGateway) Create(ctx context.Context, group *entity.TravelGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockTravelGroupGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelGroup, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelGroup), args.Error(1)
+}
+
+func TestTravelRequestUseCase_CreateTravelGroup(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, OrganizationId: uuid.New(), Name: "Assistant", Email: "assistant@example.com", Role: enums.UserTypeCommon}
+	departureDate := time.Now().AddDate(0, 1, 0)
+	returnDate := departureDate.AddDate(0, 0, 3)
+
+	travelers := []entity.Traveler{
+		{Id: uuid.New(), Name: "Maria Souza", Email: "maria@example.com", CreatedBy: userID},
+		{Id: uuid.New(), Name: "João Lima", Email: "joao@example.com", CreatedBy: userID},
+	}
+	travelerIDs := []uuid.UUID{travelers[0].Id, travelers[1].Id}
+
+	t.Run("should create one member request per traveler", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		mockTravelerUseCase := new(MockTravelerUseCase)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		costCenter := &entity.CostCenter{Id: uuid.New(), Code: "CC-200", Name: "Pessoas", Kind: enums.CostCenterKindCostCenter, Active: true}
+		input := dto.CreateTravelGroupDTO{
+			Name:            "Offsite Q4",
+			TravelerIds:     travelerIDs,
+			DestinationName: "Florianópolis",
+			DepartureDate:   departureDate,
+			ReturnDate:      &returnDate,
+			BusinessPurpose: "Planejamento anual",
+			CostCenterId:    &costCenter.Id,
+		}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelerUseCase.On("ResolveForBooking", ctx, user, travelerIDs).Return(travelers, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, mock.Anything, departureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil).Twice()
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, []dto.CostAllocationDTO(nil)).Return(costCenter, []entity.TravelCostAllocation{}, nil).Twice()
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockGroupGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelGroup")).Return(nil)
+
+		// Act
+		result, err := useCase.CreateTravelGroup(ctx, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.GroupApprovalModeUnit, result.ApprovalMode)
+		assert.Equal(t, user.OrganizationId, result.OrganizationId)
+		assert.Len(t, result.Members, 2)
+		for i, member := range result.Members {
+			assert.Equal(t, result.Id, *member.GroupId)
+			assert.Equal(t, result.OrganizationId, member.OrganizationId)
+			assert.Equal(t, travelers[i].Name, member.TravelerName)
+			assert.Equal(t, []uuid.UUID{travelers[i].Id}, member.TravelerIds())
+			assert.Equal(t, "Planejamento anual", member.BusinessPurpose)
+			assert.Equal(t, &costCenter.Id, member.CostCenterId)
+		}
+		assert.Equal(t, 2, result.StatusCounts[enums.TravelRequestStatusSolicited])
+		mockGroupGateway.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should require at least two travelers", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockTravelerUseCase := new(MockTravelerUseCase)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), mockTravelerUseCase, mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelGroupDTO{
+			Name:            "Offsite Q4",
+			TravelerIds:     []uuid.UUID{travelerIDs[0], travelerIDs[0]},
+			DestinationName: "Florianópolis",
+			DepartureDate:   departureDate,
+		}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelerUseCase.On("ResolveForBooking", ctx, user, input.TravelerIds).Return(travelers[:1], nil)
+
+		// Act
+		result, err := useCase.CreateTravelGroup(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrGroupTravelersRequired, err)
+		mockGroupGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject unknown approval modes", func(t *testing.T) {
+		// Arrange
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelGroupDTO{
+			Name:            "Offsite Q4",
+			ApprovalMode:    "MAJORITY",
+			TravelerIds:     travelerIDs,
+			DestinationName: "Florianópolis",
+			DepartureDate:   departureDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelGroup(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrInvalidApprovalMode, err)
+	})
+}
+
+// countingTransactor conta as transações externas; as aninhadas reaproveitam a do contexto.
+type countingTransactor struct {
+	outermost int
+}
+
+type countingTransactorKey struct{}
+
+func (c *countingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ctx.Value(countingTransactorKey{}) == nil {
+		c.outermost++
+		ctx = context.WithValue(ctx, countingTransactorKey{}, true)
+	}
+
+	return fn(ctx)
+}
+
+func TestTravelRequestUseCase_UpdateTravelGroupStatus(t *testing.T) {
+	ctx := context.Background()
+	requesterID := uuid.New()
+	adminID := uuid.New()
+	admin := &entity.User{Id: adminID, Name: "Admin", Role: enums.UserTypeAdmin}
+	department := "Engenharia"
+
+	newGroup := func(mode enums.GroupApprovalMode) *entity.TravelGroup {
+		group := &entity.TravelGroup{Id: uuid.New(), UserId: requesterID, ApprovalMode: mode}
+		for i := 0; i < 2; i++ {
+			group.Members = append(group.Members, entity.TravelRequest{
+				Id:             uuid.New(),
+				UserId:         requesterID,
+				Status:         enums.TravelRequestStatusSolicited,
+				Department:     &department,
+				EstimatedTotal: 1000.10,
+				DepartureDate:  time.Now().AddDate(0, 1, 0),
+				GroupId:        &group.Id,
+			})
+		}
+		return group
+	}
+
+	t.Run("should check the budget for the whole group when approving as a unit", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockCostUseCase := new(MockCostUseCase)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		group := newGroup(enums.GroupApprovalModeUnit)
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockGroupGateway.On("FindByID", ctx, group.Id).Return(group, nil)
+		mockCostUseCase.On("CheckBudget", ctx, mock.MatchedBy(func(travel *entity.TravelRequest) bool {
+			return travel.Id == group.Id && travel.EstimatedTotal == 2000.20
+		})).Return(nil).Once()
+		mockTravelGateway.On("UpdateStatus", ctx, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(true, nil).Twice()
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(nil).Twice()
+
+		// Act
+		result, err := useCase.UpdateTravelGroupStatus(ctx, group.Id, adminID, dto.UpdateTravelGroupStatusDTO{Status: enums.TravelRequestStatusApproved})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.StatusCounts[enums.TravelRequestStatusApproved])
+		for _, member := range result.Members {
+			assert.Equal(t, &adminID, member.ApprovedBy)
+		}
+		mockCostUseCase.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+		mockNotificationService.AssertExpectations(t)
+	})
+
+	t.Run("should leave every member pending when the group exceeds the budget", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		group := newGroup(enums.GroupApprovalModeUnit)
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockGroupGateway.On("FindByID", ctx, group.Id).Return(group, nil)
+		mockCostUseCase.On("CheckBudget", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(ErrBudgetExceeded)
+
+		// Act
+		result, err := useCase.UpdateTravelGroupStatus(ctx, group.Id, adminID, dto.UpdateTravelGroupStatusDTO{Status: enums.TravelRequestStatusApproved})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		mockTravelGateway.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should change a unit group in a single transaction and fail when a member changed meanwhile", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		transactor := &countingTransactor{}
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, transactor, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		group := newGroup(enums.GroupApprovalModeUnit)
+		first, second := group.Members[0].Id, group.Members[1].Id
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockGroupGateway.On("FindByID", ctx, group.Id).Return(group, nil)
+		mockTravelGateway.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == first }), enums.TravelRequestStatusSolicited).Return(true, nil)
+		mockTravelGateway.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == second }), enums.TravelRequestStatusSolicited).Return(false, nil)
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.Anything, enums.TravelRequestStatusSolicited).Return(nil)
+
+		// Act
+		result, err := useCase.UpdateTravelGroupStatus(ctx, group.Id, adminID, dto.UpdateTravelGroupStatusDTO{Status: enums.TravelRequestStatusCanceled})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrStatusChangedMeanwhile, err)
+		assert.Equal(t, 1, transactor.outermost)
+	})
+
+	t.Run("should reject statuses other than approved or canceled", func(t *testing.T) {
+		// Arrange
+		mockUserGateway := new(MockUserGateway)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		// Act
+		result, err := useCase.UpdateTravelGroupStatus(ctx, uuid.New(), adminID, dto.UpdateTravelGroupStatusDTO{Status: enums.TravelRequestStatusSolicited})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrInvalidGroupStatus, err)
+		mockGroupGateway.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should decide each member of a per-traveler group and report the ones that failed", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockCostUseCase := new(MockCostUseCase)
+		mockGroupGateway := new(MockTravelGroupGateway)
+		transactor := &countingTransactor{}
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, transactor, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), mockGroupGateway, new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		group := newGroup(enums.GroupApprovalModePerTraveler)
+		first, second := group.Members[0].Id, group.Members[1].Id
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockGroupGateway.On("FindByID", ctx, group.Id).Return(group, nil)
+		mockCostUseCase.On("CheckBudget", mock.Anything, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == first })).Return(ErrBudgetExceeded)
+		mockCostUseCase.On("CheckBudget", mock.Anything, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == second })).Return(nil)
+		mockTravelGateway.On("UpdateStatus", mock.Anything, mock.MatchedBy(func(travel *entity.TravelRequest) bool { return travel.Id == second }), enums.TravelRequestStatusSolicited).Return(true, nil).Once()
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(nil).Once()
+
+		// Act
+		result, err := useCase.UpdateTravelGroupStatus(ctx, group.Id, adminID, dto.UpdateTravelGroupStatusDTO{Status: enums.TravelRequestStatusApproved})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, transactor.outermost)
+		assert.Equal(t, []dto.BulkStatusItemDTO{
+			{TravelRequestId: first, Result: dto.BulkStatusResultFailed, Error: ErrBudgetExceeded.Error()},
+			{TravelRequestId: second, Result: dto.BulkStatusResultSuccess},
+		}, result.Results)
+		assert.Equal(t, 1, result.StatusCounts[enums.TravelRequestStatusSolicited])
+		assert.Equal(t, 1, result.StatusCounts[enums.TravelRequestStatusApproved])
+		assert.Nil(t, result.Members[0].ApprovedBy)
+		mockTravelGateway.AssertExpectations(t)
+		mockNotificationService.AssertExpectations(t)
+	})
+
+	t.Run("should refuse individual status changes on unit groups", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		group := newGroup(enums.GroupApprovalModeUnit)
+		member := group.Members[0]
+		member.Group = group
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, member.Id).Return(&member, nil)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: member.Id.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		})
+
+		// Assert
+		assert.Equal(t, ErrGroupApprovedAsUnit, err)
+		mockTravelGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
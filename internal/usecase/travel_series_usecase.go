@@ -0,0 +1,391 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTravelSeriesCanceled    = errors.New("a série de viagens está cancelada")
+	ErrInvalidSeriesHorizon    = errors.New("o horizonte de geração deve estar entre 1 e 365 dias")
+	ErrNotSeriesOccurrence     = errors.New("a solicitação não pertence a esta série")
+	ErrOccurrenceNotCancelable = errors.New("apenas ocorrências pendentes podem ser canceladas pela série")
+)
+
+const (
+	defaultSeriesHorizonDays = 30
+	maxSeriesHorizonDays     = 365
+
+	// maxOccurrencesPerRun limita o trabalho de uma execução; o restante fica para a próxima.
+	maxOccurrencesPerRun = 100
+)
+
+type TravelSeriesUseCase interface {
+	CreateTravelSeries(ctx context.Context, userID uuid.UUID, input dto.CreateTravelSeriesDTO) (*entity.TravelSeries, error)
+	UpdateTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelSeriesDTO) (*entity.TravelSeries, error)
+	GetTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelSeries, error)
+	ListTravelSeries(ctx context.Context, userID uuid.UUID) ([]entity.TravelSeries, error)
+	CancelTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	CancelOccurrence(ctx context.Context, id uuid.UUID, travelRequestID uuid.UUID, userID uuid.UUID) error
+	GenerateDueOccurrences(ctx context.Context, now time.Time) error
+}
+
+// CreateTravelSeries cadastra a série e já gera as ocorrências dentro do horizonte, na mesma
+// transação: se a geração falhar, a série não é criada. As demais ocorrências são criadas pela
+// execução periódica de GenerateDueOccurrences.
+func (uc *TravelRequestUseCaseImpl) CreateTravelSeries(ctx context.Context, userID uuid.UUID, input dto.CreateTravelSeriesDTO) (*entity.TravelSeries, error) {
+	template, err := uc.GetTravelTemplate(ctx, input.TemplateId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = template.DepartureTimezone
+	}
+
+	now := time.Now()
+	series := &entity.TravelSeries{
+		Id:             uuid.New(),
+		OrganizationId: template.OrganizationId,
+		UserId:         userID,
+		TemplateId:     template.Id,
+		Status:         enums.TravelSeriesStatusActive,
+		CreatedAt:      now,
+	}
+
+	if err := uc.applySeriesSchedule(ctx, series, input.RRule, input.StartsAt, timezone, input.HorizonDays); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.seriesGateway.Create(ctx, series); err != nil {
+			return err
+		}
+
+		return uc.generateOccurrences(ctx, series, template, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetTravelSeries(ctx, series.Id, userID)
+}
+
+// UpdateTravelSeries altera a série inteira: as ocorrências futuras ainda pendentes e não
+// editadas individualmente são canceladas e geradas de novo com a nova regra e o novo modelo.
+// Ocorrências aprovadas e exceções são mantidas. Tudo acontece em uma transação, para que uma
+// falha não deixe a série sem as ocorrências canceladas.
+func (uc *TravelRequestUseCaseImpl) UpdateTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelSeriesDTO) (*entity.TravelSeries, error) {
+	series, err := uc.findOwnedSeries(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !series.IsActive() {
+		return nil, ErrTravelSeriesCanceled
+	}
+
+	templateID := series.TemplateId
+	if input.TemplateId != nil {
+		templateID = *input.TemplateId
+	}
+
+	template, err := uc.GetTravelTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rrule, startsAt, timezone, horizonDays := series.RRule, series.StartsAt, series.Timezone, series.HorizonDays
+	if input.RRule != nil {
+		rrule = *input.RRule
+	}
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+	if input.Timezone != nil {
+		timezone = *input.Timezone
+	}
+	if input.HorizonDays != nil {
+		horizonDays = *input.HorizonDays
+	}
+
+	if err := uc.applySeriesSchedule(ctx, series, rrule, startsAt, timezone, horizonDays); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.cancelFutureOccurrences(ctx, user, series, now, true); err != nil {
+			return err
+		}
+
+		series.TemplateId = template.Id
+		series.GeneratedUntil = nil
+		series.UpdatedAt = &now
+
+		if err := uc.seriesGateway.Update(ctx, series); err != nil {
+			return err
+		}
+
+		return uc.generateOccurrences(ctx, series, template, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.GetTravelSeries(ctx, series.Id, userID)
+}
+
+// GetTravelSeries retorna a série, com as ocorrências já geradas, somente para o seu dono.
+func (uc *TravelRequestUseCaseImpl) GetTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelSeries, error) {
+	return uc.findOwnedSeries(ctx, id, userID)
+}
+
+func (uc *TravelRequestUseCaseImpl) ListTravelSeries(ctx context.Context, userID uuid.UUID) ([]entity.TravelSeries, error) {
+	return uc.seriesGateway.ListByUserID(ctx, userID)
+}
+
+// CancelTravelSeries encerra a série e cancela todas as ocorrências futuras ainda pendentes.
+// Ocorrências aprovadas seguem o fluxo normal de cancelamento.
+func (uc *TravelRequestUseCaseImpl) CancelTravelSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	series, err := uc.findOwnedSeries(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if !series.IsActive() {
+		return ErrTravelSeriesCanceled
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	series.Status = enums.TravelSeriesStatusCanceled
+	series.UpdatedAt = &now
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.seriesGateway.Update(ctx, series); err != nil {
+			return err
+		}
+
+		return uc.cancelFutureOccurrences(ctx, user, series, now, false)
+	})
+}
+
+// CancelOccurrence cancela uma única ocorrência pendente, que passa a ser uma exceção da série
+// e não é gerada de novo.
+func (uc *TravelRequestUseCaseImpl) CancelOccurrence(ctx context.Context, id uuid.UUID, travelRequestID uuid.UUID, userID uuid.UUID) error {
+	series, err := uc.findOwnedSeries(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	travel, err := uc.travelGateway.FindByID(ctx, travelRequestID)
+	if err != nil {
+		return err
+	}
+
+	if travel.SeriesId == nil || *travel.SeriesId != series.Id {
+		return ErrNotSeriesOccurrence
+	}
+
+	if travel.Status != enums.TravelRequestStatusSolicited {
+		return ErrOccurrenceNotCancelable
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	travel.SeriesException = true
+
+	return uc.changeStatus(ctx, user, travel, enums.TravelRequestStatusCanceled)
+}
+
+// GenerateDueOccurrences percorre as séries ativas e cria as ocorrências que entraram no
+// horizonte de geração. É executado periodicamente em segundo plano; a falha de uma série
+// não impede as demais.
+func (uc *TravelRequestUseCaseImpl) GenerateDueOccurrences(ctx context.Context, now time.Time) error {
+	seriesList, err := uc.seriesGateway.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range seriesList {
+		series := &seriesList[i]
+
+		template, err := uc.templateGateway.FindByID(ctx, series.TemplateId)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("série %s: %w", series.Id, err))
+			continue
+		}
+
+		if err := uc.generateOccurrences(ctx, series, template, now); err != nil {
+			errs = append(errs, fmt.Errorf("série %s: %w", series.Id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// generateOccurrences cria as ocorrências devidas da série e avança GeneratedUntil. Datas que
+// já têm ocorrência são puladas; ocorrências recusadas pelas validações de uma solicitação
+// (sobreposição, políticas bloqueantes) são registradas no log e também puladas.
+func (uc *TravelRequestUseCaseImpl) generateOccurrences(ctx context.Context, series *entity.TravelSeries, template *entity.TravelTemplate, now time.Time) error {
+	rule, err := entity.ParseRecurrenceRule(series.RRule)
+	if err != nil {
+		return err
+	}
+
+	occurrences, windowEnd := series.DueOccurrences(rule, now, maxOccurrencesPerRun)
+	if len(occurrences) == maxOccurrencesPerRun {
+		windowEnd = occurrences[len(occurrences)-1]
+	}
+
+	existing, err := uc.travelGateway.ListBySeriesID(ctx, series.Id)
+	if err != nil {
+		return err
+	}
+
+	scheduled := make(map[int64]bool, len(existing))
+	for _, travel := range existing {
+		if travel.OccurrenceDate != nil {
+			scheduled[travel.OccurrenceDate.Unix()] = true
+		}
+	}
+
+	for _, occurrence := range occurrences {
+		if scheduled[occurrence.Unix()] {
+			continue
+		}
+
+		input := uc.templateTravelInput(ctx, template, occurrence, nil, series.Timezone)
+		input.SeriesId = &series.Id
+		input.OccurrenceDate = &occurrence
+
+		if _, err := uc.CreateTravelRequest(ctx, series.UserId, input); err != nil {
+			if !isRejectedOccurrence(err) {
+				// Guarda o progresso até a ocorrência anterior para repetir esta na próxima execução.
+				previous := occurrence.Add(-time.Second)
+				if series.GeneratedUntil == nil || previous.After(*series.GeneratedUntil) {
+					series.GeneratedUntil = &previous
+					if updateErr := uc.seriesGateway.Update(ctx, series); updateErr != nil {
+						log.Printf("[RECURRENCE] Erro ao salvar progresso da série %s: %v", series.Id, updateErr)
+					}
+				}
+				return err
+			}
+
+			log.Printf("[RECURRENCE] Ocorrência de %s da série %s não gerada: %v", occurrence.Format(time.RFC3339), series.Id, err)
+		}
+	}
+
+	series.GeneratedUntil = &windowEnd
+
+	return uc.seriesGateway.Update(ctx, series)
+}
+
+// cancelFutureOccurrences cancela as ocorrências pendentes que ainda não partiram. Com
+// keepExceptions, as editadas individualmente são mantidas e as canceladas liberam a data
+// para a regeração da série.
+func (uc *TravelRequestUseCaseImpl) cancelFutureOccurrences(ctx context.Context, user *entity.User, series *entity.TravelSeries, now time.Time, keepExceptions bool) error {
+	occurrences, err := uc.travelGateway.ListBySeriesID(ctx, series.Id)
+	if err != nil {
+		return err
+	}
+
+	for i := range occurrences {
+		occurrence := &occurrences[i]
+
+		if occurrence.Status != enums.TravelRequestStatusSolicited || !occurrence.DepartureDate.After(now) {
+			continue
+		}
+
+		if keepExceptions {
+			if occurrence.SeriesException {
+				continue
+			}
+			occurrence.OccurrenceDate = nil
+		}
+
+		if err := uc.changeStatus(ctx, user, occurrence, enums.TravelRequestStatusCanceled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *TravelRequestUseCaseImpl) findOwnedSeries(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelSeries, error) {
+	series, err := uc.seriesGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if series.UserId != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return series, nil
+}
+
+func (uc *TravelRequestUseCaseImpl) applySeriesSchedule(ctx context.Context, series *entity.TravelSeries, rrule string, startsAt time.Time, timezone string, horizonDays int) error {
+	if _, err := entity.ParseRecurrenceRule(rrule); err != nil {
+		return err
+	}
+
+	if timezone == "" {
+		timezone = uc.defaultTimezoneFor(ctx)
+	}
+
+	if err := validateTimezones(timezone); err != nil {
+		return err
+	}
+
+	if horizonDays == 0 {
+		horizonDays = defaultSeriesHorizonDays
+	}
+
+	if horizonDays < 1 || horizonDays > maxSeriesHorizonDays {
+		return ErrInvalidSeriesHorizon
+	}
+
+	series.RRule = rrule
+	series.StartsAt = startsAt
+	series.Timezone = timezone
+	series.HorizonDays = horizonDays
+
+	return nil
+}
+
+// isRejectedOccurrence indica se a ocorrência foi recusada pelas regras de negócio de uma
+// solicitação, e não por uma falha que vale a pena tentar de novo.
+func isRejectedOccurrence(err error) bool {
+	var policyErr *PolicyViolationError
+
+	return errors.Is(err, ErrOverlappingTravel) ||
+		errors.As(err, &policyErr) ||
+		errors.Is(err, ErrFutureDatesOnly) ||
+		errors.Is(err, ErrInvalidDates) ||
+		errors.Is(err, ErrTravelerRequired) ||
+		errors.Is(err, ErrUnauthorized)
+}
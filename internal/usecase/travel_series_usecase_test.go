@@ -0,0 +1,331 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelSeriesGateway struct {
+	mock.Mock
+}
+
+func (m *MockTravelSeriesGateway) Create(ctx context.Context, series *entity.TravelSeries) error {
+	args := m.Called(ctx, series)
+	return args.Error(0)
+}
+
+func (m *MockTravelSeriesGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelSeries, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelSeries), args.Error(1)
+}
+
+func (m *MockTravelSeriesGateway) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelSeries, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entity.TravelSeries), args.Error(1)
+}
+
+func (m *MockTravelSeriesGateway) ListActive(ctx context.Context) ([]entity.TravelSeries, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.TravelSeries), args.Error(1)
+}
+
+func (m *MockTravelSeriesGateway) Update(ctx context.Context, series *entity.TravelSeries) error {
+	args := m.Called(ctx, series)
+	return args.Error(0)
+}
+
+func TestTravelSeriesUseCase_GenerateDueOccurrences(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, Name: "Test User", Role: enums.UserTypeCommon}
+	saoPaulo := entity.LoadTimezone("America/Sao_Paulo")
+
+	costCenter := &entity.CostCenter{Id: uuid.New(), Code: "CC-300", Name: "Vendas", Kind: enums.CostCenterKindCostCenter, Active: true}
+	template := &entity.TravelTemplate{
+		Id:              uuid.New(),
+		UserId:          userID,
+		Name:            "Visita semanal",
+		DestinationName: "Campinas",
+		TravelerName:    "John Doe",
+		DurationDays:    1,
+		BusinessPurpose: "Visita à filial",
+		CostCenterId:    &costCenter.Id,
+	}
+
+	sameDeparture := func(expected time.Time) interface{} {
+		return mock.MatchedBy(func(departure time.Time) bool { return departure.Equal(expected) })
+	}
+
+	t.Run("should create the due occurrences, skipping existing dates and rejected requests", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, mockSeriesGateway, "America/Sao_Paulo")
+
+		now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+		first := time.Date(2030, 1, 2, 8, 0, 0, 0, saoPaulo)
+		second := time.Date(2030, 1, 9, 8, 0, 0, 0, saoPaulo)
+		third := time.Date(2030, 1, 16, 8, 0, 0, 0, saoPaulo)
+
+		series := entity.TravelSeries{
+			Id:          uuid.New(),
+			UserId:      userID,
+			TemplateId:  template.Id,
+			RRule:       "FREQ=WEEKLY",
+			StartsAt:    first,
+			Timezone:    "America/Sao_Paulo",
+			HorizonDays: 21,
+			Status:      enums.TravelSeriesStatusActive,
+		}
+		existing := []entity.TravelRequest{{Id: uuid.New(), SeriesId: &series.Id, OccurrenceDate: &first}}
+
+		mockSeriesGateway.On("ListActive", ctx).Return([]entity.TravelSeries{series}, nil)
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+		mockTravelGateway.On("ListBySeriesID", ctx, series.Id).Return(existing, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, sameDeparture(second), mock.Anything, (*uuid.UUID)(nil)).Return([]uuid.UUID{uuid.New()}, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, sameDeparture(third), mock.Anything, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, []dto.CostAllocationDTO(nil)).Return(costCenter, []entity.TravelCostAllocation{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockSeriesGateway.On("Update", ctx, mock.AnythingOfType("*entity.TravelSeries")).Return(nil)
+
+		// Act
+		err := useCase.GenerateDueOccurrences(ctx, now)
+
+		// Assert
+		assert.NoError(t, err)
+		mockTravelGateway.AssertNumberOfCalls(t, "Create", 1)
+
+		created := mockTravelGateway.Calls[len(mockTravelGateway.Calls)-1].Arguments.Get(1).(*entity.TravelRequest)
+		assert.Equal(t, series.Id, *created.SeriesId)
+		assert.True(t, third.Equal(*created.OccurrenceDate))
+		assert.True(t, third.AddDate(0, 0, 1).Equal(*created.ReturnDate))
+		assert.Equal(t, "America/Sao_Paulo", created.DepartureTimezone)
+		assert.Equal(t, "Visita à filial", created.BusinessPurpose)
+		assert.Equal(t, &costCenter.Id, created.CostCenterId)
+
+		updated := mockSeriesGateway.Calls[len(mockSeriesGateway.Calls)-1].Arguments.Get(1).(*entity.TravelSeries)
+		assert.True(t, now.AddDate(0, 0, 21).Equal(*updated.GeneratedUntil))
+	})
+}
+
+func TestTravelSeriesUseCase(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, Name: "Test User", Role: enums.UserTypeCommon}
+
+	future := time.Now().AddDate(0, 1, 0)
+	past := time.Now().AddDate(0, -1, 0)
+
+	newSeries := func() *entity.TravelSeries {
+		return &entity.TravelSeries{
+			Id:          uuid.New(),
+			UserId:      userID,
+			TemplateId:  uuid.New(),
+			RRule:       "FREQ=MONTHLY;COUNT=1",
+			StartsAt:    past,
+			Timezone:    "America/Sao_Paulo",
+			HorizonDays: 30,
+			Status:      enums.TravelSeriesStatusActive,
+		}
+	}
+
+	t.Run("should reject an invalid recurrence rule", func(t *testing.T) {
+		// Arrange
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, new(MockTravelSeriesGateway), "America/Sao_Paulo")
+		template := &entity.TravelTemplate{Id: uuid.New(), UserId: userID}
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+
+		// Act
+		result, err := useCase.CreateTravelSeries(ctx, userID, dto.CreateTravelSeriesDTO{
+			TemplateId: template.Id,
+			RRule:      "FREQ=HOURLY",
+			StartsAt:   future,
+		})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, entity.ErrInvalidRecurrenceRule)
+	})
+
+	t.Run("should fail the creation when the occurrences cannot be generated", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, mockSeriesGateway, "America/Sao_Paulo")
+		template := &entity.TravelTemplate{Id: uuid.New(), UserId: userID}
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+		mockSeriesGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelSeries")).Return(nil)
+		mockTravelGateway.On("ListBySeriesID", ctx, mock.Anything).Return([]entity.TravelRequest(nil), errors.New("conexão perdida"))
+
+		// Act
+		result, err := useCase.CreateTravelSeries(ctx, userID, dto.CreateTravelSeriesDTO{
+			TemplateId: template.Id,
+			RRule:      "FREQ=MONTHLY;COUNT=1",
+			StartsAt:   future,
+			Timezone:   "America/Sao_Paulo",
+		})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "conexão perdida")
+		mockSeriesGateway.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should cancel a single occurrence and keep it as an exception", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), mockSeriesGateway, "America/Sao_Paulo")
+
+		series := newSeries()
+		occurrence := &entity.TravelRequest{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, OccurrenceDate: &future, DepartureDate: future, Status: enums.TravelRequestStatusSolicited}
+
+		mockSeriesGateway.On("FindByID", ctx, series.Id).Return(series, nil)
+		mockTravelGateway.On("FindByID", ctx, occurrence.Id).Return(occurrence, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, occurrence, enums.TravelRequestStatusSolicited).Return(true, nil)
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, occurrence, enums.TravelRequestStatusSolicited).Return(nil)
+
+		// Act
+		err := useCase.CancelOccurrence(ctx, series.Id, occurrence.Id, userID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.TravelRequestStatusCanceled, occurrence.Status)
+		assert.True(t, occurrence.SeriesException)
+		assert.Equal(t, userID, *occurrence.CanceledBy)
+	})
+
+	t.Run("should not cancel a request from another series", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), mockSeriesGateway, "America/Sao_Paulo")
+
+		series := newSeries()
+		otherSeriesID := uuid.New()
+		travel := &entity.TravelRequest{Id: uuid.New(), UserId: userID, SeriesId: &otherSeriesID, Status: enums.TravelRequestStatusSolicited}
+
+		mockSeriesGateway.On("FindByID", ctx, series.Id).Return(series, nil)
+		mockTravelGateway.On("FindByID", ctx, travel.Id).Return(travel, nil)
+
+		// Act
+		err := useCase.CancelOccurrence(ctx, series.Id, travel.Id, userID)
+
+		// Assert
+		assert.Equal(t, ErrNotSeriesOccurrence, err)
+		mockTravelGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should cancel pending occurrences and keep approved ones when canceling the series", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), mockSeriesGateway, "America/Sao_Paulo")
+
+		series := newSeries()
+		occurrences := []entity.TravelRequest{
+			{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, DepartureDate: future, Status: enums.TravelRequestStatusSolicited},
+			{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, DepartureDate: future, Status: enums.TravelRequestStatusApproved},
+			{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, DepartureDate: past, Status: enums.TravelRequestStatusSolicited},
+		}
+
+		mockSeriesGateway.On("FindByID", ctx, series.Id).Return(series, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockSeriesGateway.On("Update", ctx, series).Return(nil)
+		mockTravelGateway.On("ListBySeriesID", ctx, series.Id).Return(occurrences, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(true, nil)
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.Anything, enums.TravelRequestStatusSolicited).Return(nil)
+
+		// Act
+		err := useCase.CancelTravelSeries(ctx, series.Id, userID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.TravelSeriesStatusCanceled, series.Status)
+		mockTravelGateway.AssertNumberOfCalls(t, "UpdateStatus", 1)
+		assert.Equal(t, enums.TravelRequestStatusCanceled, occurrences[0].Status)
+		assert.Equal(t, enums.TravelRequestStatusApproved, occurrences[1].Status)
+		assert.Equal(t, enums.TravelRequestStatusSolicited, occurrences[2].Status)
+	})
+
+	t.Run("should regenerate only pending occurrences that were not edited when the series changes", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, mockSeriesGateway, "America/Sao_Paulo")
+
+		series := newSeries()
+		template := &entity.TravelTemplate{Id: series.TemplateId, UserId: userID, DestinationName: "Campinas", TravelerName: "John Doe"}
+		edited := future.AddDate(0, 0, 1)
+		occurrences := []entity.TravelRequest{
+			{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, OccurrenceDate: &future, DepartureDate: future, Status: enums.TravelRequestStatusSolicited},
+			{Id: uuid.New(), UserId: userID, SeriesId: &series.Id, OccurrenceDate: &edited, DepartureDate: edited, Status: enums.TravelRequestStatusSolicited, SeriesException: true},
+		}
+		horizonDays := 7
+
+		mockSeriesGateway.On("FindByID", ctx, series.Id).Return(series, nil)
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelGateway.On("ListBySeriesID", ctx, series.Id).Return(occurrences, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(true, nil)
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.Anything, enums.TravelRequestStatusSolicited).Return(nil)
+		mockSeriesGateway.On("Update", ctx, series).Return(nil)
+
+		// Act
+		result, err := useCase.UpdateTravelSeries(ctx, series.Id, userID, dto.UpdateTravelSeriesDTO{HorizonDays: &horizonDays})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 7, result.HorizonDays)
+		mockTravelGateway.AssertNumberOfCalls(t, "UpdateStatus", 1)
+		assert.Equal(t, enums.TravelRequestStatusCanceled, occurrences[0].Status)
+		assert.Nil(t, occurrences[0].OccurrenceDate)
+		assert.Equal(t, enums.TravelRequestStatusSolicited, occurrences[1].Status)
+		assert.NotNil(t, occurrences[1].OccurrenceDate)
+	})
+
+	t.Run("should not change a series owned by someone else", func(t *testing.T) {
+		// Arrange
+		mockSeriesGateway := new(MockTravelSeriesGateway)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), mockSeriesGateway, "America/Sao_Paulo")
+		series := newSeries()
+		mockSeriesGateway.On("FindByID", ctx, series.Id).Return(series, nil)
+
+		// Act
+		err := useCase.CancelTravelSeries(ctx, series.Id, uuid.New())
+
+		// Assert
+		assert.Equal(t, ErrUnauthorized, err)
+		mockSeriesGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTemplate       = errors.New("nome e destino do modelo são obrigatórios")
+	ErrCloneShiftRequired    = errors.New("informe a nova data de ida ou o deslocamento em dias")
+	ErrCloneShiftNotPositive = errors.New("o deslocamento deve mover a viagem para uma data posterior")
+)
+
+type TravelTemplateUseCase interface {
+	CreateTravelTemplate(ctx context.Context, userID uuid.UUID, input dto.SaveTravelTemplateDTO) (*entity.TravelTemplate, error)
+	UpdateTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.SaveTravelTemplateDTO) (*entity.TravelTemplate, error)
+	GetTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelTemplate, error)
+	ListTravelTemplates(ctx context.Context, userID uuid.UUID) ([]entity.TravelTemplate, error)
+	DeleteTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) error
+	InstantiateTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.InstantiateTravelTemplateDTO) (*entity.TravelRequest, error)
+	CloneTravelRequest(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.CloneTravelRequestDTO) (*entity.TravelRequest, error)
+}
+
+func (uc *TravelRequestUseCaseImpl) CreateTravelTemplate(ctx context.Context, userID uuid.UUID, input dto.SaveTravelTemplateDTO) (*entity.TravelTemplate, error) {
+	now := time.Now()
+	template := &entity.TravelTemplate{
+		Id:        uuid.New(),
+		UserId:    userID,
+		CreatedAt: now,
+	}
+
+	if err := uc.applyTemplateInput(ctx, template, userID, input); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	template.OrganizationId = user.OrganizationId
+
+	if err := uc.templateGateway.Create(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (uc *TravelRequestUseCaseImpl) UpdateTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.SaveTravelTemplateDTO) (*entity.TravelTemplate, error) {
+	template, err := uc.GetTravelTemplate(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyTemplateInput(ctx, template, userID, input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template.UpdatedAt = &now
+
+	if err := uc.templateGateway.Update(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// GetTravelTemplate retorna o modelo somente para o seu dono.
+func (uc *TravelRequestUseCaseImpl) GetTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelTemplate, error) {
+	template, err := uc.templateGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if template.UserId != userID {
+		return nil, ErrUnauthorized
+	}
+
+	return template, nil
+}
+
+func (uc *TravelRequestUseCaseImpl) ListTravelTemplates(ctx context.Context, userID uuid.UUID) ([]entity.TravelTemplate, error) {
+	return uc.templateGateway.ListByUserID(ctx, userID)
+}
+
+func (uc *TravelRequestUseCaseImpl) DeleteTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID) error {
+	template, err := uc.GetTravelTemplate(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return uc.templateGateway.Delete(ctx, template)
+}
+
+// InstantiateTravelTemplate cria uma nova solicitação com o roteiro do modelo. Sem data de volta,
+// a volta é a ida somada à duração do modelo.
+func (uc *TravelRequestUseCaseImpl) InstantiateTravelTemplate(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.InstantiateTravelTemplateDTO) (*entity.TravelRequest, error) {
+	template, err := uc.GetTravelTemplate(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := uc.templateTravelInput(ctx, template, input.DepartureDate, input.ReturnDate, template.DepartureTimezone)
+	request.OverrideOverlap = input.OverrideOverlap
+
+	return uc.CreateTravelRequest(ctx, userID, request)
+}
+
+// templateTravelInput monta a nova solicitação com o roteiro do modelo. Sem data de volta,
+// a volta é a ida somada à duração do modelo.
+func (uc *TravelRequestUseCaseImpl) templateTravelInput(
+	ctx context.Context,
+	template *entity.TravelTemplate,
+	departureDate time.Time,
+	returnDate *time.Time,
+	departureTimezone string,
+) dto.CreateTravelRequestDTO {
+	if returnDate == nil {
+		timezone := departureTimezone
+		if timezone == "" {
+			timezone = uc.defaultTimezoneFor(ctx)
+		}
+		returnDate = template.ReturnDateFor(departureDate, timezone)
+	}
+
+	costItems := make([]dto.CostItemDTO, 0, len(template.CostItems))
+	for _, item := range template.CostItems {
+		costItems = append(costItems, dto.CostItemDTO{
+			Category:    item.Category,
+			Description: item.Description,
+			Amount:      item.Amount,
+			Currency:    item.Currency,
+		})
+	}
+
+	return dto.CreateTravelRequestDTO{
+		TravelerName:      template.TravelerName,
+		TravelerIds:       template.TravelerIds(),
+		DestinationName:   template.DestinationName,
+		DestinationId:     template.DestinationId,
+		DepartureDate:     departureDate,
+		ReturnDate:        returnDate,
+		DepartureTimezone: departureTimezone,
+		Department:        template.Department,
+		CostItems:         costItems,
+		BusinessPurpose:   template.BusinessPurpose,
+		CostCenterId:      template.CostCenterId,
+	}
+}
+
+// CloneTravelRequest cria um rascunho com o mesmo roteiro, viajantes e custos de uma solicitação
+// existente, deslocando as datas. O rascunho pode ser editado e só vai para aprovação, com a
+// verificação de sobreposição e o evento travel.created, quando o usuário o envia.
+func (uc *TravelRequestUseCaseImpl) CloneTravelRequest(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.CloneTravelRequestDTO) (*entity.TravelRequest, error) {
+	source, err := uc.travelGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !source.IsVisibleTo(userID) {
+		return nil, ErrUnauthorized
+	}
+
+	departureDate, returnDate, err := shiftItinerary(source, input)
+	if err != nil {
+		return nil, err
+	}
+
+	costItems := make([]dto.CostItemDTO, 0, len(source.CostItems))
+	for _, item := range source.CostItems {
+		costItems = append(costItems, dto.CostItemDTO{
+			Category:    item.Category,
+			Description: item.Description,
+			Amount:      item.Amount,
+			Currency:    item.Currency,
+		})
+	}
+
+	return uc.CreateTravelRequest(ctx, userID, dto.CreateTravelRequestDTO{
+		TravelerName:      source.TravelerName,
+		TravelerIds:       source.TravelerIds(),
+		DestinationName:   source.DestinationName,
+		DestinationId:     source.DestinationId,
+		DepartureDate:     departureDate,
+		ReturnDate:        returnDate,
+		DepartureTimezone: source.DepartureTimezone,
+		ReturnTimezone:    source.ReturnTimezone,
+		Department:        source.Department,
+		CostItems:         costItems,
+		BusinessPurpose:   source.BusinessPurpose,
+		CostCenterId:      source.CostCenterId,
+		Allocations:       allocationDTOs(source.Allocations),
+		Draft:             true,
+	})
+}
+
+// shiftItinerary move ida e volta pelo mesmo intervalo. O deslocamento em dias é aplicado
+// no calendário local de cada trecho, preservando o horário mesmo com horário de verão.
+func shiftItinerary(source *entity.TravelRequest, input dto.CloneTravelRequestDTO) (time.Time, *time.Time, error) {
+	switch {
+	case input.DepartureDate != nil:
+		shift := input.DepartureDate.Sub(source.DepartureDate)
+
+		var returnDate *time.Time
+		if source.ReturnDate != nil {
+			shifted := source.ReturnDate.Add(shift)
+			returnDate = &shifted
+		}
+
+		return *input.DepartureDate, returnDate, nil
+	case input.ShiftDays != nil:
+		if *input.ShiftDays <= 0 {
+			return time.Time{}, nil, ErrCloneShiftNotPositive
+		}
+
+		departureDate := source.LocalDepartureDate().AddDate(0, 0, *input.ShiftDays)
+
+		var returnDate *time.Time
+		if localReturn := source.LocalReturnDate(); localReturn != nil {
+			shifted := localReturn.AddDate(0, 0, *input.ShiftDays)
+			returnDate = &shifted
+		}
+
+		return departureDate, returnDate, nil
+	}
+
+	return time.Time{}, nil, ErrCloneShiftRequired
+}
+
+func (uc *TravelRequestUseCaseImpl) applyTemplateInput(ctx context.Context, template *entity.TravelTemplate, userID uuid.UUID, input dto.SaveTravelTemplateDTO) error {
+	destination, err := uc.resolveDestination(ctx, input.DestinationId)
+	if err != nil {
+		return err
+	}
+
+	if destination != nil {
+		input.DestinationName = destination.DisplayName()
+	}
+
+	if strings.TrimSpace(input.Name) == "" || strings.TrimSpace(input.DestinationName) == "" {
+		return ErrInvalidTemplate
+	}
+
+	if len(input.TravelerIds) == 0 && strings.TrimSpace(input.TravelerName) == "" {
+		return ErrTravelerRequired
+	}
+
+	if input.DepartureTimezone != "" {
+		if err := validateTimezones(input.DepartureTimezone); err != nil {
+			return err
+		}
+	}
+
+	var travelers []entity.Traveler
+	if len(input.TravelerIds) > 0 {
+		user, err := uc.userGateway.FindByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		travelers, err = uc.travelerUseCase.ResolveForBooking(ctx, user, input.TravelerIds)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Os valores ficam na moeda original; a estimativa só confirma que há câmbio para cada moeda.
+	if len(input.CostItems) > 0 {
+		if _, err := uc.costUseCase.EstimateCosts(ctx, input.CostItems); err != nil {
+			return err
+		}
+	}
+
+	// O centro de custo é opcional no modelo, mas quando informado precisa estar ativo.
+	if input.CostCenterId != nil {
+		if _, _, err := uc.costUseCase.ResolveCostCenter(ctx, input.CostCenterId, nil); err != nil {
+			return err
+		}
+	}
+
+	costItems := make([]entity.TravelTemplateCostItem, 0, len(input.CostItems))
+	for _, item := range input.CostItems {
+		costItems = append(costItems, entity.TravelTemplateCostItem{
+			Id:          uuid.New(),
+			TemplateId:  template.Id,
+			Category:    item.Category,
+			Description: item.Description,
+			Amount:      item.Amount,
+			Currency:    strings.ToUpper(item.Currency),
+		})
+	}
+
+	template.Name = strings.TrimSpace(input.Name)
+	template.DestinationName = input.DestinationName
+	template.DestinationId = nil
+	if destination != nil {
+		template.DestinationId = &destination.Id
+	}
+	template.TravelerName = input.TravelerName
+	template.DurationDays = input.DurationDays
+	template.Department = input.Department
+	template.DepartureTimezone = input.DepartureTimezone
+	template.BusinessPurpose = strings.TrimSpace(input.BusinessPurpose)
+	template.CostCenterId = input.CostCenterId
+	template.Travelers = travelers
+	template.CostItems = costItems
+
+	return nil
+}
@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelTemplateGateway struct {
+	mock.Mock
+}
+
+func (m *MockTravelTemplateGateway) Create(ctx context.Context, template *entity.TravelTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockTravelTemplateGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.TravelTemplate, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.TravelTemplate), args.Error(1)
+}
+
+func (m *MockTravelTemplateGateway) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.TravelTemplate, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entity.TravelTemplate), args.Error(1)
+}
+
+func (m *MockTravelTemplateGateway) Update(ctx context.Context, template *entity.TravelTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockTravelTemplateGateway) Delete(ctx context.Context, template *entity.TravelTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func TestTravelTemplateUseCase_CloneTravelRequest(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, Name: "Test User", Role: enums.UserTypeCommon}
+
+	saoPaulo := entity.LoadTimezone("America/Sao_Paulo")
+	departureDate := time.Date(2030, 3, 4, 8, 0, 0, 0, saoPaulo)
+	returnDate := time.Date(2030, 3, 6, 19, 0, 0, 0, saoPaulo)
+
+	costCenter := &entity.CostCenter{Id: uuid.New(), Code: "CC-100", Name: "Comercial", Kind: enums.CostCenterKindCostCenter, Active: true}
+	project := &entity.CostCenter{Id: uuid.New(), Code: "PRJ-7", Name: "Projeto Atlas", Kind: enums.CostCenterKindProject, Active: true}
+
+	source := &entity.TravelRequest{
+		Id:                uuid.New(),
+		UserId:            userID,
+		TravelerName:      "John Doe",
+		DestinationName:   "Rio de Janeiro",
+		DepartureDate:     departureDate,
+		ReturnDate:        &returnDate,
+		DepartureTimezone: "America/Sao_Paulo",
+		ReturnTimezone:    "America/Sao_Paulo",
+		Status:            enums.TravelRequestStatusApproved,
+		BusinessPurpose:   "Reunião trimestral",
+		CostCenterId:      &costCenter.Id,
+		CostCenter:        costCenter,
+		Allocations: []entity.TravelCostAllocation{
+			{Id: uuid.New(), CostCenterId: costCenter.Id, Percentage: 60, Amount: 540},
+			{Id: uuid.New(), CostCenterId: project.Id, Percentage: 40, Amount: 360},
+		},
+		CostItems: []entity.TravelCostItem{
+			{Id: uuid.New(), Category: enums.CostCategoryAirfare, Description: "Ponte aérea", Amount: 900, Currency: "BRL", BaseAmount: 900},
+		},
+	}
+
+	t.Run("should create a draft shifted by whole local days without submitting it", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		events := &recordingTravelEvents{}
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, events, mockCostUseCase, mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		shiftDays := 28
+		expectedDeparture := time.Date(2030, 4, 1, 8, 0, 0, 0, saoPaulo)
+		expectedReturn := time.Date(2030, 4, 3, 19, 0, 0, 0, saoPaulo)
+		expectedItems := []dto.CostItemDTO{{Category: enums.CostCategoryAirfare, Description: "Ponte aérea", Amount: 900, Currency: "BRL"}}
+
+		mockTravelGateway.On("FindByID", ctx, source.Id).Return(source, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockCostUseCase.On("EstimateCosts", ctx, expectedItems).Return([]entity.TravelCostItem{{Id: uuid.New(), Category: enums.CostCategoryAirfare, Amount: 900, Currency: "BRL", BaseAmount: 900}}, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, []dto.CostAllocationDTO{
+			{CostCenterId: costCenter.Id, Percentage: 60},
+			{CostCenterId: project.Id, Percentage: 40},
+		}).Return(costCenter, []entity.TravelCostAllocation{
+			{CostCenterId: costCenter.Id, Percentage: 60, CostCenter: costCenter},
+			{CostCenterId: project.Id, Percentage: 40, CostCenter: project},
+		}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+
+		// Act
+		result, err := useCase.CloneTravelRequest(ctx, source.Id, userID, dto.CloneTravelRequestDTO{ShiftDays: &shiftDays})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEqual(t, source.Id, result.Id)
+		assert.Equal(t, enums.TravelRequestStatusDraft, result.Status)
+		assert.True(t, expectedDeparture.Equal(result.DepartureDate))
+		assert.True(t, expectedReturn.Equal(*result.ReturnDate))
+		assert.Equal(t, "Rio de Janeiro", result.DestinationName)
+		assert.Equal(t, 900.0, result.EstimatedTotal)
+		assert.Equal(t, "Reunião trimestral", result.BusinessPurpose)
+		assert.Len(t, result.Allocations, 2)
+		assert.Equal(t, 360.0, result.Allocations[1].Amount)
+		assert.Empty(t, events.events)
+		mockTravelGateway.AssertNotCalled(t, "FindOverlapping", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockTravelGateway.AssertExpectations(t)
+		mockCostUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should require a new date or a shift", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+		mockTravelGateway.On("FindByID", ctx, source.Id).Return(source, nil)
+
+		// Act
+		result, err := useCase.CloneTravelRequest(ctx, source.Id, userID, dto.CloneTravelRequestDTO{})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrCloneShiftRequired, err)
+	})
+
+	t.Run("should not clone requests the user cannot see", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+		mockTravelGateway.On("FindByID", ctx, source.Id).Return(source, nil)
+		shiftDays := 7
+
+		// Act
+		result, err := useCase.CloneTravelRequest(ctx, source.Id, uuid.New(), dto.CloneTravelRequestDTO{ShiftDays: &shiftDays})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
+func TestTravelTemplateUseCase(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, OrganizationId: uuid.New(), Name: "Test User", Role: enums.UserTypeCommon}
+
+	t.Run("should save cost items in their original currency", func(t *testing.T) {
+		// Arrange
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.SaveTravelTemplateDTO{
+			Name:            "Visita mensal ao cliente",
+			DestinationName: "Rio de Janeiro",
+			TravelerName:    "John Doe",
+			DurationDays:    2,
+			CostItems:       []dto.CostItemDTO{{Category: enums.CostCategoryLodging, Amount: 200, Currency: "usd"}},
+		}
+		mockCostUseCase.On("EstimateCosts", ctx, input.CostItems).Return([]entity.TravelCostItem{}, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTemplateGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelTemplate")).Return(nil)
+
+		// Act
+		template, err := useCase.CreateTravelTemplate(ctx, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, userID, template.UserId)
+		assert.Equal(t, user.OrganizationId, template.OrganizationId)
+		assert.Len(t, template.CostItems, 1)
+		assert.Equal(t, "USD", template.CostItems[0].Currency)
+		assert.Equal(t, 200.0, template.CostItems[0].Amount)
+		assert.Equal(t, template.Id, template.CostItems[0].TemplateId)
+	})
+
+	t.Run("should require name and destination", func(t *testing.T) {
+		// Arrange
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		// Act
+		template, err := useCase.CreateTravelTemplate(ctx, userID, dto.SaveTravelTemplateDTO{Name: "Sem destino", TravelerName: "John Doe"})
+
+		// Assert
+		assert.Nil(t, template)
+		assert.Equal(t, ErrInvalidTemplate, err)
+	})
+
+	t.Run("should instantiate using the template duration", func(t *testing.T) {
+		// Arrange
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		costCenter := &entity.CostCenter{Id: uuid.New(), Code: "CC-100", Name: "Comercial", Kind: enums.CostCenterKindCostCenter, Active: true}
+		template := &entity.TravelTemplate{
+			Id:              uuid.New(),
+			UserId:          userID,
+			Name:            "Visita mensal ao cliente",
+			DestinationName: "Rio de Janeiro",
+			TravelerName:    "John Doe",
+			DurationDays:    3,
+			BusinessPurpose: "Acompanhamento de contrato",
+			CostCenterId:    &costCenter.Id,
+		}
+		departureDate := time.Now().AddDate(0, 1, 0).Truncate(time.Second)
+		expectedReturn := departureDate.In(entity.LoadTimezone("America/Sao_Paulo")).AddDate(0, 0, 3)
+
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, departureDate, &expectedReturn, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, []dto.CostAllocationDTO(nil)).Return(costCenter, []entity.TravelCostAllocation{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+
+		// Act
+		result, err := useCase.InstantiateTravelTemplate(ctx, template.Id, userID, dto.InstantiateTravelTemplateDTO{DepartureDate: departureDate})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Rio de Janeiro", result.DestinationName)
+		assert.True(t, expectedReturn.Equal(*result.ReturnDate))
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should keep templates private to their owner", func(t *testing.T) {
+		// Arrange
+		mockTemplateGateway := new(MockTravelTemplateGateway)
+		useCase := NewTravelRequestUseCase(new(MockTravelGateway), new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), mockTemplateGateway, new(MockTravelSeriesGateway), "America/Sao_Paulo")
+		template := &entity.TravelTemplate{Id: uuid.New(), UserId: uuid.New()}
+		mockTemplateGateway.On("FindByID", ctx, template.Id).Return(template, nil)
+
+		// Act
+		result, err := useCase.GetTravelTemplate(ctx, template.Id, userID)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
@@ -4,55 +4,111 @@ import (
 	"challenge-travel-api/internal/domain/entity"
 	"challenge-travel-api/internal/domain/enums"
 	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/domain/tenant"
 	"challenge-travel-api/internal/interface/dto"
 	"challenge-travel-api/internal/utils"
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrInvalidDates          = errors.New("data de ida deve ser anterior à data de volta")
-	ErrFutureDatesOnly       = errors.New("as datas devem ser futuras")
-	ErrInvalidDestination    = errors.New("destino é obrigatório")
-	ErrUnauthorized          = errors.New("usuário não autorizado para esta operação")
-	ErrTravelAlreadyApproved = errors.New("Viajem já aprovada")
+	ErrInvalidDates            = errors.New("data de ida deve ser anterior à data de volta")
+	ErrFutureDatesOnly         = errors.New("as datas devem ser futuras")
+	ErrInvalidDestination      = errors.New("destino é obrigatório")
+	ErrUnauthorized            = errors.New("usuário não autorizado para esta operação")
+	ErrOverlappingTravel       = errors.New("o viajante já possui uma solicitação no mesmo período")
+	ErrInvalidTimezone         = errors.New("fuso horário inválido")
+	ErrInvalidStatusFilter     = errors.New("status inválido no filtro")
+	ErrBusinessPurposeRequired = errors.New("o motivo da viagem é obrigatório")
+	ErrTravelNotDraft          = errors.New("somente rascunhos podem ser enviados para aprovação")
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// OverlappingTravelError identifica as solicitações que conflitam com o período informado.
+type OverlappingTravelError struct {
+	ConflictingIds []uuid.UUID
+}
+
+func (e *OverlappingTravelError) Error() string {
+	ids := make([]string, 0, len(e.ConflictingIds))
+	for _, id := range e.ConflictingIds {
+		ids = append(ids, id.String())
+	}
+
+	return fmt.Sprintf("%s: %s", ErrOverlappingTravel.Error(), strings.Join(ids, ", "))
+}
+
+func (e *OverlappingTravelError) Unwrap() error {
+	return ErrOverlappingTravel
+}
+
 type TravelUseCase interface {
 	CreateTravelRequest(ctx context.Context, userID uuid.UUID, input dto.CreateTravelRequestDTO) (*entity.TravelRequest, error)
 	UpdateTravelRequest(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelRequestDTO) (*entity.TravelRequest, error)
+	SubmitTravelRequest(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.SubmitTravelRequestDTO) (*entity.TravelRequest, error)
 	UpdateStatusTravelRequest(ctx context.Context, userId string, input dto.UpdateStatusTravelRequestDTO) error
+	BulkUpdateStatus(ctx context.Context, userID uuid.UUID, input dto.BulkUpdateStatusDTO) (*dto.BulkUpdateStatusResponseDTO, error)
 	GetByID(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelRequest, error)
-	ListTravelRequests(
-		ctx context.Context,
-		userID uuid.UUID,
-		status *enums.TravelRequestStatus,
-		startDate *time.Time,
-		endDate *time.Time,
-		destinationName *string,
-		page int,
-		pageSize int,
-	) ([]entity.TravelRequest, error)
+	ListTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error)
+	ListApproverInbox(ctx context.Context, userID uuid.UUID, page int, pageSize int) (*entity.ApproverInbox, error)
+	ListAllTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error)
+	ExportTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error)
 }
 
 type TravelRequestUseCaseImpl struct {
 	travelGateway       gateway.TravelRequestGateway
 	userGateway         gateway.UserGateway
 	notificationService NotificationUseCae
+	transactor          gateway.Transactor
+	eventPublisher      TravelEventPublisher
+	costUseCase         CostUseCase
+	policyUseCase       PolicyUseCase
+	travelerUseCase     TravelerUseCase
+	travelGroupGateway  gateway.TravelGroupGateway
+	destinationUseCase  DestinationUseCase
+	templateGateway     gateway.TravelTemplateGateway
+	seriesGateway       gateway.TravelSeriesGateway
+	defaultTimezone     string
 }
 
 func NewTravelRequestUseCase(
 	travelGateway gateway.TravelRequestGateway,
 	userGateway gateway.UserGateway,
 	notificationService NotificationUseCae,
+	transactor gateway.Transactor,
+	eventPublisher TravelEventPublisher,
+	costUseCase CostUseCase,
+	policyUseCase PolicyUseCase,
+	travelerUseCase TravelerUseCase,
+	travelGroupGateway gateway.TravelGroupGateway,
+	destinationUseCase DestinationUseCase,
+	templateGateway gateway.TravelTemplateGateway,
+	seriesGateway gateway.TravelSeriesGateway,
+	defaultTimezone string,
 ) *TravelRequestUseCaseImpl {
 	return &TravelRequestUseCaseImpl{
 		travelGateway:       travelGateway,
 		userGateway:         userGateway,
 		notificationService: notificationService,
+		transactor:          transactor,
+		eventPublisher:      eventPublisher,
+		costUseCase:         costUseCase,
+		policyUseCase:       policyUseCase,
+		travelerUseCase:     travelerUseCase,
+		travelGroupGateway:  travelGroupGateway,
+		destinationUseCase:  destinationUseCase,
+		templateGateway:     templateGateway,
+		seriesGateway:       seriesGateway,
+		defaultTimezone:     defaultTimezone,
 	}
 }
 
@@ -61,17 +117,26 @@ func (uc *TravelRequestUseCaseImpl) CreateTravelRequest(
 	userID uuid.UUID,
 	input dto.CreateTravelRequestDTO,
 ) (*entity.TravelRequest, error) {
-	if input.DestinationName == "" {
-		return nil, ErrInvalidDestination
+	destination, err := uc.resolveDestination(ctx, input.DestinationId)
+	if err != nil {
+		return nil, err
 	}
 
-	now := time.Now()
-	if input.DepartureDate.Before(now) {
-		return nil, ErrFutureDatesOnly
+	if destination != nil {
+		input.DestinationName = destination.DisplayName()
 	}
 
-	if input.ReturnDate != nil && input.DepartureDate.After(*input.ReturnDate) {
-		return nil, ErrInvalidDates
+	if err := validateItinerary(input.DestinationName, input.DepartureDate, input.ReturnDate); err != nil {
+		return nil, err
+	}
+
+	input.DepartureTimezone, input.ReturnTimezone, err = uc.resolveTimezones(ctx, input.DepartureTimezone, input.ReturnTimezone, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.TravelerIds) == 0 && strings.TrimSpace(input.TravelerName) == "" {
+		return nil, ErrTravelerRequired
 	}
 
 	user, err := uc.userGateway.FindByID(ctx, userID)
@@ -79,20 +144,97 @@ func (uc *TravelRequestUseCaseImpl) CreateTravelRequest(
 		return nil, err
 	}
 
-	travelRequest := &entity.TravelRequest{
-		Id:              uuid.New(),
-		TravelerName:    input.TravelerName,
-		UserId:          userID,
-		DestinationName: input.DestinationName,
-		DepartureDate:   input.DepartureDate,
-		ReturnDate:      input.ReturnDate,
-		Status:          enums.TravelRequestStatusSolicited,
-		CreatedAt:       now,
-		UpdatedAt:       &now,
-		User:            *user,
+	var travelers []entity.Traveler
+	if len(input.TravelerIds) > 0 {
+		travelers, err = uc.travelerUseCase.ResolveForBooking(ctx, user, input.TravelerIds)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A verificação de sobreposição bloqueia os viajantes até a gravação, na mesma transação.
+	var travelRequest *entity.TravelRequest
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		travelRequest, err = uc.buildTravelRequest(ctx, user, input, travelers, destination)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.travelGateway.Create(ctx, travelRequest); err != nil {
+			return err
+		}
+
+		if input.Draft {
+			return nil
+		}
+
+		return uc.eventPublisher.PublishTravelEvent(ctx, enums.WebhookEventTravelCreated, travelRequest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return travelRequest, nil
+}
+
+// SubmitTravelRequest envia um rascunho do solicitante para aprovação. O rascunho não reserva o
+// período, então a sobreposição e as políticas são conferidas de novo no envio, que publica o
+// evento travel.created.
+func (uc *TravelRequestUseCaseImpl) SubmitTravelRequest(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	input dto.SubmitTravelRequestDTO,
+) (*entity.TravelRequest, error) {
+	travelRequest, err := uc.travelGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if travelRequest.UserId != userID {
+		return nil, ErrUnauthorized
+	}
+
+	if travelRequest.Status != enums.TravelRequestStatusDraft {
+		return nil, ErrTravelNotDraft
+	}
+
+	if err := validateItinerary(travelRequest.DestinationName, travelRequest.DepartureDate, travelRequest.ReturnDate); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyPolicies(ctx, travelRequest); err != nil {
+		return nil, err
 	}
 
-	err = uc.travelGateway.Create(ctx, travelRequest)
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.checkOverlap(ctx, user, travelRequest, &travelRequest.Id, input.OverrideOverlap); err != nil {
+			return err
+		}
+
+		travelRequest.Submit()
+
+		applied, err := uc.travelGateway.UpdateStatus(ctx, travelRequest, enums.TravelRequestStatusDraft)
+		if err != nil {
+			return err
+		}
+
+		if !applied {
+			return ErrStatusChangedMeanwhile
+		}
+
+		if err := uc.travelGateway.ReplacePolicyViolations(ctx, travelRequest); err != nil {
+			return err
+		}
+
+		return uc.eventPublisher.PublishTravelEvent(ctx, enums.WebhookEventTravelCreated, travelRequest)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -115,26 +257,179 @@ func (uc *TravelRequestUseCaseImpl) UpdateTravelRequest(
 		return nil, ErrUnauthorized
 	}
 
-	if travelRequest.Status != enums.TravelRequestStatusSolicited {
+	if !travelRequest.IsEditable() {
 		return nil, errors.New("não é possível alterar um pedido que já foi aprovado ou cancelado")
 	}
 
-	if input.DestinationName == nil {
+	if input.DestinationName == nil && input.DestinationId == nil {
 		return nil, ErrInvalidDestination
 	}
 
+	destination, err := uc.resolveDestination(ctx, input.DestinationId)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
-	if input.DepartureDate.Before(now) {
+	if input.DepartureDate != nil && input.DepartureDate.Before(now) {
 		return nil, ErrFutureDatesOnly
 	}
 
-	if input.ReturnDate != nil && input.DepartureDate.After(*input.ReturnDate) {
-		return nil, ErrInvalidDates
+	if input.BusinessPurpose != nil && strings.TrimSpace(*input.BusinessPurpose) == "" {
+		return nil, ErrBusinessPurposeRequired
+	}
+
+	var costItems []entity.TravelCostItem
+	if input.CostItems != nil {
+		costItems, err = uc.costUseCase.EstimateCosts(ctx, input.CostItems)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Sem novo rateio, as parcelas atuais são mantidas com o centro responsável informado.
+	allocationsChanged := input.CostCenterId != nil || input.Allocations != nil
+	var costCenter *entity.CostCenter
+	var allocations []entity.TravelCostAllocation
+	if allocationsChanged {
+		costCenterID := input.CostCenterId
+		if costCenterID == nil {
+			costCenterID = travelRequest.CostCenterId
+		}
+
+		allocationInput := input.Allocations
+		if allocationInput == nil {
+			allocationInput = allocationDTOs(travelRequest.Allocations)
+		}
+
+		costCenter, allocations, err = uc.costUseCase.ResolveCostCenter(ctx, costCenterID, allocationInput)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var user *entity.User
+	if input.TravelerIds != nil || input.DepartureDate != nil || input.ReturnDate != nil || input.OverrideOverlap {
+		user, err = uc.userGateway.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var travelers []entity.Traveler
+	if len(input.TravelerIds) > 0 {
+		travelers, err = uc.travelerUseCase.ResolveForBooking(ctx, user, input.TravelerIds)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	travelRequest.UpdateTravelRequest(input.DestinationName, input.TravelerName, input.DepartureDate, input.ReturnDate, nil, nil, nil)
 
-	err = uc.travelGateway.Update(ctx, travelRequest)
+	// Um texto livre diferente do nome canônico desfaz o vínculo com o catálogo.
+	if destination != nil || travelRequest.Destination == nil || travelRequest.DestinationName != travelRequest.Destination.DisplayName() {
+		travelRequest.SetDestination(destination)
+	}
+
+	if input.DepartureTimezone != nil {
+		travelRequest.DepartureTimezone = *input.DepartureTimezone
+	}
+
+	if input.ReturnTimezone != nil {
+		travelRequest.ReturnTimezone = *input.ReturnTimezone
+	} else if destination != nil {
+		travelRequest.ReturnTimezone = destination.Timezone
+	}
+
+	if err := validateTimezones(travelRequest.DepartureTimezone, travelRequest.ReturnTimezone); err != nil {
+		return nil, err
+	}
+
+	if input.TravelerIds != nil {
+		travelRequest.SetTravelers(travelers)
+		if len(travelers) == 0 && strings.TrimSpace(travelRequest.TravelerName) == "" {
+			return nil, ErrTravelerRequired
+		}
+	}
+
+	if travelRequest.ReturnDate != nil && travelRequest.DepartureDate.After(*travelRequest.ReturnDate) {
+		return nil, ErrInvalidDates
+	}
+
+	if input.Department != nil {
+		if user == nil {
+			user, err = uc.userGateway.FindByID(ctx, userID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		travelRequest.Department, err = uc.costUseCase.ResolveDepartment(ctx, user, input.Department)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.BusinessPurpose != nil {
+		travelRequest.BusinessPurpose = strings.TrimSpace(*input.BusinessPurpose)
+	}
+
+	if input.CostItems != nil {
+		travelRequest.SetCostItems(costItems, uc.costUseCase.BaseCurrency(ctx))
+	}
+
+	if allocationsChanged {
+		if err := travelRequest.SetCostCenter(costCenter, allocations); err != nil {
+			return nil, err
+		}
+	}
+
+	// Uma ocorrência editada individualmente deixa de ser regenerada quando a série muda.
+	if travelRequest.SeriesId != nil {
+		travelRequest.SeriesException = true
+	}
+
+	if err := uc.applyPolicies(ctx, travelRequest); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Rascunhos não reservam o período; a sobreposição é conferida no envio.
+		if user != nil && travelRequest.Status != enums.TravelRequestStatusDraft {
+			if err := uc.checkOverlap(ctx, user, travelRequest, &travelRequest.Id, input.OverrideOverlap); err != nil {
+				return err
+			}
+		}
+
+		updated, err := uc.travelGateway.Update(ctx, travelRequest)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return ErrStatusChangedMeanwhile
+		}
+
+		if input.TravelerIds != nil {
+			if err := uc.travelGateway.ReplaceTravelers(ctx, travelRequest); err != nil {
+				return err
+			}
+		}
+
+		if input.CostItems != nil {
+			if err := uc.travelGateway.ReplaceCostItems(ctx, travelRequest); err != nil {
+				return err
+			}
+		}
+
+		// Novos custos mudam o valor de cada parcela do rateio.
+		if allocationsChanged || (input.CostItems != nil && len(travelRequest.Allocations) > 0) {
+			if err := uc.travelGateway.ReplaceAllocations(ctx, travelRequest); err != nil {
+				return err
+			}
+		}
+
+		return uc.travelGateway.ReplacePolicyViolations(ctx, travelRequest)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +437,218 @@ func (uc *TravelRequestUseCaseImpl) UpdateTravelRequest(
 	return travelRequest, nil
 }
 
+func allocationDTOs(allocations []entity.TravelCostAllocation) []dto.CostAllocationDTO {
+	result := make([]dto.CostAllocationDTO, 0, len(allocations))
+	for _, allocation := range allocations {
+		result = append(result, dto.CostAllocationDTO{
+			CostCenterId: allocation.CostCenterId,
+			Percentage:   allocation.Percentage,
+		})
+	}
+
+	return result
+}
+
+// resolveDestination carrega a entrada do catálogo quando informada. Sem ela, o destino
+// continua sendo o texto livre de DestinationName.
+func (uc *TravelRequestUseCaseImpl) resolveDestination(ctx context.Context, id *uuid.UUID) (*entity.Destination, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	return uc.destinationUseCase.GetDestination(ctx, *id)
+}
+
+// resolveTimezones completa os fusos não informados: a ida usa o fuso padrão da empresa e a
+// volta usa o fuso do destino do catálogo ou, na falta dele, o mesmo da ida.
+func (uc *TravelRequestUseCaseImpl) resolveTimezones(ctx context.Context, departureTimezone, returnTimezone string, destination *entity.Destination) (string, string, error) {
+	if departureTimezone == "" {
+		departureTimezone = uc.defaultTimezoneFor(ctx)
+	}
+
+	if returnTimezone == "" {
+		returnTimezone = departureTimezone
+		if destination != nil && destination.Timezone != "" {
+			returnTimezone = destination.Timezone
+		}
+	}
+
+	if err := validateTimezones(departureTimezone, returnTimezone); err != nil {
+		return "", "", err
+	}
+
+	return departureTimezone, returnTimezone, nil
+}
+
+// defaultTimezoneFor retorna o fuso padrão da organização do contexto ou, sem ela, o da
+// instalação.
+func (uc *TravelRequestUseCaseImpl) defaultTimezoneFor(ctx context.Context) string {
+	if organization, ok := tenant.Organization(ctx); ok && organization.DefaultTimezone != "" {
+		return organization.DefaultTimezone
+	}
+
+	return uc.defaultTimezone
+}
+
+func validateTimezones(timezones ...string) error {
+	for _, timezone := range timezones {
+		if _, err := time.LoadLocation(timezone); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidTimezone, timezone)
+		}
+	}
+
+	return nil
+}
+
+func validateItinerary(destinationName string, departureDate time.Time, returnDate *time.Time) error {
+	if destinationName == "" {
+		return ErrInvalidDestination
+	}
+
+	if departureDate.Before(time.Now()) {
+		return ErrFutureDatesOnly
+	}
+
+	if returnDate != nil && departureDate.After(*returnDate) {
+		return ErrInvalidDates
+	}
+
+	return nil
+}
+
+// buildTravelRequest monta uma nova solicitação para os viajantes informados, verificando
+// sobreposição de datas, resolvendo o centro de custo, estimando e rateando os custos e
+// avaliando as políticas, sem persisti-la.
+func (uc *TravelRequestUseCaseImpl) buildTravelRequest(
+	ctx context.Context,
+	user *entity.User,
+	input dto.CreateTravelRequestDTO,
+	travelers []entity.Traveler,
+	destination *entity.Destination,
+) (*entity.TravelRequest, error) {
+	businessPurpose := strings.TrimSpace(input.BusinessPurpose)
+	if businessPurpose == "" {
+		return nil, ErrBusinessPurposeRequired
+	}
+
+	status := enums.TravelRequestStatusSolicited
+	if input.Draft {
+		status = enums.TravelRequestStatusDraft
+	}
+
+	now := time.Now()
+
+	travelRequest := &entity.TravelRequest{
+		Id:                uuid.New(),
+		TravelerName:      input.TravelerName,
+		UserId:            user.Id,
+		OrganizationId:    user.OrganizationId,
+		DestinationName:   input.DestinationName,
+		DepartureDate:     input.DepartureDate,
+		ReturnDate:        input.ReturnDate,
+		DepartureTimezone: input.DepartureTimezone,
+		ReturnTimezone:    input.ReturnTimezone,
+		Status:            status,
+		SeriesId:          input.SeriesId,
+		OccurrenceDate:    input.OccurrenceDate,
+		BusinessPurpose:   businessPurpose,
+		CreatedAt:         now,
+		UpdatedAt:         &now,
+		User:              *user,
+	}
+
+	travelRequest.SetTravelers(travelers)
+	travelRequest.SetDestination(destination)
+
+	// Rascunhos não reservam o período; a sobreposição é conferida no envio.
+	if !input.Draft {
+		if err := uc.checkOverlap(ctx, user, travelRequest, nil, input.OverrideOverlap); err != nil {
+			return nil, err
+		}
+	}
+
+	costCenter, allocations, err := uc.costUseCase.ResolveCostCenter(ctx, input.CostCenterId, input.Allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	travelRequest.Department, err = uc.costUseCase.ResolveDepartment(ctx, user, input.Department)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(input.CostItems) > 0 {
+		costItems, err := uc.costUseCase.EstimateCosts(ctx, input.CostItems)
+		if err != nil {
+			return nil, err
+		}
+
+		travelRequest.SetCostItems(costItems, uc.costUseCase.BaseCurrency(ctx))
+	} else {
+		travelRequest.Currency = uc.costUseCase.BaseCurrency(ctx)
+	}
+
+	if err := travelRequest.SetCostCenter(costCenter, allocations); err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyPolicies(ctx, travelRequest); err != nil {
+		return nil, err
+	}
+
+	return travelRequest, nil
+}
+
+// checkOverlap impede períodos sobrepostos para os mesmos viajantes. Sem viajantes
+// cadastrados, o conflito é verificado pelo solicitante. Somente administradores
+// podem ignorar o conflito com override.
+func (uc *TravelRequestUseCaseImpl) checkOverlap(
+	ctx context.Context,
+	user *entity.User,
+	travelRequest *entity.TravelRequest,
+	excludeID *uuid.UUID,
+	override bool,
+) error {
+	if override && user.Role != enums.UserTypeAdmin {
+		return ErrUnauthorized
+	}
+
+	conflicts, err := uc.travelGateway.FindOverlapping(
+		ctx,
+		travelRequest.UserId,
+		travelRequest.TravelerIds(),
+		travelRequest.DepartureDate,
+		travelRequest.ReturnDate,
+		excludeID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(conflicts) > 0 && !override {
+		return &OverlappingTravelError{ConflictingIds: conflicts}
+	}
+
+	return nil
+}
+
+// applyPolicies avalia as regras de política, registra as violações na solicitação
+// e retorna PolicyViolationError quando alguma delas é bloqueante.
+func (uc *TravelRequestUseCaseImpl) applyPolicies(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	violations, err := uc.policyUseCase.Evaluate(ctx, travelRequest)
+	if err != nil {
+		return err
+	}
+
+	travelRequest.SetPolicyViolations(violations)
+
+	if blocking := travelRequest.BlockingViolations(); len(blocking) > 0 {
+		return &PolicyViolationError{Violations: blocking}
+	}
+
+	return nil
+}
+
 func (uc *TravelRequestUseCaseImpl) UpdateStatusTravelRequest(ctx context.Context, userId string, input dto.UpdateStatusTravelRequestDTO) error {
 	userIdUUID, err := uuid.Parse(userId)
 
@@ -167,6 +674,31 @@ func (uc *TravelRequestUseCaseImpl) UpdateStatusTravelRequest(ctx context.Contex
 		return err
 	}
 
+	if travel.Group != nil && travel.Group.ApprovesAsUnit() {
+		return ErrGroupApprovedAsUnit
+	}
+
+	if err := uc.authorizeStatusChange(user, travel); err != nil {
+		return err
+	}
+
+	if !travel.CanTransitionTo(input.Status) {
+		return ErrInvalidTransition
+	}
+
+	// O orçamento é conferido na transação da aprovação, que bloqueia a linha do orçamento até gravar o status.
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if input.Status == enums.TravelRequestStatusApproved {
+			if err := uc.costUseCase.CheckBudget(ctx, travel); err != nil {
+				return err
+			}
+		}
+
+		return uc.changeStatus(ctx, user, travel, input.Status)
+	})
+}
+
+func (uc *TravelRequestUseCaseImpl) authorizeStatusChange(user *entity.User, travel *entity.TravelRequest) error {
 	if travel.UserId == user.Id {
 		return ErrUnauthorized
 	}
@@ -175,66 +707,262 @@ func (uc *TravelRequestUseCaseImpl) UpdateStatusTravelRequest(ctx context.Contex
 		return ErrUnauthorized
 	}
 
+	return nil
+}
+
+// changeStatus grava o novo status e, na mesma transação, a notificação ao solicitante e aos
+// viajantes e o evento para os webhooks. A gravação só acontece se o status ainda for o lido;
+// caso contrário, retorna ErrStatusChangedMeanwhile.
+func (uc *TravelRequestUseCaseImpl) changeStatus(
+	ctx context.Context,
+	user *entity.User,
+	travel *entity.TravelRequest,
+	status enums.TravelRequestStatus,
+) error {
 	previousStatus := travel.Status
 
-	if previousStatus == enums.TravelRequestStatusApproved {
-		return ErrTravelAlreadyApproved
+	applyStatus(user, travel, status)
+
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		applied, err := uc.travelGateway.UpdateStatus(ctx, travel, previousStatus)
+		if err != nil {
+			return err
+		}
+
+		if !applied {
+			return ErrStatusChangedMeanwhile
+		}
+
+		if err := uc.notificationService.NotifyStatusChange(ctx, travel, previousStatus); err != nil {
+			return err
+		}
+
+		return uc.publishStatusEvent(ctx, travel, previousStatus)
+	})
+}
+
+// publishStatusEvent publica travel.approved ou travel.canceled quando o status mudou para uma
+// dessas decisões.
+func (uc *TravelRequestUseCaseImpl) publishStatusEvent(ctx context.Context, travel *entity.TravelRequest, previousStatus enums.TravelRequestStatus) error {
+	event, ok := travelStatusEvent(travel.Status)
+	if !ok || travel.Status == previousStatus {
+		return nil
 	}
 
+	return uc.eventPublisher.PublishTravelEvent(ctx, event, travel)
+}
+
+// applyStatus aplica o status em memória, registrando quem aprovou ou cancelou.
+func applyStatus(user *entity.User, travel *entity.TravelRequest, status enums.TravelRequestStatus) {
 	var canceledBy *uuid.UUID
-	if input.Status == enums.TravelRequestStatusCanceled {
+	if status == enums.TravelRequestStatusCanceled {
 		canceledBy = &user.Id
 	}
 
 	var approvedBy *uuid.UUID
-	if input.Status == enums.TravelRequestStatusApproved {
+	if status == enums.TravelRequestStatusApproved {
 		approvedBy = &user.Id
 	}
 
-	travel.UpdateTravelRequest(nil, nil, nil, nil, &input.Status, canceledBy, approvedBy)
+	travel.UpdateTravelRequest(nil, nil, nil, nil, &status, canceledBy, approvedBy)
+}
 
-	err = uc.travelGateway.Update(ctx, travel)
+func (uc *TravelRequestUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelRequest, error) {
+	travelRequest, err := uc.travelGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !travelRequest.IsVisibleTo(userID) {
+		return nil, ErrUnauthorized
+	}
+
+	return travelRequest, nil
+}
 
+// ListTravelRequests retorna uma página das solicitações feitas pelo usuário ou em que ele viaja.
+func (uc *TravelRequestUseCaseImpl) ListTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error) {
+	page, err := fetchTravelPage(filters, func(filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
+		return uc.travelGateway.ListByUserID(ctx, userID, filters)
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	uc.notificationService.NotifyStatusChange(travel, previousStatus)
+	for i := range page.Items {
+		if page.Items[i].Group != nil {
+			page.Items[i].Group.Summarize()
+		}
+	}
 
-	return nil
+	return page, nil
+}
 
+// ListApproverInbox lista as solicitações de outros usuários que aguardam a decisão do
+// administrador, da ida mais urgente para a mais distante.
+func (uc *TravelRequestUseCaseImpl) ListApproverInbox(ctx context.Context, userID uuid.UUID, page int, pageSize int) (*entity.ApproverInbox, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	filters := utils.TravelRequestFilters{Page: page, PageSize: pageSize}
+
+	travels, err := uc.travelGateway.ListPendingApproval(ctx, userID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := uc.travelGateway.CountByStatusExcludingUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewApproverInbox(travels, counts, time.Now()), nil
 }
-func (uc *TravelRequestUseCaseImpl) GetByID(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.TravelRequest, error) {
-	travelRequest, err := uc.travelGateway.FindByID(ctx, id)
+
+// ListAllTravelRequests é a listagem administrativa: solicitações de todos os usuários,
+// paginadas e com o total de registros que atendem aos filtros.
+func (uc *TravelRequestUseCaseImpl) ListAllTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) (*entity.TravelRequestPage, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	if err := validateStatusFilters(filters.Statuses); err != nil {
+		return nil, err
+	}
+
+	return fetchTravelPage(filters, func(filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
+		return uc.travelGateway.List(ctx, filters)
+	})
+}
+
+// ExportTravelRequests devolve, sem paginação, todas as solicitações que atendem aos filtros da
+// listagem administrativa, com centro de custo e rateio carregados para a exportação contábil.
+func (uc *TravelRequestUseCaseImpl) ExportTravelRequests(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	if err := validateStatusFilters(filters.Statuses); err != nil {
+		return nil, err
+	}
+
+	if filters.Search != nil && strings.TrimSpace(*filters.Search) == "" {
+		filters.Search = nil
+	}
+
+	if filters.SortBy == "" || (filters.SortBy == enums.TravelRequestSortRelevance && filters.Search == nil) {
+		filters.SortBy, filters.SortDesc = enums.TravelRequestSortCreatedAt, true
+	}
+
+	filters.Page, filters.PageSize, filters.Cursor = 1, 0, nil
+
+	travels, _, err := uc.travelGateway.List(ctx, filters)
 	if err != nil {
 		return nil, err
 	}
 
-	if travelRequest.UserId != userID {
-		return nil, ErrUnauthorized
+	return travels, nil
+}
+
+func validateStatusFilters(statuses []enums.TravelRequestStatus) error {
+	for _, status := range statuses {
+		switch status {
+		case enums.TravelRequestStatusDraft, enums.TravelRequestStatusSolicited, enums.TravelRequestStatusApproved, enums.TravelRequestStatusCanceled:
+		default:
+			return ErrInvalidStatusFilter
+		}
 	}
 
-	return travelRequest, nil
+	return nil
 }
 
-func (uc *TravelRequestUseCaseImpl) ListTravelRequests(
-	ctx context.Context,
-	userID uuid.UUID,
-	status *enums.TravelRequestStatus,
-	startDate *time.Time,
-	endDate *time.Time,
-	destinationName *string,
-	page int,
-	pageSize int,
-) ([]entity.TravelRequest, error) {
-	filters := utils.TravelRequestFilters{
-		Status:          status,
-		StartDate:       startDate,
-		EndDate:         endDate,
-		DestinationName: destinationName,
-		Page:            page,
-		PageSize:        pageSize,
-	}
-
-	return uc.travelGateway.ListByUserID(ctx, userID, filters)
+// fetchTravelPage aplica a paginação dos filtros e monta o envelope da resposta. Sem ordenação
+// informada, vale a do cursor; na falta dele, a relevância quando há busca textual ou, sem busca,
+// as mais recentes primeiro. Com cursor, busca um item a mais para saber se há próxima página sem
+// precisar contar os registros.
+func fetchTravelPage(
+	filters utils.TravelRequestFilters,
+	fetch func(filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error),
+) (*entity.TravelRequestPage, error) {
+	normalizePagination(&filters)
+
+	if filters.Search != nil && strings.TrimSpace(*filters.Search) == "" {
+		filters.Search = nil
+	}
+
+	cursor := filters.Cursor
+	if cursor != nil && filters.SortBy == "" {
+		filters.SortBy, filters.SortDesc = cursor.SortBy, cursor.SortDesc
+	}
+
+	if filters.SortBy == "" && filters.Search != nil {
+		filters.SortBy, filters.SortDesc = enums.TravelRequestSortRelevance, true
+	}
+
+	if filters.SortBy == "" || (filters.SortBy == enums.TravelRequestSortRelevance && filters.Search == nil) {
+		filters.SortBy, filters.SortDesc = enums.TravelRequestSortCreatedAt, true
+	}
+
+	query := filters
+	if cursor != nil {
+		if cursor.SortBy != filters.SortBy || cursor.SortDesc != filters.SortDesc {
+			return nil, utils.ErrInvalidCursor
+		}
+		query.PageSize++
+	}
+
+	travels, total, err := fetch(query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.TravelRequestPage{PageSize: filters.PageSize}
+
+	hasMore := false
+	if cursor != nil {
+		hasMore = len(travels) > filters.PageSize
+		if hasMore {
+			travels = travels[:filters.PageSize]
+		}
+	} else {
+		page.Page = filters.Page
+		page.Total = &total
+		hasMore = int64(filters.Page)*int64(filters.PageSize) < total
+	}
+
+	page.Items = travels
+
+	if hasMore && len(travels) > 0 && utils.SupportsCursor(filters.SortBy) {
+		last := travels[len(travels)-1]
+
+		value := last.CreatedAt
+		if filters.SortBy == enums.TravelRequestSortDepartureDate {
+			value = last.DepartureDate
+		}
+
+		page.NextCursor = utils.TravelRequestCursor{
+			SortBy:   filters.SortBy,
+			SortDesc: filters.SortDesc,
+			Value:    value,
+			Id:       last.Id,
+		}.Encode()
+	}
+
+	return page, nil
+}
+
+// normalizePagination garante uma página válida e limita o tamanho a maxPageSize.
+func normalizePagination(filters *utils.TravelRequestFilters) {
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+
+	if filters.PageSize < 1 {
+		filters.PageSize = defaultPageSize
+	}
+
+	if filters.PageSize > maxPageSize {
+		filters.PageSize = maxPageSize
+	}
 }
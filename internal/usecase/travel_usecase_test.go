@@ -6,6 +6,7 @@ import (
 	"challenge-travel-api/internal/interface/dto"
 	"challenge-travel-api/internal/utils"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -31,27 +32,224 @@ func (m *MockTravelGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity
 	return args.Get(0).(*entity.TravelRequest), args.Error(1)
 }
 
-func (m *MockTravelGateway) Update(ctx context.Context, travelRequest *entity.TravelRequest) error {
+func (m *MockTravelGateway) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.TravelRequest, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]entity.TravelRequest), args.Error(1)
+}
+
+func (m *MockTravelGateway) UpdateStatus(ctx context.Context, travelRequest *entity.TravelRequest, previousStatus enums.TravelRequestStatus) (bool, error) {
+	args := m.Called(ctx, travelRequest, previousStatus)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTravelGateway) Update(ctx context.Context, travelRequest *entity.TravelRequest) (bool, error) {
 	args := m.Called(ctx, travelRequest)
-	return args.Error(0)
+	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockTravelGateway) List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+func (m *MockTravelGateway) List(ctx context.Context, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
 	args := m.Called(ctx, filters)
-	return args.Get(0).([]entity.TravelRequest), args.Error(1)
+	return args.Get(0).([]entity.TravelRequest), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *MockTravelGateway) ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+func (m *MockTravelGateway) ListByUserID(ctx context.Context, userID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, int64, error) {
 	args := m.Called(ctx, userID, filters)
+	return args.Get(0).([]entity.TravelRequest), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockTravelGateway) ListPendingApproval(ctx context.Context, approverID uuid.UUID, filters utils.TravelRequestFilters) ([]entity.TravelRequest, error) {
+	args := m.Called(ctx, approverID, filters)
+	return args.Get(0).([]entity.TravelRequest), args.Error(1)
+}
+
+func (m *MockTravelGateway) CountByStatusExcludingUser(ctx context.Context, userID uuid.UUID) (map[enums.TravelRequestStatus]int, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[enums.TravelRequestStatus]int), args.Error(1)
+}
+
+func (m *MockTravelGateway) ListBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]entity.TravelRequest, error) {
+	args := m.Called(ctx, seriesID)
 	return args.Get(0).([]entity.TravelRequest), args.Error(1)
 }
 
+func (m *MockTravelGateway) FindOverlapping(ctx context.Context, userID uuid.UUID, travelerIDs []uuid.UUID, departureDate time.Time, returnDate *time.Time, excludeID *uuid.UUID) ([]uuid.UUID, error) {
+	args := m.Called(ctx, userID, travelerIDs, departureDate, returnDate, excludeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (m *MockTravelGateway) ReplaceCostItems(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	args := m.Called(ctx, travelRequest)
+	return args.Error(0)
+}
+
+func (m *MockTravelGateway) ReplaceAllocations(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	args := m.Called(ctx, travelRequest)
+	return args.Error(0)
+}
+
+func (m *MockTravelGateway) ReplaceTravelers(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	args := m.Called(ctx, travelRequest)
+	return args.Error(0)
+}
+
+func (m *MockTravelGateway) ReplacePolicyViolations(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	args := m.Called(ctx, travelRequest)
+	return args.Error(0)
+}
+
+func (m *MockTravelGateway) SumApprovedTotalByDepartment(ctx context.Context, department string, from, to time.Time, excludeID uuid.UUID) (float64, error) {
+	args := m.Called(ctx, department, from, to, excludeID)
+	return args.Get(0).(float64), args.Error(1)
+}
+
 type MockNotificationService struct {
 	mock.Mock
 }
 
-func (m *MockNotificationService) NotifyStatusChange(travel *entity.TravelRequest, previousStatus enums.TravelRequestStatus) {
-	m.Called(travel, previousStatus)
+func (m *MockNotificationService) NotifyStatusChange(ctx context.Context, travel *entity.TravelRequest, previousStatus enums.TravelRequestStatus) error {
+	args := m.Called(ctx, travel, previousStatus)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) NotifyStatusChanges(ctx context.Context, changes []StatusChange) error {
+	args := m.Called(ctx, changes)
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) NotifyComment(ctx context.Context, travel *entity.TravelRequest, comment *entity.TravelRequestComment, recipients []entity.NotificationRecipient) error {
+	args := m.Called(ctx, travel, comment, recipients)
+	return args.Error(0)
+}
+
+// passthroughTransactor executa a função no próprio contexto, sem banco de dados.
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// discardTravelEvents ignora os eventos de webhook das solicitações.
+type discardTravelEvents struct{}
+
+func (discardTravelEvents) PublishTravelEvent(ctx context.Context, event enums.WebhookEvent, travelRequest *entity.TravelRequest) error {
+	return nil
+}
+
+// recordingTravelEvents guarda os eventos publicados, na ordem.
+type recordingTravelEvents struct {
+	events []enums.WebhookEvent
+}
+
+func (r *recordingTravelEvents) PublishTravelEvent(ctx context.Context, event enums.WebhookEvent, travelRequest *entity.TravelRequest) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+type MockCostUseCase struct {
+	mock.Mock
+}
+
+func (m *MockCostUseCase) BaseCurrency(ctx context.Context) string {
+	return m.Called().String(0)
+}
+
+func (m *MockCostUseCase) EstimateCosts(ctx context.Context, items []dto.CostItemDTO) ([]entity.TravelCostItem, error) {
+	args := m.Called(ctx, items)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TravelCostItem), args.Error(1)
+}
+
+func (m *MockCostUseCase) ResolveDepartment(ctx context.Context, requester *entity.User, department *string) (*string, error) {
+	args := m.Called(ctx, requester, department)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*string), args.Error(1)
+}
+
+func (m *MockCostUseCase) CheckBudget(ctx context.Context, travelRequest *entity.TravelRequest) error {
+	args := m.Called(ctx, travelRequest)
+	return args.Error(0)
+}
+
+func (m *MockCostUseCase) ListExchangeRates(ctx context.Context) ([]entity.ExchangeRate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockCostUseCase) SaveExchangeRate(ctx context.Context, userID uuid.UUID, input dto.SaveExchangeRateDTO) (*entity.ExchangeRate, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ExchangeRate), args.Error(1)
+}
+
+func (m *MockCostUseCase) ListDepartmentBudgets(ctx context.Context, year *int) ([]entity.DepartmentBudget, error) {
+	args := m.Called(ctx, year)
+	return args.Get(0).([]entity.DepartmentBudget), args.Error(1)
+}
+
+func (m *MockCostUseCase) SaveDepartmentBudget(ctx context.Context, userID uuid.UUID, input dto.SaveDepartmentBudgetDTO) (*entity.DepartmentBudget, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DepartmentBudget), args.Error(1)
+}
+
+func (m *MockCostUseCase) ResolveCostCenter(ctx context.Context, costCenterID *uuid.UUID, allocations []dto.CostAllocationDTO) (*entity.CostCenter, []entity.TravelCostAllocation, error) {
+	args := m.Called(ctx, costCenterID, allocations)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Get(1).([]entity.TravelCostAllocation), args.Error(2)
+}
+
+func (m *MockCostUseCase) ListCostCenters(ctx context.Context, includeInactive bool) ([]entity.CostCenter, error) {
+	args := m.Called(ctx, includeInactive)
+	return args.Get(0).([]entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostUseCase) CreateCostCenter(ctx context.Context, userID uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Error(1)
+}
+
+func (m *MockCostUseCase) UpdateCostCenter(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.SaveCostCenterDTO) (*entity.CostCenter, error) {
+	args := m.Called(ctx, userID, id, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.CostCenter), args.Error(1)
+}
+
+type MockPolicyUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPolicyUseCase) Evaluate(ctx context.Context, travelRequest *entity.TravelRequest) ([]entity.TravelPolicyViolation, error) {
+	args := m.Called(ctx, travelRequest)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TravelPolicyViolation), args.Error(1)
+}
+
+func (m *MockPolicyUseCase) ListRules(ctx context.Context) ([]entity.TravelPolicyRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.TravelPolicyRule), args.Error(1)
 }
 
 func TestTravelRequestUseCase_CreateTravelRequest(t *testing.T) {
@@ -59,7 +257,10 @@ func TestTravelRequestUseCase_CreateTravelRequest(t *testing.T) {
 	mockTravelGateway := new(MockTravelGateway)
 	mockUserGateway := new(MockUserGateway)
 	mockNotificationService := new(MockNotificationService)
-	useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService)
+	mockCostUseCase := new(MockCostUseCase)
+	mockPolicyUseCase := new(MockPolicyUseCase)
+	mockTravelerUseCase := new(MockTravelerUseCase)
+	useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
 
 	ctx := context.Background()
 	userID := uuid.New()
@@ -73,9 +274,15 @@ func TestTravelRequestUseCase_CreateTravelRequest(t *testing.T) {
 		Role: enums.UserTypeCommon,
 	}
 
+	costCenter := &entity.CostCenter{Id: uuid.New(), Code: "CC-100", Name: "Comercial", Kind: enums.CostCenterKindCostCenter, Active: true}
+	mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+	mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, []dto.CostAllocationDTO(nil)).Return(costCenter, []entity.TravelCostAllocation{}, nil)
+
 	t.Run("should create travel request successfully", func(t *testing.T) {
 		// Arrange
 		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
 			TravelerName:    "John Doe",
 			DestinationName: "Paris",
 			DepartureDate:   futureDate,
@@ -83,7 +290,10 @@ func TestTravelRequestUseCase_CreateTravelRequest(t *testing.T) {
 		}
 
 		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, mock.Anything, mock.Anything, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
 		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
 
 		// Act
 		result, err := useCase.CreateTravelRequest(ctx, userID, input)
@@ -96,162 +306,1140 @@ func TestTravelRequestUseCase_CreateTravelRequest(t *testing.T) {
 		assert.Equal(t, input.DepartureDate, result.DepartureDate)
 		assert.Equal(t, input.ReturnDate, result.ReturnDate)
 		assert.Equal(t, enums.TravelRequestStatusSolicited, result.Status)
+		assert.Equal(t, "America/Sao_Paulo", result.DepartureTimezone)
+		assert.Equal(t, "America/Sao_Paulo", result.ReturnTimezone)
+		assert.Equal(t, "BRL", result.Currency)
 		mockUserGateway.AssertExpectations(t)
 		mockTravelGateway.AssertExpectations(t)
 	})
 
-	t.Run("should return error for invalid destination", func(t *testing.T) {
+	t.Run("should compute estimated total from cost items", func(t *testing.T) {
 		// Arrange
 		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
 			TravelerName:    "John Doe",
-			DestinationName: "",
+			DestinationName: "Paris",
 			DepartureDate:   futureDate,
 			ReturnDate:      &returnDate,
+			CostItems: []dto.CostItemDTO{
+				{Category: enums.CostCategoryAirfare, Amount: 1000, Currency: "EUR"},
+				{Category: enums.CostCategoryLodging, Amount: 800, Currency: "BRL"},
+			},
+		}
+
+		costItems := []entity.TravelCostItem{
+			{Id: uuid.New(), Category: enums.CostCategoryAirfare, Amount: 1000, Currency: "EUR", BaseAmount: 5850},
+			{Id: uuid.New(), Category: enums.CostCategoryLodging, Amount: 800, Currency: "BRL", BaseAmount: 800},
 		}
 
+		mockCostUseCase.On("EstimateCosts", ctx, input.CostItems).Return(costItems, nil).Once()
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
+
 		// Act
 		result, err := useCase.CreateTravelRequest(ctx, userID, input)
 
 		// Assert
-		assert.Error(t, err)
-		assert.Equal(t, ErrInvalidDestination, err)
-		assert.Nil(t, result)
+		assert.NoError(t, err)
+		assert.Equal(t, 6650.0, result.EstimatedTotal)
+		assert.Equal(t, "BRL", result.Currency)
+		assert.Len(t, result.CostItems, 2)
+		assert.Equal(t, result.Id, result.CostItems[0].TravelRequestId)
+		mockCostUseCase.AssertExpectations(t)
 	})
 
-	t.Run("should return error for past departure date", func(t *testing.T) {
+	t.Run("should reject request with blocking policy violations", func(t *testing.T) {
 		// Arrange
-		pastDate := now.AddDate(0, -1, 0)
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
 		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
 			TravelerName:    "John Doe",
 			DestinationName: "Paris",
-			DepartureDate:   pastDate,
+			DepartureDate:   futureDate,
 			ReturnDate:      &returnDate,
 		}
 
+		violations := []entity.TravelPolicyViolation{
+			{RuleName: "antecedencia_minima", Severity: enums.PolicySeverityBlock, Message: "Antecedência mínima de 14 dias"},
+			{RuleName: "limite_pernoites", Severity: enums.PolicySeverityWarn, Message: "Mais de 5 pernoites"},
+		}
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, mock.Anything, mock.Anything, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(violations, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
+
 		// Act
 		result, err := useCase.CreateTravelRequest(ctx, userID, input)
 
 		// Assert
-		assert.Error(t, err)
-		assert.Equal(t, ErrFutureDatesOnly, err)
 		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrPolicyViolation)
+		var policyErr *PolicyViolationError
+		assert.ErrorAs(t, err, &policyErr)
+		assert.Len(t, policyErr.Violations, 1)
+		assert.Equal(t, "antecedencia_minima", policyErr.Violations[0].RuleName)
+		mockTravelGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
 	})
 
-	t.Run("should return error for invalid dates", func(t *testing.T) {
+	t.Run("should store warnings on created request", func(t *testing.T) {
 		// Arrange
-		invalidReturnDate := now.AddDate(0, -1, 0)
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
 		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
 			TravelerName:    "John Doe",
 			DestinationName: "Paris",
 			DepartureDate:   futureDate,
-			ReturnDate:      &invalidReturnDate,
+			ReturnDate:      &returnDate,
+		}
+
+		violations := []entity.TravelPolicyViolation{
+			{RuleName: "limite_pernoites", Severity: enums.PolicySeverityWarn, Message: "Mais de 5 pernoites"},
 		}
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, mock.Anything, mock.Anything, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(violations, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
 
 		// Act
 		result, err := useCase.CreateTravelRequest(ctx, userID, input)
 
 		// Assert
-		assert.Error(t, err)
-		assert.Equal(t, ErrInvalidDates, err)
-		assert.Nil(t, result)
+		assert.NoError(t, err)
+		assert.Len(t, result.PolicyViolations, 1)
+		assert.Equal(t, result.Id, result.PolicyViolations[0].TravelRequestId)
+		mockTravelGateway.AssertExpectations(t)
 	})
-}
 
-func TestTravelRequestUseCase_UpdateStatusTravelRequest(t *testing.T) {
-	// Setup
-	mockTravelGateway := new(MockTravelGateway)
-	mockUserGateway := new(MockUserGateway)
-	mockNotificationService := new(MockNotificationService)
-	useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService)
+	t.Run("should reject overlapping travel requests", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
 
-	ctx := context.Background()
-	userID := uuid.New()
-	adminID := uuid.New()
-	travelID := uuid.New()
+		conflictID := uuid.New()
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+		}
 
-	admin := &entity.User{
-		Id:   adminID,
-		Name: "Admin User",
-		Role: enums.UserTypeAdmin,
-	}
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{conflictID}, nil)
 
-	regularUser := &entity.User{
-		Id:   userID,
-		Name: "Regular User",
-		Role: enums.UserTypeCommon,
-	}
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
 
-	travel := &entity.TravelRequest{
-		Id:     travelID,
-		UserId: userID,
-		Status: enums.TravelRequestStatusSolicited,
-	}
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrOverlappingTravel)
+		var overlapErr *OverlappingTravelError
+		assert.ErrorAs(t, err, &overlapErr)
+		assert.Equal(t, []uuid.UUID{conflictID}, overlapErr.ConflictingIds)
+		mockTravelGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
 
-	t.Run("should update status successfully", func(t *testing.T) {
+	t.Run("should not allow common users to override overlaps", func(t *testing.T) {
 		// Arrange
-		input := dto.UpdateStatusTravelRequestDTO{
-			TravelRequestId: travelID.String(),
-			Status:          enums.TravelRequestStatusApproved,
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+			OverrideOverlap: true,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+		mockTravelGateway.AssertNotCalled(t, "FindOverlapping", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should let admins override overlaps", func(t *testing.T) {
+		// Arrange
+		adminID := uuid.New()
+		admin := &entity.User{Id: adminID, Name: "Admin", Role: enums.UserTypeAdmin}
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "Admin",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+			OverrideOverlap: true,
 		}
 
 		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
-		mockTravelGateway.On("FindByID", ctx, travelID).Return(travel, nil)
-		mockTravelGateway.On("Update", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
-		mockNotificationService.On("NotifyStatusChange", mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return()
+		mockTravelGateway.On("FindOverlapping", ctx, adminID, []uuid.UUID{}, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{uuid.New()}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
 
 		// Act
-		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), input)
+		result, err := useCase.CreateTravelRequest(ctx, adminID, input)
 
 		// Assert
 		assert.NoError(t, err)
-		mockUserGateway.AssertExpectations(t)
+		assert.NotNil(t, result)
 		mockTravelGateway.AssertExpectations(t)
-		mockNotificationService.AssertExpectations(t)
 	})
 
-	t.Run("should return error for unauthorized user", func(t *testing.T) {
+	t.Run("should book registered travelers and check overlaps per traveler", func(t *testing.T) {
 		// Arrange
-		input := dto.UpdateStatusTravelRequestDTO{
-			TravelRequestId: travelID.String(),
-			Status:          enums.TravelRequestStatusApproved,
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		mockTravelerUseCase := new(MockTravelerUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		travelers := []entity.Traveler{
+			{Id: uuid.New(), Name: "Maria Souza", CreatedBy: userID},
+			{Id: uuid.New(), Name: "João Lima", CreatedBy: userID},
+		}
+		travelerIDs := []uuid.UUID{travelers[0].Id, travelers[1].Id}
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerIds:     travelerIDs,
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
 		}
 
-		mockUserGateway.On("FindByID", ctx, userID).Return(regularUser, nil)
-		mockTravelGateway.On("FindByID", ctx, travelID).Return(travel, nil)
+		mockTravelerUseCase.On("ResolveForBooking", ctx, user, travelerIDs).Return(travelers, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, travelerIDs, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
 
 		// Act
-		err := useCase.UpdateStatusTravelRequest(ctx, userID.String(), input)
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
 
 		// Assert
-		assert.Error(t, err)
-		assert.Equal(t, ErrUnauthorized, err)
-		mockUserGateway.AssertExpectations(t)
+		assert.NoError(t, err)
+		assert.Equal(t, "Maria Souza, João Lima", result.TravelerName)
+		assert.Equal(t, travelerIDs, result.TravelerIds())
+		mockTravelerUseCase.AssertExpectations(t)
 		mockTravelGateway.AssertExpectations(t)
 	})
 
-	t.Run("should return error for already approved travel", func(t *testing.T) {
+	t.Run("should use the catalog name when a destination is referenced", func(t *testing.T) {
 		// Arrange
-		approvedTravel := &entity.TravelRequest{
-			Id:     travelID,
-			UserId: userID,
-			Status: enums.TravelRequestStatusApproved,
-		}
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		mockDestinationUseCase := new(MockDestinationUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), mockDestinationUseCase, new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
 
-		input := dto.UpdateStatusTravelRequestDTO{
-			TravelRequestId: travelID.String(),
-			Status:          enums.TravelRequestStatusApproved,
+		destination := &entity.Destination{Id: uuid.New(), Code: "BR-SAO", City: "São Paulo", CountryCode: "BR", CountryName: "Brasil", Timezone: "America/Sao_Paulo"}
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "sampa",
+			DestinationId:   &destination.Id,
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
 		}
 
-		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
-		mockTravelGateway.On("FindByID", ctx, travelID).Return(approvedTravel, nil)
+		mockDestinationUseCase.On("GetDestination", ctx, destination.Id).Return(destination, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL").Once()
 
 		// Act
-		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), input)
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "São Paulo, Brasil", result.DestinationName)
+		assert.Equal(t, &destination.Id, result.DestinationId)
+		assert.Equal(t, destination.Timezone, result.ReturnTimezone)
+		mockDestinationUseCase.AssertExpectations(t)
+	})
+
+	t.Run("should return error for unknown time zone", func(t *testing.T) {
+		// Arrange
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose:   "Reunião com clientes",
+			CostCenterId:      &costCenter.Id,
+			TravelerName:      "John Doe",
+			DestinationName:   "Paris",
+			DepartureDate:     futureDate,
+			ReturnDate:        &returnDate,
+			DepartureTimezone: "Mars/Olympus_Mons",
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidTimezone)
+	})
+
+	t.Run("should require a traveler name or registered travelers", func(t *testing.T) {
+		// Arrange
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrTravelerRequired, err)
+	})
+
+	t.Run("should return error for invalid destination", func(t *testing.T) {
+		// Arrange
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, ErrInvalidDestination, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error for past departure date", func(t *testing.T) {
+		// Arrange
+		pastDate := now.AddDate(0, -1, 0)
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   pastDate,
+			ReturnDate:      &returnDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, ErrFutureDatesOnly, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error for invalid dates", func(t *testing.T) {
+		// Arrange
+		invalidReturnDate := now.AddDate(0, -1, 0)
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &invalidReturnDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, ErrInvalidDates, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should require a business purpose", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "   ",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+		}
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrBusinessPurposeRequired, err)
+		mockTravelGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should split the estimated total among cost center allocations", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		project := &entity.CostCenter{Id: uuid.New(), Code: "PRJ-7", Name: "Projeto Atlas", Kind: enums.CostCenterKindProject, Active: true}
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Implantação do projeto Atlas",
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+			CostItems:       []dto.CostItemDTO{{Category: enums.CostCategoryAirfare, Amount: 1000, Currency: "BRL"}},
+			Allocations: []dto.CostAllocationDTO{
+				{CostCenterId: project.Id, Percentage: 66.67},
+				{CostCenterId: costCenter.Id, Percentage: 33.33},
+			},
+		}
+
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("ResolveCostCenter", ctx, (*uuid.UUID)(nil), input.Allocations).Return(project, []entity.TravelCostAllocation{
+			{CostCenterId: project.Id, Percentage: 66.67, CostCenter: project},
+			{CostCenterId: costCenter.Id, Percentage: 33.33, CostCenter: costCenter},
+		}, nil)
+		mockCostUseCase.On("EstimateCosts", ctx, input.CostItems).Return([]entity.TravelCostItem{
+			{Id: uuid.New(), Category: enums.CostCategoryAirfare, Amount: 1000, Currency: "BRL", BaseAmount: 1000},
+		}, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Create", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return(nil)
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Implantação do projeto Atlas", result.BusinessPurpose)
+		assert.Equal(t, &project.Id, result.CostCenterId)
+		assert.Len(t, result.Allocations, 2)
+		assert.Equal(t, 666.7, result.Allocations[0].Amount)
+		assert.Equal(t, 333.3, result.Allocations[1].Amount)
+		assert.Equal(t, result.Id, result.Allocations[1].TravelRequestId)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject allocations that do not sum to 100 percent", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, new(MockPolicyUseCase), mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		input := dto.CreateTravelRequestDTO{
+			BusinessPurpose: "Reunião com clientes",
+			CostCenterId:    &costCenter.Id,
+			TravelerName:    "John Doe",
+			DestinationName: "Paris",
+			DepartureDate:   futureDate,
+			ReturnDate:      &returnDate,
+			Allocations:     []dto.CostAllocationDTO{{CostCenterId: costCenter.Id, Percentage: 80}},
+		}
+
+		mockTravelGateway.On("FindOverlapping", ctx, userID, []uuid.UUID{}, futureDate, &returnDate, (*uuid.UUID)(nil)).Return([]uuid.UUID{}, nil)
+		mockCostUseCase.On("ResolveDepartment", ctx, mock.AnythingOfType("*entity.User"), (*string)(nil)).Return(nil, nil)
+		mockCostUseCase.On("BaseCurrency").Return("BRL")
+		mockCostUseCase.On("ResolveCostCenter", ctx, &costCenter.Id, input.Allocations).Return(costCenter, []entity.TravelCostAllocation{
+			{CostCenterId: costCenter.Id, Percentage: 80, CostCenter: costCenter},
+		}, nil)
+
+		// Act
+		result, err := useCase.CreateTravelRequest(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, entity.ErrInvalidAllocation, err)
+		mockTravelGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_UpdateTravelRequest_Allocations(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	commercial := &entity.CostCenter{Id: uuid.New(), Code: "CC-100", Kind: enums.CostCenterKindCostCenter, Active: true}
+	project := &entity.CostCenter{Id: uuid.New(), Code: "PRJ-7", Kind: enums.CostCenterKindProject, Active: true}
+
+	t.Run("should replace the split keeping the responsible cost center", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockCostUseCase := new(MockCostUseCase)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		travel := &entity.TravelRequest{
+			Id:                uuid.New(),
+			UserId:            userID,
+			TravelerName:      "John Doe",
+			DestinationName:   "Paris",
+			DepartureDate:     time.Now().AddDate(0, 1, 0),
+			DepartureTimezone: "America/Sao_Paulo",
+			ReturnTimezone:    "Europe/Paris",
+			Status:            enums.TravelRequestStatusSolicited,
+			EstimatedTotal:    2000,
+			BusinessPurpose:   "Feira de tecnologia",
+			CostCenterId:      &commercial.Id,
+			CostCenter:        commercial,
+		}
+		destinationName := "Paris"
+		input := dto.UpdateTravelRequestDTO{
+			DestinationName: &destinationName,
+			Allocations: []dto.CostAllocationDTO{
+				{CostCenterId: commercial.Id, Percentage: 50},
+				{CostCenterId: project.Id, Percentage: 50},
+			},
+		}
+
+		mockTravelGateway.On("FindByID", ctx, travel.Id).Return(travel, nil)
+		mockCostUseCase.On("ResolveCostCenter", ctx, &commercial.Id, input.Allocations).Return(commercial, []entity.TravelCostAllocation{
+			{CostCenterId: commercial.Id, Percentage: 50, CostCenter: commercial},
+			{CostCenterId: project.Id, Percentage: 50, CostCenter: project},
+		}, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, travel).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Update", ctx, travel).Return(true, nil)
+		mockTravelGateway.On("ReplaceAllocations", ctx, travel).Return(nil)
+		mockTravelGateway.On("ReplacePolicyViolations", ctx, travel).Return(nil)
+
+		// Act
+		result, err := useCase.UpdateTravelRequest(ctx, travel.Id, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &commercial.Id, result.CostCenterId)
+		assert.Len(t, result.Allocations, 2)
+		assert.Equal(t, 1000.0, result.Allocations[1].Amount)
+		assert.Equal(t, "Feira de tecnologia", result.BusinessPurpose)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should report a request decided while it was being edited", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		travel := &entity.TravelRequest{
+			Id:                uuid.New(),
+			UserId:            userID,
+			TravelerName:      "John Doe",
+			DestinationName:   "Paris",
+			DepartureDate:     time.Now().AddDate(0, 1, 0),
+			DepartureTimezone: "America/Sao_Paulo",
+			ReturnTimezone:    "Europe/Paris",
+			Status:            enums.TravelRequestStatusSolicited,
+		}
+		destinationName := "Lyon"
+
+		mockTravelGateway.On("FindByID", ctx, travel.Id).Return(travel, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, travel).Return([]entity.TravelPolicyViolation{}, nil)
+		mockTravelGateway.On("Update", ctx, travel).Return(false, nil)
+
+		// Act
+		result, err := useCase.UpdateTravelRequest(ctx, travel.Id, userID, dto.UpdateTravelRequestDTO{DestinationName: &destinationName})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrStatusChangedMeanwhile)
+		mockTravelGateway.AssertNotCalled(t, "ReplacePolicyViolations", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject a blank business purpose", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		travel := &entity.TravelRequest{Id: uuid.New(), UserId: userID, Status: enums.TravelRequestStatusSolicited}
+		destinationName := "Paris"
+		blank := " "
+
+		mockTravelGateway.On("FindByID", ctx, travel.Id).Return(travel, nil)
+
+		// Act
+		result, err := useCase.UpdateTravelRequest(ctx, travel.Id, userID, dto.UpdateTravelRequestDTO{DestinationName: &destinationName, BusinessPurpose: &blank})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrBusinessPurposeRequired, err)
+		mockTravelGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_UpdateStatusTravelRequest(t *testing.T) {
+	// Setup
+	mockTravelGateway := new(MockTravelGateway)
+	mockUserGateway := new(MockUserGateway)
+	mockNotificationService := new(MockNotificationService)
+	mockCostUseCase := new(MockCostUseCase)
+	mockPolicyUseCase := new(MockPolicyUseCase)
+	mockTravelerUseCase := new(MockTravelerUseCase)
+	useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	adminID := uuid.New()
+	travelID := uuid.New()
+
+	admin := &entity.User{
+		Id:   adminID,
+		Name: "Admin User",
+		Role: enums.UserTypeAdmin,
+	}
+
+	regularUser := &entity.User{
+		Id:   userID,
+		Name: "Regular User",
+		Role: enums.UserTypeCommon,
+	}
+
+	travel := &entity.TravelRequest{
+		Id:     travelID,
+		UserId: userID,
+		Status: enums.TravelRequestStatusSolicited,
+	}
+
+	t.Run("should update status successfully", func(t *testing.T) {
+		// Arrange
+		input := dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: travelID.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, travelID).Return(travel, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(true, nil).Once()
+		mockCostUseCase.On("CheckBudget", ctx, travel).Return(nil).Once()
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.AnythingOfType("*entity.TravelRequest"), enums.TravelRequestStatusSolicited).Return(nil)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), input)
+
+		// Assert
+		assert.NoError(t, err)
+		mockUserGateway.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+		mockNotificationService.AssertExpectations(t)
+	})
+
+	t.Run("should return error for unauthorized user", func(t *testing.T) {
+		// Arrange
+		input := dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: travelID.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(regularUser, nil)
+		mockTravelGateway.On("FindByID", ctx, travelID).Return(travel, nil)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, userID.String(), input)
 
 		// Assert
 		assert.Error(t, err)
-		assert.Equal(t, ErrTravelAlreadyApproved, err)
+		assert.Equal(t, ErrUnauthorized, err)
 		mockUserGateway.AssertExpectations(t)
 		mockTravelGateway.AssertExpectations(t)
 	})
+
+	t.Run("should return error for already approved travel", func(t *testing.T) {
+		// Arrange
+		approvedTravel := &entity.TravelRequest{
+			Id:     travelID,
+			UserId: userID,
+			Status: enums.TravelRequestStatusApproved,
+		}
+
+		input := dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: travelID.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, travelID).Return(approvedTravel, nil)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), input)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, ErrInvalidTransition, err)
+		mockUserGateway.AssertExpectations(t)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject reopening a canceled travel or moving it back to solicited", func(t *testing.T) {
+		// Arrange
+		canceledTravel := &entity.TravelRequest{Id: uuid.New(), UserId: userID, Status: enums.TravelRequestStatusCanceled}
+		pendingTravel := &entity.TravelRequest{Id: uuid.New(), UserId: userID, Status: enums.TravelRequestStatusSolicited}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, canceledTravel.Id).Return(canceledTravel, nil)
+		mockTravelGateway.On("FindByID", ctx, pendingTravel.Id).Return(pendingTravel, nil)
+
+		// Act
+		reopenErr := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: canceledTravel.Id.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		})
+		resetErr := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: pendingTravel.Id.String(),
+			Status:          enums.TravelRequestStatusSolicited,
+		})
+
+		// Assert
+		assert.Equal(t, ErrInvalidTransition, reopenErr)
+		assert.Equal(t, ErrInvalidTransition, resetErr)
+		mockTravelGateway.AssertNotCalled(t, "UpdateStatus", mock.Anything, canceledTravel, mock.Anything)
+		mockTravelGateway.AssertNotCalled(t, "UpdateStatus", mock.Anything, pendingTravel, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_UpdateStatusTravelRequest_Conflict(t *testing.T) {
+	t.Run("should report a conflict when another operation changed the status first", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		admin := &entity.User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+		travel := &entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, travel.Id).Return(travel, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, travel, enums.TravelRequestStatusSolicited).Return(false, nil)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, admin.Id.String(), dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: travel.Id.String(),
+			Status:          enums.TravelRequestStatusCanceled,
+		})
+
+		// Assert
+		assert.Equal(t, ErrStatusChangedMeanwhile, err)
+		mockNotificationService.AssertNotCalled(t, "NotifyStatusChange", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_UpdateStatusTravelRequest_Webhooks(t *testing.T) {
+	ctx := context.Background()
+	admin := &entity.User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+
+	setup := func() (*TravelRequestUseCaseImpl, *MockTravelGateway, *recordingTravelEvents) {
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockNotificationService := new(MockNotificationService)
+		mockCostUseCase := new(MockCostUseCase)
+		events := &recordingTravelEvents{}
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, events, mockCostUseCase, new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		mockUserGateway.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mockTravelGateway.On("UpdateStatus", mock.Anything, mock.AnythingOfType("*entity.TravelRequest"), mock.AnythingOfType("enums.TravelRequestStatus")).Return(true, nil)
+		mockCostUseCase.On("CheckBudget", ctx, mock.Anything).Return(nil)
+		mockNotificationService.On("NotifyStatusChange", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		return useCase, mockTravelGateway, events
+	}
+
+	t.Run("should publish travel.approved and travel.canceled with the decision", func(t *testing.T) {
+		// Arrange
+		useCase, mockTravelGateway, events := setup()
+		approved := &entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+		canceled := &entity.TravelRequest{Id: uuid.New(), UserId: uuid.New(), Status: enums.TravelRequestStatusSolicited}
+		mockTravelGateway.On("FindByID", ctx, approved.Id).Return(approved, nil)
+		mockTravelGateway.On("FindByID", ctx, canceled.Id).Return(canceled, nil)
+
+		// Act
+		approveErr := useCase.UpdateStatusTravelRequest(ctx, admin.Id.String(), dto.UpdateStatusTravelRequestDTO{TravelRequestId: approved.Id.String(), Status: enums.TravelRequestStatusApproved})
+		cancelErr := useCase.UpdateStatusTravelRequest(ctx, admin.Id.String(), dto.UpdateStatusTravelRequestDTO{TravelRequestId: canceled.Id.String(), Status: enums.TravelRequestStatusCanceled})
+
+		// Assert
+		assert.NoError(t, approveErr)
+		assert.NoError(t, cancelErr)
+		assert.Equal(t, []enums.WebhookEvent{enums.WebhookEventTravelApproved, enums.WebhookEventTravelCanceled}, events.events)
+	})
+}
+
+func TestTravelRequestUseCase_SubmitTravelRequest(t *testing.T) {
+	ctx := context.Background()
+	user := &entity.User{Id: uuid.New(), Role: enums.UserTypeCommon}
+	departureDate := time.Now().AddDate(0, 1, 0)
+
+	setup := func() (*TravelRequestUseCaseImpl, *MockTravelGateway, *recordingTravelEvents) {
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		mockPolicyUseCase := new(MockPolicyUseCase)
+		events := &recordingTravelEvents{}
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, events, new(MockCostUseCase), mockPolicyUseCase, new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		mockUserGateway.On("FindByID", ctx, user.Id).Return(user, nil)
+		mockPolicyUseCase.On("Evaluate", ctx, mock.AnythingOfType("*entity.TravelRequest")).Return([]entity.TravelPolicyViolation{}, nil)
+		return useCase, mockTravelGateway, events
+	}
+
+	newDraft := func() *entity.TravelRequest {
+		return &entity.TravelRequest{Id: uuid.New(), UserId: user.Id, DestinationName: "Lisboa", DepartureDate: departureDate, Status: enums.TravelRequestStatusDraft}
+	}
+
+	t.Run("should check overlap, submit the draft and publish travel.created", func(t *testing.T) {
+		// Arrange
+		useCase, mockTravelGateway, events := setup()
+		draft := newDraft()
+		mockTravelGateway.On("FindByID", ctx, draft.Id).Return(draft, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, user.Id, []uuid.UUID{}, departureDate, (*time.Time)(nil), &draft.Id).Return([]uuid.UUID{}, nil)
+		mockTravelGateway.On("UpdateStatus", ctx, draft, enums.TravelRequestStatusDraft).Return(true, nil)
+		mockTravelGateway.On("ReplacePolicyViolations", ctx, draft).Return(nil)
+
+		// Act
+		result, err := useCase.SubmitTravelRequest(ctx, draft.Id, user.Id, dto.SubmitTravelRequestDTO{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.TravelRequestStatusSolicited, result.Status)
+		assert.Equal(t, []enums.WebhookEvent{enums.WebhookEventTravelCreated}, events.events)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should keep the draft when it overlaps another request", func(t *testing.T) {
+		// Arrange
+		useCase, mockTravelGateway, events := setup()
+		draft := newDraft()
+		conflict := uuid.New()
+		mockTravelGateway.On("FindByID", ctx, draft.Id).Return(draft, nil)
+		mockTravelGateway.On("FindOverlapping", ctx, user.Id, []uuid.UUID{}, departureDate, (*time.Time)(nil), &draft.Id).Return([]uuid.UUID{conflict}, nil)
+
+		// Act
+		result, err := useCase.SubmitTravelRequest(ctx, draft.Id, user.Id, dto.SubmitTravelRequestDTO{})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrOverlappingTravel)
+		assert.Equal(t, enums.TravelRequestStatusDraft, draft.Status)
+		assert.Empty(t, events.events)
+		mockTravelGateway.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should only submit drafts of the requester", func(t *testing.T) {
+		// Arrange
+		useCase, mockTravelGateway, _ := setup()
+		pending := newDraft()
+		pending.Status = enums.TravelRequestStatusSolicited
+		other := newDraft()
+		other.UserId = uuid.New()
+		mockTravelGateway.On("FindByID", ctx, pending.Id).Return(pending, nil)
+		mockTravelGateway.On("FindByID", ctx, other.Id).Return(other, nil)
+
+		// Act
+		_, pendingErr := useCase.SubmitTravelRequest(ctx, pending.Id, user.Id, dto.SubmitTravelRequestDTO{})
+		_, otherErr := useCase.SubmitTravelRequest(ctx, other.Id, user.Id, dto.SubmitTravelRequestDTO{})
+
+		// Assert
+		assert.Equal(t, ErrTravelNotDraft, pendingErr)
+		assert.Equal(t, ErrUnauthorized, otherErr)
+	})
+}
+
+func TestTravelRequestUseCase_UpdateStatusTravelRequest_Budget(t *testing.T) {
+	// Setup
+	mockTravelGateway := new(MockTravelGateway)
+	mockUserGateway := new(MockUserGateway)
+	mockNotificationService := new(MockNotificationService)
+	mockCostUseCase := new(MockCostUseCase)
+	mockPolicyUseCase := new(MockPolicyUseCase)
+	mockTravelerUseCase := new(MockTravelerUseCase)
+	useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, mockNotificationService, passthroughTransactor{}, discardTravelEvents{}, mockCostUseCase, mockPolicyUseCase, mockTravelerUseCase, new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+	ctx := context.Background()
+	adminID := uuid.New()
+	travelID := uuid.New()
+	department := "ENGINEERING"
+
+	admin := &entity.User{
+		Id:   adminID,
+		Name: "Admin User",
+		Role: enums.UserTypeAdmin,
+	}
+
+	t.Run("should not approve when department budget would be exceeded", func(t *testing.T) {
+		// Arrange
+		travel := &entity.TravelRequest{
+			Id:             travelID,
+			UserId:         uuid.New(),
+			Status:         enums.TravelRequestStatusSolicited,
+			Department:     &department,
+			EstimatedTotal: 5000,
+		}
+
+		input := dto.UpdateStatusTravelRequestDTO{
+			TravelRequestId: travelID.String(),
+			Status:          enums.TravelRequestStatusApproved,
+		}
+
+		budgetErr := fmt.Errorf("%w: departamento %s", ErrBudgetExceeded, department)
+		mockUserGateway.On("FindByID", ctx, adminID).Return(admin, nil)
+		mockTravelGateway.On("FindByID", ctx, travelID).Return(travel, nil)
+		mockCostUseCase.On("CheckBudget", ctx, travel).Return(budgetErr)
+
+		// Act
+		err := useCase.UpdateStatusTravelRequest(ctx, adminID.String(), input)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrBudgetExceeded)
+		assert.Equal(t, enums.TravelRequestStatusSolicited, travel.Status)
+		mockTravelGateway.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockNotificationService.AssertNotCalled(t, "NotifyStatusChange", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_ListApproverInbox(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should list pending requests of other users with status counts", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		adminID := uuid.New()
+		pending := []entity.TravelRequest{
+			{Id: uuid.New(), Status: enums.TravelRequestStatusSolicited, DepartureDate: time.Now().AddDate(0, 0, 3), DepartureTimezone: "UTC", CreatedAt: time.Now().Add(-2 * time.Hour)},
+		}
+		counts := map[enums.TravelRequestStatus]int{enums.TravelRequestStatusSolicited: 1, enums.TravelRequestStatusApproved: 4}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(&entity.User{Id: adminID, Role: enums.UserTypeAdmin}, nil)
+		mockTravelGateway.On("ListPendingApproval", ctx, adminID, utils.TravelRequestFilters{Page: 1, PageSize: 20}).Return(pending, nil)
+		mockTravelGateway.On("CountByStatusExcludingUser", ctx, adminID).Return(counts, nil)
+
+		// Act
+		inbox, err := useCase.ListApproverInbox(ctx, adminID, 1, 20)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, counts, inbox.StatusCounts)
+		assert.Len(t, inbox.Items, 1)
+		assert.Equal(t, pending[0].Id, inbox.Items[0].TravelRequest.Id)
+		assert.Equal(t, 2, inbox.Items[0].AgeHours)
+	})
+
+	t.Run("should reject users who are not approvers", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		userID := uuid.New()
+		mockUserGateway.On("FindByID", ctx, userID).Return(&entity.User{Id: userID, Role: enums.UserTypeCommon}, nil)
+
+		// Act
+		inbox, err := useCase.ListApproverInbox(ctx, userID, 1, 20)
+
+		// Assert
+		assert.Nil(t, inbox)
+		assert.Equal(t, ErrUnauthorized, err)
+		mockTravelGateway.AssertNotCalled(t, "ListPendingApproval", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_ListAllTravelRequests(t *testing.T) {
+	ctx := context.Background()
+	adminID := uuid.New()
+
+	t.Run("should clamp pagination and return the total", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		statuses := []enums.TravelRequestStatus{enums.TravelRequestStatusApproved}
+		travels := []entity.TravelRequest{{Id: uuid.New()}}
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(&entity.User{Id: adminID, Role: enums.UserTypeAdmin}, nil)
+		expectedFilters := utils.TravelRequestFilters{Statuses: statuses, Page: 1, PageSize: 100, SortBy: enums.TravelRequestSortCreatedAt, SortDesc: true}
+		mockTravelGateway.On("List", ctx, expectedFilters).Return(travels, int64(250), nil)
+
+		// Act
+		page, err := useCase.ListAllTravelRequests(ctx, adminID, utils.TravelRequestFilters{Statuses: statuses, PageSize: 500})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, travels, page.Items)
+		assert.Equal(t, 1, page.Page)
+		assert.Equal(t, 100, page.PageSize)
+		assert.Equal(t, int64(250), *page.Total)
+		assert.NotEmpty(t, page.NextCursor)
+	})
+
+	t.Run("should reject unknown statuses", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, mockUserGateway, new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		mockUserGateway.On("FindByID", ctx, adminID).Return(&entity.User{Id: adminID, Role: enums.UserTypeAdmin}, nil)
+
+		// Act
+		page, err := useCase.ListAllTravelRequests(ctx, adminID, utils.TravelRequestFilters{Statuses: []enums.TravelRequestStatus{"PENDING"}})
+
+		// Assert
+		assert.Nil(t, page)
+		assert.Equal(t, ErrInvalidStatusFilter, err)
+		mockTravelGateway.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_ListTravelRequests(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should return the page envelope and keep the sort", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		userID := uuid.New()
+		travels := []entity.TravelRequest{{Id: uuid.New()}, {Id: uuid.New()}}
+		expectedFilters := utils.TravelRequestFilters{Page: 3, PageSize: 20, SortBy: enums.TravelRequestSortDepartureDate, SortDesc: true}
+
+		mockTravelGateway.On("ListByUserID", ctx, userID, expectedFilters).Return(travels, int64(42), nil)
+
+		// Act
+		page, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{Page: 3, SortBy: enums.TravelRequestSortDepartureDate, SortDesc: true})
+
+		// Assert
+		assert.NoError(t, err)
+		total := int64(42)
+		assert.Equal(t, &entity.TravelRequestPage{Items: travels, Page: 3, PageSize: 20, Total: &total}, page)
+	})
+}
+
+func TestTravelRequestUseCase_ListTravelRequests_Cursor(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("should fetch one extra item to build the next cursor without counting", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		cursor := &utils.TravelRequestCursor{SortBy: enums.TravelRequestSortDepartureDate, Value: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), Id: uuid.New()}
+		travels := []entity.TravelRequest{
+			{Id: uuid.New(), DepartureDate: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)},
+			{Id: uuid.New(), DepartureDate: time.Date(2030, 1, 3, 0, 0, 0, 0, time.UTC)},
+			{Id: uuid.New(), DepartureDate: time.Date(2030, 1, 4, 0, 0, 0, 0, time.UTC)},
+		}
+		expectedFilters := utils.TravelRequestFilters{Page: 1, PageSize: 3, SortBy: enums.TravelRequestSortDepartureDate, Cursor: cursor}
+
+		mockTravelGateway.On("ListByUserID", ctx, userID, expectedFilters).Return(travels, int64(0), nil)
+
+		// Act
+		page, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{PageSize: 2, Cursor: cursor})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, travels[:2], page.Items)
+		assert.Nil(t, page.Total)
+		assert.Zero(t, page.Page)
+
+		next, err := utils.DecodeTravelRequestCursor(page.NextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, utils.TravelRequestCursor{SortBy: enums.TravelRequestSortDepartureDate, Value: travels[1].DepartureDate, Id: travels[1].Id}, *next)
+	})
+
+	t.Run("should omit the cursor on the last page", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		cursor := &utils.TravelRequestCursor{SortBy: enums.TravelRequestSortCreatedAt, SortDesc: true, Value: time.Now(), Id: uuid.New()}
+		travels := []entity.TravelRequest{{Id: uuid.New()}}
+		mockTravelGateway.On("ListByUserID", ctx, userID, mock.Anything).Return(travels, int64(0), nil)
+
+		// Act
+		page, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{PageSize: 2, Cursor: cursor})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, travels, page.Items)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("should reject a cursor generated for another sort", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		cursor := &utils.TravelRequestCursor{SortBy: enums.TravelRequestSortCreatedAt, SortDesc: true, Value: time.Now(), Id: uuid.New()}
+
+		// Act
+		page, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{SortBy: enums.TravelRequestSortStatus, Cursor: cursor})
+
+		// Assert
+		assert.Nil(t, page)
+		assert.Equal(t, utils.ErrInvalidCursor, err)
+		mockTravelGateway.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelRequestUseCase_ListTravelRequests_Search(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+
+	t.Run("should sort by relevance when searching without an explicit sort", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		search := "são paulo"
+		expectedFilters := utils.TravelRequestFilters{Search: &search, Page: 1, PageSize: 20, SortBy: enums.TravelRequestSortRelevance, SortDesc: true}
+		mockTravelGateway.On("ListByUserID", ctx, userID, expectedFilters).Return([]entity.TravelRequest{{Id: uuid.New()}}, int64(30), nil)
+
+		// Act
+		page, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{Search: &search})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, page.NextCursor)
+		mockTravelGateway.AssertExpectations(t)
+	})
+
+	t.Run("should ignore blank searches and relevance without a search", func(t *testing.T) {
+		// Arrange
+		mockTravelGateway := new(MockTravelGateway)
+		useCase := NewTravelRequestUseCase(mockTravelGateway, new(MockUserGateway), new(MockNotificationService), passthroughTransactor{}, discardTravelEvents{}, new(MockCostUseCase), new(MockPolicyUseCase), new(MockTravelerUseCase), new(MockTravelGroupGateway), new(MockDestinationUseCase), new(MockTravelTemplateGateway), new(MockTravelSeriesGateway), "America/Sao_Paulo")
+
+		blank := "  "
+		expectedFilters := utils.TravelRequestFilters{Page: 1, PageSize: 20, SortBy: enums.TravelRequestSortCreatedAt, SortDesc: true}
+		mockTravelGateway.On("ListByUserID", ctx, userID, expectedFilters).Return([]entity.TravelRequest{}, int64(0), nil)
+
+		// Act
+		_, err := useCase.ListTravelRequests(ctx, userID, utils.TravelRequestFilters{Search: &blank, SortBy: enums.TravelRequestSortRelevance})
+
+		// Assert
+		assert.NoError(t, err)
+		mockTravelGateway.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTraveler  = errors.New("nome e e-mail do viajante são obrigatórios")
+	ErrTravelerRequired = errors.New("informe o nome do viajante ou os viajantes cadastrados")
+)
+
+type TravelerUseCase interface {
+	CreateTraveler(ctx context.Context, userID uuid.UUID, input dto.CreateTravelerDTO) (*entity.Traveler, error)
+	UpdateTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelerDTO) (*entity.Traveler, error)
+	GetTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Traveler, error)
+	ListTravelers(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error)
+	AddDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, input dto.TravelerDelegateDTO) error
+	RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, delegateUserID uuid.UUID) error
+	ResolveForBooking(ctx context.Context, user *entity.User, ids []uuid.UUID) ([]entity.Traveler, error)
+}
+
+type TravelerUseCaseImpl struct {
+	travelerGateway gateway.TravelerGateway
+	userGateway     gateway.UserGateway
+}
+
+func NewTravelerUseCase(travelerGateway gateway.TravelerGateway, userGateway gateway.UserGateway) *TravelerUseCaseImpl {
+	return &TravelerUseCaseImpl{
+		travelerGateway: travelerGateway,
+		userGateway:     userGateway,
+	}
+}
+
+func (uc *TravelerUseCaseImpl) CreateTraveler(ctx context.Context, userID uuid.UUID, input dto.CreateTravelerDTO) (*entity.Traveler, error) {
+	if strings.TrimSpace(input.Name) == "" || strings.TrimSpace(input.Email) == "" {
+		return nil, ErrInvalidTraveler
+	}
+
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.UserId != nil && *input.UserId != user.Id && user.Role != enums.UserTypeAdmin {
+		return nil, ErrUnauthorized
+	}
+
+	traveler := &entity.Traveler{
+		Id:                uuid.New(),
+		OrganizationId:    user.OrganizationId,
+		Name:              input.Name,
+		Email:             input.Email,
+		EmployeeId:        input.EmployeeId,
+		UserId:            input.UserId,
+		Nationality:       upperPtr(input.Nationality),
+		PassportNumber:    input.PassportNumber,
+		PassportCountry:   upperPtr(input.PassportCountry),
+		PassportExpiresAt: input.PassportExpiresAt,
+		CreatedBy:         user.Id,
+		CreatedAt:         time.Now(),
+	}
+
+	if err := uc.travelerGateway.Create(ctx, traveler); err != nil {
+		return nil, err
+	}
+
+	return traveler, nil
+}
+
+func (uc *TravelerUseCaseImpl) UpdateTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelerDTO) (*entity.Traveler, error) {
+	traveler, err := uc.findManageable(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		traveler.Name = *input.Name
+	}
+
+	if input.Email != nil {
+		traveler.Email = *input.Email
+	}
+
+	if input.EmployeeId != nil {
+		traveler.EmployeeId = input.EmployeeId
+	}
+
+	if input.Nationality != nil {
+		traveler.Nationality = upperPtr(input.Nationality)
+	}
+
+	if input.PassportNumber != nil {
+		traveler.PassportNumber = input.PassportNumber
+	}
+
+	if input.PassportCountry != nil {
+		traveler.PassportCountry = upperPtr(input.PassportCountry)
+	}
+
+	if input.PassportExpiresAt != nil {
+		traveler.PassportExpiresAt = input.PassportExpiresAt
+	}
+
+	if strings.TrimSpace(traveler.Name) == "" || strings.TrimSpace(traveler.Email) == "" {
+		return nil, ErrInvalidTraveler
+	}
+
+	now := time.Now()
+	traveler.UpdatedAt = &now
+
+	if err := uc.travelerGateway.Update(ctx, traveler); err != nil {
+		return nil, err
+	}
+
+	return traveler, nil
+}
+
+func (uc *TravelerUseCaseImpl) GetTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Traveler, error) {
+	return uc.findManageable(ctx, id, userID)
+}
+
+func (uc *TravelerUseCaseImpl) ListTravelers(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error) {
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Role == enums.UserTypeAdmin {
+		return uc.travelerGateway.ListAll(ctx)
+	}
+
+	return uc.travelerGateway.ListManageableBy(ctx, userID)
+}
+
+func (uc *TravelerUseCaseImpl) AddDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, input dto.TravelerDelegateDTO) error {
+	if _, err := uc.findManageable(ctx, travelerID, userID); err != nil {
+		return err
+	}
+
+	if _, err := uc.userGateway.FindByID(ctx, input.UserId); err != nil {
+		return err
+	}
+
+	return uc.travelerGateway.AddDelegate(ctx, &entity.TravelerDelegate{
+		TravelerId: travelerID,
+		UserId:     input.UserId,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func (uc *TravelerUseCaseImpl) RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, delegateUserID uuid.UUID) error {
+	if _, err := uc.findManageable(ctx, travelerID, userID); err != nil {
+		return err
+	}
+
+	return uc.travelerGateway.RemoveDelegate(ctx, travelerID, delegateUserID)
+}
+
+// ResolveForBooking carrega os viajantes informados garantindo que o usuário pode solicitar viagens para todos eles.
+func (uc *TravelerUseCaseImpl) ResolveForBooking(ctx context.Context, user *entity.User, ids []uuid.UUID) ([]entity.Traveler, error) {
+	unique := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	travelers, err := uc.travelerGateway.FindByIDs(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range travelers {
+		if !travelers[i].CanBeManagedBy(user) {
+			return nil, ErrUnauthorized
+		}
+	}
+
+	return travelers, nil
+}
+
+func (uc *TravelerUseCaseImpl) findManageable(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Traveler, error) {
+	user, err := uc.userGateway.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	traveler, err := uc.travelerGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !traveler.CanBeManagedBy(user) {
+		return nil, ErrUnauthorized
+	}
+
+	return traveler, nil
+}
+
+func upperPtr(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	upper := strings.ToUpper(*value)
+	return &upper
+}
@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTravelerGateway struct {
+	mock.Mock
+}
+
+func (m *MockTravelerGateway) Create(ctx context.Context, traveler *entity.Traveler) error {
+	args := m.Called(ctx, traveler)
+	return args.Error(0)
+}
+
+func (m *MockTravelerGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.Traveler, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerGateway) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Traveler, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerGateway) Update(ctx context.Context, traveler *entity.Traveler) error {
+	args := m.Called(ctx, traveler)
+	return args.Error(0)
+}
+
+func (m *MockTravelerGateway) ListManageableBy(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerGateway) ListAll(ctx context.Context) ([]entity.Traveler, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerGateway) AddDelegate(ctx context.Context, delegate *entity.TravelerDelegate) error {
+	args := m.Called(ctx, delegate)
+	return args.Error(0)
+}
+
+func (m *MockTravelerGateway) RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID) error {
+	args := m.Called(ctx, travelerID, userID)
+	return args.Error(0)
+}
+
+type MockTravelerUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTravelerUseCase) CreateTraveler(ctx context.Context, userID uuid.UUID, input dto.CreateTravelerDTO) (*entity.Traveler, error) {
+	args := m.Called(ctx, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerUseCase) UpdateTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID, input dto.UpdateTravelerDTO) (*entity.Traveler, error) {
+	args := m.Called(ctx, id, userID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerUseCase) GetTraveler(ctx context.Context, id uuid.UUID, userID uuid.UUID) (*entity.Traveler, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerUseCase) ListTravelers(ctx context.Context, userID uuid.UUID) ([]entity.Traveler, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]entity.Traveler), args.Error(1)
+}
+
+func (m *MockTravelerUseCase) AddDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, input dto.TravelerDelegateDTO) error {
+	args := m.Called(ctx, travelerID, userID, input)
+	return args.Error(0)
+}
+
+func (m *MockTravelerUseCase) RemoveDelegate(ctx context.Context, travelerID uuid.UUID, userID uuid.UUID, delegateUserID uuid.UUID) error {
+	args := m.Called(ctx, travelerID, userID, delegateUserID)
+	return args.Error(0)
+}
+
+func (m *MockTravelerUseCase) ResolveForBooking(ctx context.Context, user *entity.User, ids []uuid.UUID) ([]entity.Traveler, error) {
+	args := m.Called(ctx, user, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Traveler), args.Error(1)
+}
+
+func TestTravelerUseCase_CreateTraveler(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	user := &entity.User{Id: userID, OrganizationId: uuid.New(), Name: "Assistant", Role: enums.UserTypeCommon}
+
+	t.Run("should create a traveler owned by the caller", func(t *testing.T) {
+		// Arrange
+		mockTravelerGateway := new(MockTravelerGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelerUseCase(mockTravelerGateway, mockUserGateway)
+
+		country := "br"
+		input := dto.CreateTravelerDTO{Name: "Maria Souza", Email: "maria@example.com", Nationality: &country}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+		mockTravelerGateway.On("Create", ctx, mock.AnythingOfType("*entity.Traveler")).Return(nil)
+
+		// Act
+		result, err := useCase.CreateTraveler(ctx, userID, input)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, userID, result.CreatedBy)
+		assert.Equal(t, user.OrganizationId, result.OrganizationId)
+		assert.Equal(t, "BR", *result.Nationality)
+		mockTravelerGateway.AssertExpectations(t)
+	})
+
+	t.Run("should not let common users link another user", func(t *testing.T) {
+		// Arrange
+		mockTravelerGateway := new(MockTravelerGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelerUseCase(mockTravelerGateway, mockUserGateway)
+
+		otherID := uuid.New()
+		input := dto.CreateTravelerDTO{Name: "Maria Souza", Email: "maria@example.com", UserId: &otherID}
+
+		mockUserGateway.On("FindByID", ctx, userID).Return(user, nil)
+
+		// Act
+		result, err := useCase.CreateTraveler(ctx, userID, input)
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+		mockTravelerGateway.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTravelerUseCase_ResolveForBooking(t *testing.T) {
+	ctx := context.Background()
+	user := &entity.User{Id: uuid.New(), Name: "Assistant", Role: enums.UserTypeCommon}
+	travelerID := uuid.New()
+
+	t.Run("should deduplicate ids and accept delegated travelers", func(t *testing.T) {
+		// Arrange
+		mockTravelerGateway := new(MockTravelerGateway)
+		useCase := NewTravelerUseCase(mockTravelerGateway, new(MockUserGateway))
+
+		traveler := entity.Traveler{
+			Id:        travelerID,
+			Name:      "Maria Souza",
+			CreatedBy: uuid.New(),
+			Delegates: []entity.TravelerDelegate{{TravelerId: travelerID, UserId: user.Id}},
+		}
+
+		mockTravelerGateway.On("FindByIDs", ctx, []uuid.UUID{travelerID}).Return([]entity.Traveler{traveler}, nil)
+
+		// Act
+		result, err := useCase.ResolveForBooking(ctx, user, []uuid.UUID{travelerID, travelerID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockTravelerGateway.AssertExpectations(t)
+	})
+
+	t.Run("should reject travelers the user cannot manage", func(t *testing.T) {
+		// Arrange
+		mockTravelerGateway := new(MockTravelerGateway)
+		useCase := NewTravelerUseCase(mockTravelerGateway, new(MockUserGateway))
+
+		traveler := entity.Traveler{Id: travelerID, Name: "Maria Souza", CreatedBy: uuid.New()}
+		mockTravelerGateway.On("FindByIDs", ctx, []uuid.UUID{travelerID}).Return([]entity.Traveler{traveler}, nil)
+
+		// Act
+		result, err := useCase.ResolveForBooking(ctx, user, []uuid.UUID{travelerID})
+
+		// Assert
+		assert.Nil(t, result)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
+func TestTravelerUseCase_ListTravelers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should list every traveler for admins", func(t *testing.T) {
+		// Arrange
+		mockTravelerGateway := new(MockTravelerGateway)
+		mockUserGateway := new(MockUserGateway)
+		useCase := NewTravelerUseCase(mockTravelerGateway, mockUserGateway)
+
+		adminID := uuid.New()
+		mockUserGateway.On("FindByID", ctx, adminID).Return(&entity.User{Id: adminID, Role: enums.UserTypeAdmin}, nil)
+		mockTravelerGateway.On("ListAll", ctx).Return([]entity.Traveler{{Id: uuid.New()}}, nil)
+
+		// Act
+		result, err := useCase.ListTravelers(ctx, adminID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockTravelerGateway.AssertNotCalled(t, "ListManageableBy", mock.Anything, mock.Anything)
+	})
+}
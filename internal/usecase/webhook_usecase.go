@@ -0,0 +1,403 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/domain/gateway"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxTopicWebhook identifica as mensagens do outbox entregues pelo WebhookUseCase.Deliver.
+const OutboxTopicWebhook = "webhook"
+
+const (
+	webhookDeliveryListLimit = 100
+	webhookSecretMinLength   = 16
+)
+
+var (
+	ErrInvalidWebhookUrl           = errors.New("URL do webhook deve ser http ou https")
+	ErrWebhookUrlNotAllowed        = errors.New("URL do webhook não pode apontar para a rede interna")
+	ErrInvalidWebhookEvent         = errors.New("evento de webhook inválido")
+	ErrWebhookSecretTooShort       = errors.New("segredo do webhook deve ter ao menos 16 caracteres")
+	ErrWebhookSubscriptionInactive = errors.New("assinatura de webhook inativa ou sem o evento")
+)
+
+// TravelEventPublisher registra os eventos de uma solicitação para as assinaturas de webhook.
+// Chamado dentro da transação da alteração, como as notificações.
+type TravelEventPublisher interface {
+	PublishTravelEvent(ctx context.Context, event enums.WebhookEvent, travelRequest *entity.TravelRequest) error
+}
+
+type WebhookUseCase interface {
+	TravelEventPublisher
+	CreateSubscription(ctx context.Context, userID uuid.UUID, input dto.CreateWebhookSubscriptionDTO) (*dto.WebhookSubscriptionCreatedDTO, error)
+	ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]entity.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.UpdateWebhookSubscriptionDTO) (*entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+	ListDeliveries(ctx context.Context, userID uuid.UUID, subscriptionID uuid.UUID) ([]entity.WebhookDelivery, error)
+	Redeliver(ctx context.Context, userID uuid.UUID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error)
+	Deliver(ctx context.Context, message entity.OutboxMessage) error
+}
+
+type WebhookUseCaseImpl struct {
+	subscriptionGateway gateway.WebhookSubscriptionGateway
+	deliveryGateway     gateway.WebhookDeliveryGateway
+	outboxGateway       gateway.OutboxGateway
+	userGateway         gateway.UserGateway
+	sender              gateway.WebhookSender
+	transactor          gateway.Transactor
+	now                 func() time.Time
+	lookupIP            func(ctx context.Context, host string) ([]net.IP, error)
+}
+
+func NewWebhookUseCase(
+	subscriptionGateway gateway.WebhookSubscriptionGateway,
+	deliveryGateway gateway.WebhookDeliveryGateway,
+	outboxGateway gateway.OutboxGateway,
+	userGateway gateway.UserGateway,
+	sender gateway.WebhookSender,
+	transactor gateway.Transactor,
+) *WebhookUseCaseImpl {
+	return &WebhookUseCaseImpl{
+		subscriptionGateway: subscriptionGateway,
+		deliveryGateway:     deliveryGateway,
+		outboxGateway:       outboxGateway,
+		userGateway:         userGateway,
+		sender:              sender,
+		transactor:          transactor,
+		now:                 time.Now,
+		lookupIP: func(ctx context.Context, host string) ([]net.IP, error) {
+			return net.DefaultResolver.LookupIP(ctx, "ip", host)
+		},
+	}
+}
+
+// webhookOutboxMessage é o conteúdo da mensagem do outbox de uma entrega; o corpo enviado fica
+// na própria entrega.
+type webhookOutboxMessage struct {
+	DeliveryId uuid.UUID `json:"delivery_id"`
+}
+
+func (uc *WebhookUseCaseImpl) CreateSubscription(ctx context.Context, userID uuid.UUID, input dto.CreateWebhookSubscriptionDTO) (*dto.WebhookSubscriptionCreatedDTO, error) {
+	admin, err := requireAdmin(ctx, uc.userGateway, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.validateWebhook(ctx, input.Url, input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := input.Secret
+	if secret == "" {
+		if secret, err = generateWebhookSecret(); err != nil {
+			return nil, err
+		}
+	} else if len(secret) < webhookSecretMinLength {
+		return nil, ErrWebhookSecretTooShort
+	}
+
+	subscription := &entity.WebhookSubscription{
+		Id:             uuid.New(),
+		OrganizationId: admin.OrganizationId,
+		Url:            input.Url,
+		Secret:         secret,
+		Events:         events,
+		Active:         true,
+		CreatedBy:      admin.Id,
+		CreatedAt:      uc.now(),
+	}
+
+	if err := uc.subscriptionGateway.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return &dto.WebhookSubscriptionCreatedDTO{WebhookSubscription: *subscription, Secret: secret}, nil
+}
+
+func (uc *WebhookUseCaseImpl) ListSubscriptions(ctx context.Context, userID uuid.UUID) ([]entity.WebhookSubscription, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.subscriptionGateway.List(ctx)
+}
+
+// UpdateSubscription troca a URL, os eventos e a situação da assinatura; o segredo não muda.
+func (uc *WebhookUseCaseImpl) UpdateSubscription(ctx context.Context, userID uuid.UUID, id uuid.UUID, input dto.UpdateWebhookSubscriptionDTO) (*entity.WebhookSubscription, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.validateWebhook(ctx, input.Url, input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := uc.subscriptionGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	subscription.Url = input.Url
+	subscription.Events = events
+	subscription.Active = input.Active
+	subscription.UpdatedAt = &now
+
+	if err := uc.subscriptionGateway.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// DeleteSubscription remove a assinatura com o histórico de entregas; entregas ainda na fila
+// são descartadas pelo worker.
+func (uc *WebhookUseCaseImpl) DeleteSubscription(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return err
+	}
+
+	return uc.subscriptionGateway.Delete(ctx, id)
+}
+
+func (uc *WebhookUseCaseImpl) ListDeliveries(ctx context.Context, userID uuid.UUID, subscriptionID uuid.UUID) ([]entity.WebhookDelivery, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.subscriptionGateway.FindByID(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return uc.deliveryGateway.ListBySubscription(ctx, subscriptionID, webhookDeliveryListLimit)
+}
+
+// Redeliver envia de novo uma entrega, com o mesmo ID e corpo, para que o destinatário possa
+// reconhecê-la caso já a tenha processado.
+func (uc *WebhookUseCaseImpl) Redeliver(ctx context.Context, userID uuid.UUID, deliveryID uuid.UUID) (*entity.WebhookDelivery, error) {
+	if _, err := requireAdmin(ctx, uc.userGateway, userID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.deliveryGateway.FindByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := uc.subscriptionGateway.FindByID(ctx, delivery.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if !subscription.Subscribes(delivery.Event) {
+		return nil, ErrWebhookSubscriptionInactive
+	}
+
+	now := uc.now()
+	delivery.Status = enums.WebhookDeliveryStatusPending
+	delivery.UpdatedAt = &now
+
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.deliveryGateway.Update(ctx, delivery); err != nil {
+			return err
+		}
+		return uc.enqueue(ctx, []entity.WebhookDelivery{*delivery})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// PublishTravelEvent grava uma entrega por assinatura ativa da organização que recebe o evento.
+func (uc *WebhookUseCaseImpl) PublishTravelEvent(ctx context.Context, event enums.WebhookEvent, travelRequest *entity.TravelRequest) error {
+	subscriptions, err := uc.subscriptionGateway.ListActiveByEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	now := uc.now()
+	deliveries := make([]entity.WebhookDelivery, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		id := uuid.New()
+		payload, err := json.Marshal(dto.WebhookEventDTO{
+			Id:         id,
+			Event:      event,
+			OccurredAt: now,
+			Data:       newWebhookTravelRequest(travelRequest),
+		})
+		if err != nil {
+			return err
+		}
+
+		deliveries = append(deliveries, entity.WebhookDelivery{
+			Id:             id,
+			OrganizationId: subscription.OrganizationId,
+			SubscriptionId: subscription.Id,
+			Event:          event,
+			Payload:        payload,
+			Status:         enums.WebhookDeliveryStatusPending,
+			CreatedAt:      now,
+		})
+	}
+
+	if err := uc.deliveryGateway.Create(ctx, deliveries); err != nil {
+		return err
+	}
+
+	return uc.enqueue(ctx, deliveries)
+}
+
+// Deliver é o OutboxHandler das entregas de webhook. O erro do envio é devolvido ao outbox, que
+// agenda a próxima tentativa com espera exponencial; entregas de assinaturas removidas, desativadas
+// ou que deixaram de receber o evento são encerradas sem novas tentativas.
+func (uc *WebhookUseCaseImpl) Deliver(ctx context.Context, message entity.OutboxMessage) error {
+	var content webhookOutboxMessage
+	if err := json.Unmarshal(message.Payload, &content); err != nil || content.DeliveryId == uuid.Nil {
+		return fmt.Errorf("%w: %v", ErrOutboxPayloadInvalid, err)
+	}
+
+	delivery, err := uc.deliveryGateway.FindForDelivery(ctx, content.DeliveryId)
+	if err != nil {
+		return err
+	}
+	if delivery == nil {
+		return nil
+	}
+
+	if !delivery.Subscription.Subscribes(delivery.Event) {
+		delivery.RecordAttempt(0, ErrWebhookSubscriptionInactive, uc.now())
+		return uc.deliveryGateway.Update(ctx, delivery)
+	}
+
+	status, sendErr := uc.sender.Send(ctx, delivery.Subscription, delivery)
+	delivery.RecordAttempt(status, sendErr, uc.now())
+	if err := uc.deliveryGateway.Update(ctx, delivery); err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+func (uc *WebhookUseCaseImpl) enqueue(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	messages := make([]entity.OutboxMessage, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		message, err := entity.NewOutboxMessage(delivery.OrganizationId, OutboxTopicWebhook, webhookOutboxMessage{DeliveryId: delivery.Id}, uc.now())
+		if err != nil {
+			return err
+		}
+		messages = append(messages, *message)
+	}
+
+	return uc.outboxGateway.Enqueue(ctx, messages)
+}
+
+// validateWebhook confere a URL e devolve os eventos sem repetições. O destino não pode resolver
+// para a rede interna; como o DNS pode mudar depois do cadastro, o HTTPSender repete a checagem
+// a cada conexão.
+func (uc *WebhookUseCaseImpl) validateWebhook(ctx context.Context, rawURL string, events []enums.WebhookEvent) ([]string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Hostname() == "" {
+		return nil, ErrInvalidWebhookUrl
+	}
+
+	if err := uc.checkWebhookHost(ctx, parsed.Hostname()); err != nil {
+		return nil, err
+	}
+
+	unique := make([]string, 0, len(events))
+	seen := make(map[enums.WebhookEvent]bool, len(events))
+	for _, event := range events {
+		if !event.IsValid() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidWebhookEvent, event)
+		}
+		if !seen[event] {
+			seen[event] = true
+			unique = append(unique, string(event))
+		}
+	}
+
+	return unique, nil
+}
+
+func (uc *WebhookUseCaseImpl) checkWebhookHost(ctx context.Context, host string) error {
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		var err error
+		if ips, err = uc.lookupIP(ctx, host); err != nil || len(ips) == 0 {
+			return fmt.Errorf("%w: %s não foi encontrado", ErrInvalidWebhookUrl, host)
+		}
+	}
+
+	for _, ip := range ips {
+		if !entity.IsWebhookAddressAllowed(ip) {
+			return ErrWebhookUrlNotAllowed
+		}
+	}
+
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
+
+// newWebhookTravelRequest copia apenas os dados da solicitação que saem da API; os dados do
+// solicitante ficam de fora.
+func newWebhookTravelRequest(travelRequest *entity.TravelRequest) dto.WebhookTravelRequestDTO {
+	return dto.WebhookTravelRequestDTO{
+		Id:                travelRequest.Id,
+		Status:            travelRequest.Status,
+		RequesterId:       travelRequest.UserId,
+		TravelerName:      travelRequest.TravelerName,
+		DestinationName:   travelRequest.DestinationName,
+		DepartureDate:     travelRequest.DepartureDate,
+		ReturnDate:        travelRequest.ReturnDate,
+		DepartureTimezone: travelRequest.DepartureTimezone,
+		ReturnTimezone:    travelRequest.ReturnTimezone,
+		Department:        travelRequest.Department,
+		BusinessPurpose:   travelRequest.BusinessPurpose,
+		Currency:          travelRequest.Currency,
+		EstimatedTotal:    travelRequest.EstimatedTotal,
+		GroupId:           travelRequest.GroupId,
+		ApprovedBy:        travelRequest.ApprovedBy,
+		ApprovedAt:        travelRequest.ApprovedAt,
+		CanceledBy:        travelRequest.CanceledBy,
+		CanceledAt:        travelRequest.CanceledAt,
+		CreatedAt:         travelRequest.CreatedAt,
+	}
+}
+
+// travelStatusEvent devolve o evento de webhook de uma decisão sobre a solicitação.
+func travelStatusEvent(status enums.TravelRequestStatus) (enums.WebhookEvent, bool) {
+	switch status {
+	case enums.TravelRequestStatusApproved:
+		return enums.WebhookEventTravelApproved, true
+	case enums.TravelRequestStatusCanceled:
+		return enums.WebhookEventTravelCanceled, true
+	}
+	return "", false
+}
@@ -0,0 +1,428 @@
+package usecase
+
+import (
+	"challenge-travel-api/internal/domain/entity"
+	"challenge-travel-api/internal/domain/enums"
+	"challenge-travel-api/internal/interface/dto"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockWebhookSubscriptionGateway struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSubscriptionGateway) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionGateway) List(ctx context.Context) ([]entity.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionGateway) ListActiveByEvent(ctx context.Context, event enums.WebhookEvent) ([]entity.WebhookSubscription, error) {
+	args := m.Called(ctx, event)
+	return args.Get(0).([]entity.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionGateway) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionGateway) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockWebhookDeliveryGateway struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDeliveryGateway) Create(ctx context.Context, deliveries []entity.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryGateway) FindByID(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryGateway) FindForDelivery(ctx context.Context, id uuid.UUID) (*entity.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryGateway) ListBySubscription(ctx context.Context, subscriptionID uuid.UUID, limit int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, subscriptionID, limit)
+	return args.Get(0).([]entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryGateway) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSender) Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	args := m.Called(ctx, subscription, delivery)
+	return args.Int(0), args.Error(1)
+}
+
+type webhookMocks struct {
+	subscriptions *MockWebhookSubscriptionGateway
+	deliveries    *MockWebhookDeliveryGateway
+	outbox        *MockOutboxGateway
+	users         *MockUserGateway
+	sender        *MockWebhookSender
+}
+
+func newWebhookUseCaseForTest(now time.Time) (*WebhookUseCaseImpl, webhookMocks) {
+	mocks := webhookMocks{
+		subscriptions: new(MockWebhookSubscriptionGateway),
+		deliveries:    new(MockWebhookDeliveryGateway),
+		outbox:        new(MockOutboxGateway),
+		users:         new(MockUserGateway),
+		sender:        new(MockWebhookSender),
+	}
+	useCase := NewWebhookUseCase(mocks.subscriptions, mocks.deliveries, mocks.outbox, mocks.users, mocks.sender, passthroughTransactor{})
+	useCase.now = func() time.Time { return now }
+	useCase.lookupIP = func(ctx context.Context, host string) ([]net.IP, error) {
+		switch host {
+		case "booking.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34")}, nil
+		case "rebind.example.com":
+			return []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("10.0.0.5")}, nil
+		}
+		return nil, errors.New("host não encontrado")
+	}
+	return useCase, mocks
+}
+
+func TestWebhookUseCase_CreateSubscription(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+	admin := &entity.User{Id: uuid.New(), OrganizationId: uuid.New(), Role: enums.UserTypeAdmin}
+
+	t.Run("should generate a secret and return it only on creation", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		mocks.users.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mocks.subscriptions.On("Create", ctx, mock.AnythingOfType("*entity.WebhookSubscription")).Return(nil)
+
+		// Act
+		created, err := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{
+			Url:    "https://booking.example.com/hooks",
+			Events: []enums.WebhookEvent{enums.WebhookEventTravelApproved, enums.WebhookEventTravelApproved},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Regexp(t, "^whsec_[0-9a-f]{64}$", created.Secret)
+		assert.Equal(t, created.Secret, created.WebhookSubscription.Secret)
+		assert.Equal(t, pq.StringArray{"travel.approved"}, created.Events)
+		assert.Equal(t, admin.OrganizationId, created.OrganizationId)
+		assert.True(t, created.Active)
+	})
+
+	t.Run("should reject invalid urls, events and short secrets", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		mocks.users.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		events := []enums.WebhookEvent{enums.WebhookEventTravelCreated}
+
+		// Act
+		_, urlErr := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{Url: "ftp://booking.example.com", Events: events})
+		_, eventErr := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{Url: "https://booking.example.com", Events: []enums.WebhookEvent{"travel.deleted"}})
+		_, secretErr := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{Url: "https://booking.example.com", Events: events, Secret: "curto"})
+
+		// Assert
+		assert.ErrorIs(t, urlErr, ErrInvalidWebhookUrl)
+		assert.ErrorIs(t, eventErr, ErrInvalidWebhookEvent)
+		assert.ErrorIs(t, secretErr, ErrWebhookSecretTooShort)
+		mocks.subscriptions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject urls that reach the internal network", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		mocks.users.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		events := []enums.WebhookEvent{enums.WebhookEventTravelCreated}
+
+		for _, target := range []string{
+			"http://127.0.0.1:8080/hooks",
+			"http://[::1]/hooks",
+			"http://10.0.0.5/hooks",
+			"http://192.168.1.10/hooks",
+			"http://169.254.169.254/latest/meta-data",
+			"https://rebind.example.com/hooks",
+		} {
+			// Act
+			_, err := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{Url: target, Events: events})
+
+			// Assert
+			assert.ErrorIs(t, err, ErrWebhookUrlNotAllowed, target)
+		}
+
+		_, err := useCase.CreateSubscription(ctx, admin.Id, dto.CreateWebhookSubscriptionDTO{Url: "https://unknown.example.com/hooks", Events: events})
+		assert.ErrorIs(t, err, ErrInvalidWebhookUrl)
+		mocks.subscriptions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should restrict subscriptions to admins", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		common := &entity.User{Id: uuid.New(), Role: enums.UserTypeCommon}
+		mocks.users.On("FindByID", ctx, common.Id).Return(common, nil)
+
+		// Act
+		_, err := useCase.CreateSubscription(ctx, common.Id, dto.CreateWebhookSubscriptionDTO{Url: "https://booking.example.com"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestWebhookUseCase_PublishTravelEvent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should record one delivery and outbox message per subscription", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		organizationID := uuid.New()
+		subscriptions := []entity.WebhookSubscription{
+			{Id: uuid.New(), OrganizationId: organizationID},
+			{Id: uuid.New(), OrganizationId: organizationID},
+		}
+		travelRequest := &entity.TravelRequest{
+			Id:              uuid.New(),
+			OrganizationId:  organizationID,
+			DestinationName: "Lisboa",
+			Status:          enums.TravelRequestStatusApproved,
+			User:            entity.User{Password: "hash"},
+		}
+		mocks.subscriptions.On("ListActiveByEvent", ctx, enums.WebhookEventTravelApproved).Return(subscriptions, nil)
+		mocks.deliveries.On("Create", ctx, mock.Anything).Return(nil)
+		mocks.outbox.On("Enqueue", ctx, mock.Anything).Return(nil)
+
+		// Act
+		err := useCase.PublishTravelEvent(ctx, enums.WebhookEventTravelApproved, travelRequest)
+
+		// Assert
+		require.NoError(t, err)
+		deliveries := mocks.deliveries.Calls[0].Arguments.Get(1).([]entity.WebhookDelivery)
+		messages := mocks.outbox.Calls[0].Arguments.Get(1).([]entity.OutboxMessage)
+		require.Len(t, deliveries, 2)
+		require.Len(t, messages, 2)
+		assert.Equal(t, subscriptions[1].Id, deliveries[1].SubscriptionId)
+		assert.Equal(t, enums.WebhookDeliveryStatusPending, deliveries[0].Status)
+		assert.Equal(t, OutboxTopicWebhook, messages[0].Topic)
+		assert.JSONEq(t, `{"delivery_id":"`+deliveries[0].Id.String()+`"}`, string(messages[0].Payload))
+
+		var payload dto.WebhookEventDTO
+		require.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+		assert.Equal(t, deliveries[0].Id, payload.Id)
+		assert.Equal(t, enums.WebhookEventTravelApproved, payload.Event)
+		assert.Equal(t, travelRequest.Id, payload.Data.Id)
+		assert.NotContains(t, string(deliveries[0].Payload), "hash")
+	})
+
+	t.Run("should skip organizations without subscriptions", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		mocks.subscriptions.On("ListActiveByEvent", ctx, enums.WebhookEventTravelCreated).Return([]entity.WebhookSubscription{}, nil)
+
+		// Act
+		err := useCase.PublishTravelEvent(ctx, enums.WebhookEventTravelCreated, &entity.TravelRequest{})
+
+		// Assert
+		assert.NoError(t, err)
+		mocks.deliveries.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		mocks.outbox.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWebhookUseCase_Deliver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	newMessage := func(deliveryID uuid.UUID) entity.OutboxMessage {
+		message, err := entity.NewOutboxMessage(uuid.New(), OutboxTopicWebhook, webhookOutboxMessage{DeliveryId: deliveryID}, now)
+		require.NoError(t, err)
+		return *message
+	}
+
+	newDelivery := func() *entity.WebhookDelivery {
+		subscription := &entity.WebhookSubscription{Id: uuid.New(), Active: true, Events: pq.StringArray{"travel.approved"}}
+		return &entity.WebhookDelivery{
+			Id:             uuid.New(),
+			SubscriptionId: subscription.Id,
+			Event:          enums.WebhookEventTravelApproved,
+			Status:         enums.WebhookDeliveryStatusPending,
+			Subscription:   subscription,
+		}
+	}
+
+	t.Run("should record a successful delivery", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		delivery := newDelivery()
+		mocks.deliveries.On("FindForDelivery", ctx, delivery.Id).Return(delivery, nil)
+		mocks.sender.On("Send", ctx, delivery.Subscription, delivery).Return(204, nil)
+		mocks.deliveries.On("Update", ctx, delivery).Return(nil)
+
+		// Act
+		err := useCase.Deliver(ctx, newMessage(delivery.Id))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.WebhookDeliveryStatusDelivered, delivery.Status)
+		assert.Equal(t, 204, *delivery.ResponseStatus)
+		assert.Equal(t, now, *delivery.DeliveredAt)
+	})
+
+	t.Run("should record the failure and return it for a retry", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		delivery := newDelivery()
+		sendErr := errors.New("destinatário respondeu 503 Service Unavailable")
+		mocks.deliveries.On("FindForDelivery", ctx, delivery.Id).Return(delivery, nil)
+		mocks.sender.On("Send", ctx, delivery.Subscription, delivery).Return(503, sendErr)
+		mocks.deliveries.On("Update", ctx, delivery).Return(nil)
+
+		// Act
+		err := useCase.Deliver(ctx, newMessage(delivery.Id))
+
+		// Assert
+		assert.ErrorIs(t, err, sendErr)
+		assert.Equal(t, enums.WebhookDeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, sendErr.Error(), *delivery.LastError)
+	})
+
+	t.Run("should not send deliveries of disabled subscriptions", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		delivery := newDelivery()
+		delivery.Subscription.Active = false
+		mocks.deliveries.On("FindForDelivery", ctx, delivery.Id).Return(delivery, nil)
+		mocks.deliveries.On("Update", ctx, delivery).Return(nil)
+
+		// Act
+		err := useCase.Deliver(ctx, newMessage(delivery.Id))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, enums.WebhookDeliveryStatusFailed, delivery.Status)
+		mocks.sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should drop deliveries removed with their subscription", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		id := uuid.New()
+		mocks.deliveries.On("FindForDelivery", ctx, id).Return(nil, nil)
+
+		// Act
+		err := useCase.Deliver(ctx, newMessage(id))
+
+		// Assert
+		assert.NoError(t, err)
+		mocks.sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject messages without a delivery", func(t *testing.T) {
+		// Arrange
+		useCase, _ := newWebhookUseCaseForTest(now)
+
+		// Act
+		err := useCase.Deliver(ctx, entity.OutboxMessage{Topic: OutboxTopicWebhook, Payload: json.RawMessage(`{}`)})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrOutboxPayloadInvalid)
+	})
+}
+
+func TestWebhookUseCase_Redeliver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2030, 5, 15, 10, 0, 0, 0, time.UTC)
+	admin := &entity.User{Id: uuid.New(), Role: enums.UserTypeAdmin}
+
+	t.Run("should requeue the same delivery", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		subscription := &entity.WebhookSubscription{Id: uuid.New(), Active: true, Events: pq.StringArray{"travel.canceled"}}
+		delivery := &entity.WebhookDelivery{
+			Id:             uuid.New(),
+			OrganizationId: uuid.New(),
+			SubscriptionId: subscription.Id,
+			Event:          enums.WebhookEventTravelCanceled,
+			Status:         enums.WebhookDeliveryStatusFailed,
+			Attempts:       8,
+		}
+		mocks.users.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mocks.deliveries.On("FindByID", ctx, delivery.Id).Return(delivery, nil)
+		mocks.subscriptions.On("FindByID", ctx, subscription.Id).Return(subscription, nil)
+		mocks.deliveries.On("Update", ctx, delivery).Return(nil)
+		mocks.outbox.On("Enqueue", ctx, mock.Anything).Return(nil)
+
+		// Act
+		redelivered, err := useCase.Redeliver(ctx, admin.Id, delivery.Id)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, enums.WebhookDeliveryStatusPending, redelivered.Status)
+		messages := mocks.outbox.Calls[0].Arguments.Get(1).([]entity.OutboxMessage)
+		require.Len(t, messages, 1)
+		assert.Equal(t, delivery.OrganizationId, messages[0].OrganizationId)
+		assert.JSONEq(t, `{"delivery_id":"`+delivery.Id.String()+`"}`, string(messages[0].Payload))
+	})
+
+	t.Run("should refuse subscriptions that no longer receive the event", func(t *testing.T) {
+		// Arrange
+		useCase, mocks := newWebhookUseCaseForTest(now)
+		subscription := &entity.WebhookSubscription{Id: uuid.New(), Active: true, Events: pq.StringArray{"travel.created"}}
+		delivery := &entity.WebhookDelivery{Id: uuid.New(), SubscriptionId: subscription.Id, Event: enums.WebhookEventTravelCanceled}
+		mocks.users.On("FindByID", ctx, admin.Id).Return(admin, nil)
+		mocks.deliveries.On("FindByID", ctx, delivery.Id).Return(delivery, nil)
+		mocks.subscriptions.On("FindByID", ctx, subscription.Id).Return(subscription, nil)
+
+		// Act
+		_, err := useCase.Redeliver(ctx, admin.Id, delivery.Id)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrWebhookSubscriptionInactive)
+		mocks.outbox.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything)
+	})
+}
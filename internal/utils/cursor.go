@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"challenge-travel-api/internal/domain/enums"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("cursor inválido")
+
+// TravelRequestCursor marca a última solicitação entregue em uma paginação por chave. Ele guarda
+// a ordenação em que foi gerado para que não seja reaproveitado com outra.
+type TravelRequestCursor struct {
+	SortBy   enums.TravelRequestSortField `json:"s"`
+	SortDesc bool                         `json:"d"`
+	Value    time.Time                    `json:"v"`
+	Id       uuid.UUID                    `json:"i"`
+}
+
+// SupportsCursor informa se a ordenação pode ser paginada por chave.
+func SupportsCursor(sortBy enums.TravelRequestSortField) bool {
+	return sortBy == enums.TravelRequestSortDepartureDate || sortBy == enums.TravelRequestSortCreatedAt
+}
+
+// Encode gera o valor opaco entregue aos clientes.
+func (c TravelRequestCursor) Encode() string {
+	payload, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func DecodeTravelRequestCursor(value string) (*TravelRequestCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor TravelRequestCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || !SupportsCursor(cursor.SortBy) || cursor.Id == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
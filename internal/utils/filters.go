@@ -15,4 +15,21 @@ type TravelRequestFilters struct {
 	DestinationName *string
 	Page            int
 	PageSize        int
+	CollapseGroups  bool
+	SortBy          enums.TravelRequestSortField
+	SortDesc        bool
+	// Com Cursor, a página começa logo após a solicitação indicada, sem OFFSET nem contagem.
+	Cursor *TravelRequestCursor
+
+	// Filtros da listagem administrativa. StartDate e EndDate limitam a data de ida.
+	Statuses     []enums.TravelRequestStatus
+	ApprovedBy   *uuid.UUID
+	Department   *string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	ApprovedFrom *time.Time
+	ApprovedTo   *time.Time
+	Search       *string
+	// CostCenterId encontra as solicitações do centro responsável ou com parcela no rateio.
+	CostCenterId *uuid.UUID
 }
@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS department_budgets;
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS travel_cost_items;
+
+DROP INDEX IF EXISTS idx_travel_requests_department;
+
+ALTER TABLE travel_requests
+    DROP COLUMN IF EXISTS estimated_total,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS department;
+
+DROP TYPE IF EXISTS travel_cost_category;
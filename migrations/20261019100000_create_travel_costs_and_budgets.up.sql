@@ -0,0 +1,65 @@
+DO $$ BEGIN
+    CREATE TYPE travel_cost_category AS ENUM ('AIRFARE', 'LODGING', 'PER_DIEM', 'GROUND_TRANSPORT');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+ALTER TABLE travel_requests
+    ADD COLUMN department VARCHAR(100),
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN estimated_total NUMERIC(14, 2) NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_travel_requests_department ON travel_requests(department);
+
+CREATE TABLE IF NOT EXISTS travel_cost_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    travel_request_id UUID NOT NULL,
+    category travel_cost_category NOT NULL,
+    description VARCHAR(255),
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    currency CHAR(3) NOT NULL,
+    base_amount NUMERIC(14, 2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE travel_cost_items
+ADD CONSTRAINT fk_travel_cost_items_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_cost_items_travel_request_id ON travel_cost_items(travel_request_id);
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    currency CHAR(3) PRIMARY KEY,
+    rate NUMERIC(18, 8) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER update_exchange_rates_updated_at
+    BEFORE UPDATE ON exchange_rates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO exchange_rates (currency, rate) VALUES
+    ('BRL', 1),
+    ('USD', 5.40),
+    ('EUR', 5.85),
+    ('GBP', 6.90),
+    ('ARS', 0.0058),
+    ('JPY', 0.036)
+ON CONFLICT (currency) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS department_budgets (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    department VARCHAR(100) NOT NULL,
+    year INTEGER NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_department_budgets_department_year ON department_budgets(department, year);
+
+CREATE TRIGGER update_department_budgets_updated_at
+    BEFORE UPDATE ON department_budgets
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
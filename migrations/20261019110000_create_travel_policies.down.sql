@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS travel_policy_violations;
+DROP TABLE IF EXISTS travel_policy_rules;
+DROP TYPE IF EXISTS travel_policy_severity;
@@ -0,0 +1,53 @@
+DO $$ BEGIN
+    CREATE TYPE travel_policy_severity AS ENUM ('BLOCK', 'WARN');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+CREATE TABLE IF NOT EXISTS travel_policy_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    field VARCHAR(50) NOT NULL,
+    operator VARCHAR(10) NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    severity travel_policy_severity NOT NULL,
+    message VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_travel_policy_rules_name ON travel_policy_rules(name);
+CREATE INDEX idx_travel_policy_rules_is_active ON travel_policy_rules(is_active);
+
+CREATE TRIGGER update_travel_policy_rules_updated_at
+    BEFORE UPDATE ON travel_policy_rules
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS travel_policy_violations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    travel_request_id UUID NOT NULL,
+    rule_id UUID,
+    rule_name VARCHAR(100) NOT NULL,
+    severity travel_policy_severity NOT NULL,
+    message VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE travel_policy_violations
+ADD CONSTRAINT fk_travel_policy_violations_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_policy_violations
+ADD CONSTRAINT fk_travel_policy_violations_rule_id
+FOREIGN KEY (rule_id) REFERENCES travel_policy_rules(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_travel_policy_violations_travel_request_id ON travel_policy_violations(travel_request_id);
+
+-- Regras de exemplo, desativadas por padrão.
+INSERT INTO travel_policy_rules (name, description, field, operator, value, severity, message, is_active) VALUES
+    ('antecedencia_minima', 'Solicitações devem ser feitas com 14 dias de antecedência', 'advance_days', 'LT', '14', 'BLOCK', 'A solicitação deve ser registrada com pelo menos 14 dias de antecedência', false),
+    ('limite_pernoites', 'Viagens com mais de 5 pernoites precisam de aprovação da diretoria', 'nights', 'GT', '5', 'WARN', 'Viagem com mais de 5 pernoites requer aprovação da diretoria', false)
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,5 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE INDEX idx_travel_requests_user_period ON travel_requests
+USING gist (user_id, tsrange(departure_date, COALESCE(return_date, departure_date), '[]'))
+WHERE status IN ('SOLICITED', 'APPROVED');
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS travel_request_travelers;
+DROP TABLE IF EXISTS traveler_delegates;
+DROP TABLE IF EXISTS travelers;
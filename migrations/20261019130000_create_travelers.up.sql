@@ -0,0 +1,65 @@
+CREATE TABLE IF NOT EXISTS travelers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    employee_id VARCHAR(50),
+    user_id UUID,
+    nationality CHAR(2),
+    passport_number VARCHAR(50),
+    passport_country CHAR(2),
+    passport_expires_at DATE,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE travelers
+ADD CONSTRAINT fk_travelers_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE travelers
+ADD CONSTRAINT fk_travelers_created_by
+FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_travelers_user_id ON travelers(user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_travelers_employee_id ON travelers(employee_id) WHERE employee_id IS NOT NULL;
+CREATE INDEX idx_travelers_email ON travelers(email);
+CREATE INDEX idx_travelers_created_by ON travelers(created_by);
+
+CREATE TRIGGER update_travelers_updated_at
+    BEFORE UPDATE ON travelers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS traveler_delegates (
+    traveler_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (traveler_id, user_id)
+);
+
+ALTER TABLE traveler_delegates
+ADD CONSTRAINT fk_traveler_delegates_traveler_id
+FOREIGN KEY (traveler_id) REFERENCES travelers(id) ON DELETE CASCADE;
+
+ALTER TABLE traveler_delegates
+ADD CONSTRAINT fk_traveler_delegates_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_traveler_delegates_user_id ON traveler_delegates(user_id);
+
+CREATE TABLE IF NOT EXISTS travel_request_travelers (
+    travel_request_id UUID NOT NULL,
+    traveler_id UUID NOT NULL,
+    PRIMARY KEY (travel_request_id, traveler_id)
+);
+
+ALTER TABLE travel_request_travelers
+ADD CONSTRAINT fk_travel_request_travelers_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_request_travelers
+ADD CONSTRAINT fk_travel_request_travelers_traveler_id
+FOREIGN KEY (traveler_id) REFERENCES travelers(id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_travel_request_travelers_traveler_id ON travel_request_travelers(traveler_id);
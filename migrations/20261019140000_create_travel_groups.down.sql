@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_travel_requests_group_id;
+ALTER TABLE travel_requests DROP CONSTRAINT IF EXISTS fk_travel_requests_group_id;
+ALTER TABLE travel_requests DROP COLUMN IF EXISTS group_id;
+
+DROP TABLE IF EXISTS travel_groups;
+DROP TYPE IF EXISTS travel_group_approval_mode;
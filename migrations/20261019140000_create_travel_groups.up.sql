@@ -0,0 +1,36 @@
+DO $$ BEGIN
+    CREATE TYPE travel_group_approval_mode AS ENUM ('UNIT', 'PER_TRAVELER');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+CREATE TABLE IF NOT EXISTS travel_groups (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    user_id UUID NOT NULL,
+    approval_mode travel_group_approval_mode NOT NULL DEFAULT 'UNIT',
+    destination_name VARCHAR(255) NOT NULL,
+    departure_date TIMESTAMP NOT NULL,
+    return_date TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE travel_groups
+ADD CONSTRAINT fk_travel_groups_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_groups_user_id ON travel_groups(user_id);
+
+CREATE TRIGGER update_travel_groups_updated_at
+    BEFORE UPDATE ON travel_groups
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE travel_requests ADD COLUMN IF NOT EXISTS group_id UUID;
+
+ALTER TABLE travel_requests
+ADD CONSTRAINT fk_travel_requests_group_id
+FOREIGN KEY (group_id) REFERENCES travel_groups(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_requests_group_id ON travel_requests(group_id);
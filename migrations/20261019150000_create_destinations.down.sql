@@ -0,0 +1,9 @@
+ALTER TABLE travel_groups DROP CONSTRAINT IF EXISTS fk_travel_groups_destination_id;
+ALTER TABLE travel_groups DROP COLUMN IF EXISTS destination_id;
+
+DROP INDEX IF EXISTS idx_travel_requests_destination_id;
+ALTER TABLE travel_requests DROP CONSTRAINT IF EXISTS fk_travel_requests_destination_id;
+ALTER TABLE travel_requests DROP COLUMN IF EXISTS destination_id;
+
+DROP TABLE IF EXISTS destination_airports;
+DROP TABLE IF EXISTS destinations;
@@ -0,0 +1,48 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+CREATE TABLE IF NOT EXISTS destinations (
+    id UUID PRIMARY KEY,
+    code VARCHAR(20) NOT NULL,
+    city VARCHAR(120) NOT NULL,
+    region VARCHAR(120) NOT NULL,
+    country_code CHAR(2) NOT NULL,
+    country_name VARCHAR(120) NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    aliases VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_destinations_code ON destinations(code);
+CREATE INDEX idx_destinations_country_code ON destinations(country_code);
+
+CREATE TRIGGER update_destinations_updated_at
+    BEFORE UPDATE ON destinations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS destination_airports (
+    iata_code CHAR(3) PRIMARY KEY,
+    destination_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL
+);
+
+ALTER TABLE destination_airports
+ADD CONSTRAINT fk_destination_airports_destination_id
+FOREIGN KEY (destination_id) REFERENCES destinations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_destination_airports_destination_id ON destination_airports(destination_id);
+
+ALTER TABLE travel_requests ADD COLUMN IF NOT EXISTS destination_id UUID;
+
+ALTER TABLE travel_requests
+ADD CONSTRAINT fk_travel_requests_destination_id
+FOREIGN KEY (destination_id) REFERENCES destinations(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_travel_requests_destination_id ON travel_requests(destination_id);
+
+ALTER TABLE travel_groups ADD COLUMN IF NOT EXISTS destination_id UUID;
+
+ALTER TABLE travel_groups
+ADD CONSTRAINT fk_travel_groups_destination_id
+FOREIGN KEY (destination_id) REFERENCES destinations(id) ON DELETE SET NULL;
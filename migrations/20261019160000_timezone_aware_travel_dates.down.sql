@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS idx_travel_requests_user_period;
+
+ALTER TABLE travel_groups
+    DROP COLUMN IF EXISTS departure_timezone,
+    DROP COLUMN IF EXISTS return_timezone;
+
+ALTER TABLE travel_groups
+    ALTER COLUMN departure_date TYPE TIMESTAMP USING departure_date AT TIME ZONE 'UTC',
+    ALTER COLUMN return_date TYPE TIMESTAMP USING return_date AT TIME ZONE 'UTC';
+
+ALTER TABLE travel_requests
+    DROP COLUMN IF EXISTS departure_timezone,
+    DROP COLUMN IF EXISTS return_timezone;
+
+ALTER TABLE travel_requests
+    ALTER COLUMN departure_date TYPE TIMESTAMP USING departure_date AT TIME ZONE 'UTC',
+    ALTER COLUMN return_date TYPE TIMESTAMP USING return_date AT TIME ZONE 'UTC';
+
+CREATE INDEX idx_travel_requests_user_period ON travel_requests
+USING gist (user_id, tsrange(departure_date, COALESCE(return_date, departure_date), '[]'))
+WHERE status IN ('SOLICITED', 'APPROVED');
@@ -0,0 +1,22 @@
+DROP INDEX IF EXISTS idx_travel_requests_user_period;
+
+-- Os valores existentes foram gravados como horário UTC sem fuso.
+ALTER TABLE travel_requests
+    ALTER COLUMN departure_date TYPE TIMESTAMPTZ USING departure_date AT TIME ZONE 'UTC',
+    ALTER COLUMN return_date TYPE TIMESTAMPTZ USING return_date AT TIME ZONE 'UTC';
+
+ALTER TABLE travel_requests
+    ADD COLUMN IF NOT EXISTS departure_timezone VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS return_timezone VARCHAR(64) NOT NULL DEFAULT '';
+
+ALTER TABLE travel_groups
+    ALTER COLUMN departure_date TYPE TIMESTAMPTZ USING departure_date AT TIME ZONE 'UTC',
+    ALTER COLUMN return_date TYPE TIMESTAMPTZ USING return_date AT TIME ZONE 'UTC';
+
+ALTER TABLE travel_groups
+    ADD COLUMN IF NOT EXISTS departure_timezone VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS return_timezone VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_travel_requests_user_period ON travel_requests
+USING gist (user_id, tstzrange(departure_date, COALESCE(return_date, departure_date), '[]'))
+WHERE status IN ('SOLICITED', 'APPROVED');
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS travel_request_comments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    travel_request_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    author_name VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    internal BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE travel_request_comments
+ADD CONSTRAINT fk_travel_request_comments_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_request_comments
+ADD CONSTRAINT fk_travel_request_comments_author_id
+FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_request_comments_travel_request_id ON travel_request_comments(travel_request_id, created_at);
+
+CREATE TRIGGER update_travel_request_comments_updated_at
+    BEFORE UPDATE ON travel_request_comments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS travel_request_attachments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    travel_request_id UUID NOT NULL,
+    uploaded_by UUID NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(127) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE travel_request_attachments
+ADD CONSTRAINT fk_travel_request_attachments_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_request_attachments
+ADD CONSTRAINT fk_travel_request_attachments_uploaded_by
+FOREIGN KEY (uploaded_by) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_request_attachments_travel_request_id ON travel_request_attachments(travel_request_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS travel_template_cost_items;
+DROP TABLE IF EXISTS travel_template_travelers;
+DROP TABLE IF EXISTS travel_templates;
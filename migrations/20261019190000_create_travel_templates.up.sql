@@ -0,0 +1,57 @@
+CREATE TABLE IF NOT EXISTS travel_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    name VARCHAR(120) NOT NULL,
+    destination_name VARCHAR(255) NOT NULL,
+    destination_id UUID,
+    traveler_name VARCHAR(255) NOT NULL DEFAULT '',
+    duration_days INTEGER NOT NULL DEFAULT 0 CHECK (duration_days >= 0),
+    department VARCHAR(100),
+    departure_timezone VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE travel_templates
+ADD CONSTRAINT fk_travel_templates_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_templates
+ADD CONSTRAINT fk_travel_templates_destination_id
+FOREIGN KEY (destination_id) REFERENCES destinations(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_travel_templates_user_id ON travel_templates(user_id);
+
+CREATE TRIGGER update_travel_templates_updated_at
+    BEFORE UPDATE ON travel_templates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS travel_template_travelers (
+    travel_template_id UUID NOT NULL,
+    traveler_id UUID NOT NULL,
+    PRIMARY KEY (travel_template_id, traveler_id)
+);
+
+ALTER TABLE travel_template_travelers
+ADD CONSTRAINT fk_travel_template_travelers_travel_template_id
+FOREIGN KEY (travel_template_id) REFERENCES travel_templates(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_template_travelers
+ADD CONSTRAINT fk_travel_template_travelers_traveler_id
+FOREIGN KEY (traveler_id) REFERENCES travelers(id) ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS travel_template_cost_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL,
+    category travel_cost_category NOT NULL,
+    description VARCHAR(255),
+    amount NUMERIC(14,2) NOT NULL,
+    currency CHAR(3) NOT NULL
+);
+
+ALTER TABLE travel_template_cost_items
+ADD CONSTRAINT fk_travel_template_cost_items_template_id
+FOREIGN KEY (template_id) REFERENCES travel_templates(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_template_cost_items_template_id ON travel_template_cost_items(template_id);
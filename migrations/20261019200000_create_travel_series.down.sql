@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_travel_requests_series_occurrence;
+
+ALTER TABLE travel_requests DROP CONSTRAINT IF EXISTS fk_travel_requests_series_id;
+
+ALTER TABLE travel_requests
+DROP COLUMN IF EXISTS series_exception,
+DROP COLUMN IF EXISTS occurrence_date,
+DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS travel_series;
+
+DROP TYPE IF EXISTS travel_series_status;
@@ -0,0 +1,45 @@
+CREATE TYPE travel_series_status AS ENUM ('ACTIVE', 'CANCELED');
+
+CREATE TABLE IF NOT EXISTS travel_series (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    template_id UUID NOT NULL,
+    rrule VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    timezone VARCHAR(64) NOT NULL,
+    horizon_days INTEGER NOT NULL DEFAULT 30 CHECK (horizon_days > 0),
+    generated_until TIMESTAMPTZ,
+    status travel_series_status NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE travel_series
+ADD CONSTRAINT fk_travel_series_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_series
+ADD CONSTRAINT fk_travel_series_template_id
+FOREIGN KEY (template_id) REFERENCES travel_templates(id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_travel_series_user_id ON travel_series(user_id);
+CREATE INDEX idx_travel_series_status ON travel_series(status);
+
+CREATE TRIGGER update_travel_series_updated_at
+    BEFORE UPDATE ON travel_series
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE travel_requests
+ADD COLUMN series_id UUID,
+ADD COLUMN occurrence_date TIMESTAMPTZ,
+ADD COLUMN series_exception BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE travel_requests
+ADD CONSTRAINT fk_travel_requests_series_id
+FOREIGN KEY (series_id) REFERENCES travel_series(id) ON DELETE SET NULL;
+
+-- Impede que duas execuções simultâneas do job gerem a mesma ocorrência.
+CREATE UNIQUE INDEX idx_travel_requests_series_occurrence
+    ON travel_requests(series_id, occurrence_date)
+    WHERE series_id IS NOT NULL AND occurrence_date IS NOT NULL;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_travel_requests_pending_departure
+ON travel_requests (departure_date, created_at)
+WHERE status = 'SOLICITED';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_travel_requests_department;
+DROP INDEX IF EXISTS idx_travel_requests_approved_by;
+DROP INDEX IF EXISTS idx_travel_requests_created_at;
@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_travel_requests_created_at ON travel_requests (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_travel_requests_approved_by ON travel_requests (approved_by);
+CREATE INDEX IF NOT EXISTS idx_travel_requests_department ON travel_requests (lower(department));
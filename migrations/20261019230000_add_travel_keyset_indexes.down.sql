@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_travel_requests_user_departure_id;
+DROP INDEX IF EXISTS idx_travel_requests_user_created_id;
+DROP INDEX IF EXISTS idx_travel_requests_departure_id;
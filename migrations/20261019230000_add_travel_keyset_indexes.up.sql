@@ -0,0 +1,3 @@
+CREATE INDEX IF NOT EXISTS idx_travel_requests_departure_id ON travel_requests (departure_date, id);
+CREATE INDEX IF NOT EXISTS idx_travel_requests_user_created_id ON travel_requests (user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS idx_travel_requests_user_departure_id ON travel_requests (user_id, departure_date, id);
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS refresh_travel_request_comments_search_document ON travel_request_comments;
+DROP TRIGGER IF EXISTS refresh_travel_requests_search_document ON travel_requests;
+
+DROP FUNCTION IF EXISTS refresh_travel_request_search_from_comment();
+DROP FUNCTION IF EXISTS refresh_travel_request_search_from_request();
+DROP FUNCTION IF EXISTS refresh_travel_request_search_document(UUID);
+
+DROP TABLE IF EXISTS travel_request_search_documents;
+
+DROP TEXT SEARCH CONFIGURATION IF EXISTS travel_search;
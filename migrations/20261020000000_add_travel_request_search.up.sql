@@ -0,0 +1,76 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- Configuração sem stemming, que ignora acentos tanto nos documentos quanto nas buscas.
+CREATE TEXT SEARCH CONFIGURATION travel_search (COPY = simple);
+
+ALTER TEXT SEARCH CONFIGURATION travel_search
+ALTER MAPPING FOR hword, hword_part, word WITH unaccent, simple;
+
+-- Documento de busca de cada solicitação. Fica em uma tabela própria para que alterações nos
+-- comentários não modifiquem travel_requests (nem o seu updated_at).
+CREATE TABLE IF NOT EXISTS travel_request_search_documents (
+    travel_request_id UUID PRIMARY KEY,
+    document TSVECTOR NOT NULL
+);
+
+ALTER TABLE travel_request_search_documents
+ADD CONSTRAINT fk_travel_request_search_documents_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_request_search_documents_document ON travel_request_search_documents USING GIN (document);
+
+-- Viajantes e destino têm peso A; comentários públicos, peso C. Notas internas ficam de fora
+-- para que a busca não revele o seu conteúdo a quem não pode lê-las.
+CREATE OR REPLACE FUNCTION refresh_travel_request_search_document(p_travel_request_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO travel_request_search_documents (travel_request_id, document)
+    SELECT
+        tr.id,
+        setweight(to_tsvector('travel_search', COALESCE(tr.traveler_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE(tr.destination_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE((
+            SELECT string_agg(c.body, ' ')
+            FROM travel_request_comments c
+            WHERE c.travel_request_id = tr.id AND NOT c.internal
+        ), '')), 'C')
+    FROM travel_requests tr
+    WHERE tr.id = p_travel_request_id
+    ON CONFLICT (travel_request_id) DO UPDATE SET document = EXCLUDED.document;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_travel_request_search_from_request()
+RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM refresh_travel_request_search_document(NEW.id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION refresh_travel_request_search_from_comment()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM refresh_travel_request_search_document(OLD.travel_request_id);
+    END IF;
+
+    IF TG_OP <> 'DELETE' AND (TG_OP = 'INSERT' OR NEW.travel_request_id <> OLD.travel_request_id) THEN
+        PERFORM refresh_travel_request_search_document(NEW.travel_request_id);
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER refresh_travel_requests_search_document
+    AFTER INSERT OR UPDATE OF traveler_name, destination_name ON travel_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION refresh_travel_request_search_from_request();
+
+CREATE TRIGGER refresh_travel_request_comments_search_document
+    AFTER INSERT OR UPDATE OR DELETE ON travel_request_comments
+    FOR EACH ROW
+    EXECUTE FUNCTION refresh_travel_request_search_from_comment();
+
+SELECT refresh_travel_request_search_document(id) FROM travel_requests;
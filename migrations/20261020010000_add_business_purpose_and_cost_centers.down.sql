@@ -0,0 +1,42 @@
+DROP TRIGGER IF EXISTS refresh_travel_requests_search_document ON travel_requests;
+
+CREATE OR REPLACE FUNCTION refresh_travel_request_search_document(p_travel_request_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO travel_request_search_documents (travel_request_id, document)
+    SELECT
+        tr.id,
+        setweight(to_tsvector('travel_search', COALESCE(tr.traveler_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE(tr.destination_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE((
+            SELECT string_agg(c.body, ' ')
+            FROM travel_request_comments c
+            WHERE c.travel_request_id = tr.id AND NOT c.internal
+        ), '')), 'C')
+    FROM travel_requests tr
+    WHERE tr.id = p_travel_request_id
+    ON CONFLICT (travel_request_id) DO UPDATE SET document = EXCLUDED.document;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER refresh_travel_requests_search_document
+    AFTER INSERT OR UPDATE OF traveler_name, destination_name ON travel_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION refresh_travel_request_search_from_request();
+
+ALTER TABLE travel_templates
+    DROP CONSTRAINT IF EXISTS fk_travel_templates_cost_center_id,
+    DROP COLUMN IF EXISTS cost_center_id,
+    DROP COLUMN IF EXISTS business_purpose;
+
+DROP TABLE IF EXISTS travel_cost_allocations;
+
+ALTER TABLE travel_requests
+    DROP CONSTRAINT IF EXISTS fk_travel_requests_cost_center_id,
+    DROP COLUMN IF EXISTS cost_center_id,
+    DROP COLUMN IF EXISTS business_purpose;
+
+DROP TRIGGER IF EXISTS update_cost_centers_updated_at ON cost_centers;
+DROP TABLE IF EXISTS cost_centers;
+
+DROP TYPE IF EXISTS cost_center_kind;
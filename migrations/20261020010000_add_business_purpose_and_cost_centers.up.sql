@@ -0,0 +1,87 @@
+DO $$ BEGIN
+    CREATE TYPE cost_center_kind AS ENUM ('COST_CENTER', 'PROJECT');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+CREATE TABLE IF NOT EXISTS cost_centers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    kind cost_center_kind NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TRIGGER update_cost_centers_updated_at
+    BEFORE UPDATE ON cost_centers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Solicitações anteriores ficam sem motivo e sem centro de custo; ambos passam a ser exigidos
+-- apenas nas novas.
+ALTER TABLE travel_requests
+    ADD COLUMN business_purpose TEXT NOT NULL DEFAULT '',
+    ADD COLUMN cost_center_id UUID;
+
+ALTER TABLE travel_requests
+ADD CONSTRAINT fk_travel_requests_cost_center_id
+FOREIGN KEY (cost_center_id) REFERENCES cost_centers(id);
+
+CREATE INDEX idx_travel_requests_cost_center_id ON travel_requests(cost_center_id);
+
+CREATE TABLE IF NOT EXISTS travel_cost_allocations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    travel_request_id UUID NOT NULL,
+    cost_center_id UUID NOT NULL,
+    percentage NUMERIC(5, 2) NOT NULL CHECK (percentage > 0 AND percentage <= 100),
+    amount NUMERIC(14, 2) NOT NULL,
+    UNIQUE (travel_request_id, cost_center_id)
+);
+
+ALTER TABLE travel_cost_allocations
+ADD CONSTRAINT fk_travel_cost_allocations_travel_request_id
+FOREIGN KEY (travel_request_id) REFERENCES travel_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE travel_cost_allocations
+ADD CONSTRAINT fk_travel_cost_allocations_cost_center_id
+FOREIGN KEY (cost_center_id) REFERENCES cost_centers(id);
+
+CREATE INDEX idx_travel_cost_allocations_cost_center_id ON travel_cost_allocations(cost_center_id);
+
+ALTER TABLE travel_templates
+    ADD COLUMN business_purpose TEXT NOT NULL DEFAULT '',
+    ADD COLUMN cost_center_id UUID;
+
+ALTER TABLE travel_templates
+ADD CONSTRAINT fk_travel_templates_cost_center_id
+FOREIGN KEY (cost_center_id) REFERENCES cost_centers(id);
+
+-- O motivo da viagem entra na busca textual com peso B, entre viajantes/destino e comentários.
+CREATE OR REPLACE FUNCTION refresh_travel_request_search_document(p_travel_request_id UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO travel_request_search_documents (travel_request_id, document)
+    SELECT
+        tr.id,
+        setweight(to_tsvector('travel_search', COALESCE(tr.traveler_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE(tr.destination_name, '')), 'A') ||
+        setweight(to_tsvector('travel_search', COALESCE(tr.business_purpose, '')), 'B') ||
+        setweight(to_tsvector('travel_search', COALESCE((
+            SELECT string_agg(c.body, ' ')
+            FROM travel_request_comments c
+            WHERE c.travel_request_id = tr.id AND NOT c.internal
+        ), '')), 'C')
+    FROM travel_requests tr
+    WHERE tr.id = p_travel_request_id
+    ON CONFLICT (travel_request_id) DO UPDATE SET document = EXCLUDED.document;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS refresh_travel_requests_search_document ON travel_requests;
+
+CREATE TRIGGER refresh_travel_requests_search_document
+    AFTER INSERT OR UPDATE OF traveler_name, destination_name, business_purpose ON travel_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION refresh_travel_request_search_from_request();
@@ -0,0 +1,7 @@
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS chk_users_manager_id,
+    DROP COLUMN IF EXISTS manager_id,
+    DROP COLUMN IF EXISTS department_id;
+
+DROP TRIGGER IF EXISTS update_departments_updated_at ON departments;
+DROP TABLE IF EXISTS departments;
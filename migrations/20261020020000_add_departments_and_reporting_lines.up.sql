@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS departments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    head_user_id UUID,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+ALTER TABLE departments
+ADD CONSTRAINT fk_departments_head_user_id
+FOREIGN KEY (head_user_id) REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE TRIGGER update_departments_updated_at
+    BEFORE UPDATE ON departments
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Ciclos na hierarquia são barrados pela aplicação; o banco impede apenas o auto-relacionamento.
+ALTER TABLE users
+    ADD COLUMN department_id UUID,
+    ADD COLUMN manager_id UUID,
+    ADD CONSTRAINT chk_users_manager_id CHECK (manager_id <> id);
+
+ALTER TABLE users
+ADD CONSTRAINT fk_users_department_id
+FOREIGN KEY (department_id) REFERENCES departments(id) ON DELETE SET NULL;
+
+ALTER TABLE users
+ADD CONSTRAINT fk_users_manager_id
+FOREIGN KEY (manager_id) REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_users_department_id ON users(department_id);
+CREATE INDEX idx_users_manager_id ON users(manager_id);
@@ -0,0 +1,31 @@
+ALTER TABLE travel_policy_rules
+    DROP CONSTRAINT IF EXISTS fk_travel_policy_rules_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+
+-- Ao voltar para uma única organização, ficam apenas os orçamentos e cotações da organização padrão.
+DELETE FROM department_budgets
+WHERE organization_id <> (SELECT id FROM organizations WHERE code = 'DEFAULT');
+DROP INDEX IF EXISTS idx_department_budgets_organization_department_year;
+ALTER TABLE department_budgets
+    DROP CONSTRAINT IF EXISTS fk_department_budgets_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+CREATE UNIQUE INDEX idx_department_budgets_department_year ON department_budgets(department, year);
+
+DELETE FROM exchange_rates
+WHERE organization_id <> (SELECT id FROM organizations WHERE code = 'DEFAULT');
+ALTER TABLE exchange_rates DROP CONSTRAINT exchange_rates_pkey;
+ALTER TABLE exchange_rates
+    DROP CONSTRAINT IF EXISTS fk_exchange_rates_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+ALTER TABLE exchange_rates ADD PRIMARY KEY (currency);
+
+ALTER TABLE travel_requests
+    DROP CONSTRAINT IF EXISTS fk_travel_requests_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS fk_users_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+
+DROP TRIGGER IF EXISTS update_organizations_updated_at ON organizations;
+DROP TABLE IF EXISTS organizations;
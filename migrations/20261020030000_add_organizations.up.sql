@@ -0,0 +1,72 @@
+CREATE TABLE IF NOT EXISTS organizations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    base_currency CHAR(3) NOT NULL,
+    default_timezone VARCHAR(64) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TRIGGER update_organizations_updated_at
+    BEFORE UPDATE ON organizations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Os dados existentes passam a pertencer à organização padrão; novas organizações são
+-- cadastradas diretamente nesta tabela.
+INSERT INTO organizations (code, name, base_currency, default_timezone) VALUES
+    ('DEFAULT', 'Organização padrão', 'BRL', 'America/Sao_Paulo')
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE users ADD COLUMN organization_id UUID;
+UPDATE users SET organization_id = (SELECT id FROM organizations WHERE code = 'DEFAULT');
+ALTER TABLE users ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE users
+ADD CONSTRAINT fk_users_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id);
+
+CREATE INDEX idx_users_organization_id ON users(organization_id);
+
+ALTER TABLE travel_requests ADD COLUMN organization_id UUID;
+UPDATE travel_requests tr SET organization_id = u.organization_id FROM users u WHERE u.id = tr.user_id;
+ALTER TABLE travel_requests ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE travel_requests
+ADD CONSTRAINT fk_travel_requests_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id);
+
+CREATE INDEX idx_travel_requests_organization_id ON travel_requests(organization_id, created_at DESC);
+
+-- As cotações são expressas na moeda base de cada organização.
+ALTER TABLE exchange_rates ADD COLUMN organization_id UUID;
+UPDATE exchange_rates SET organization_id = (SELECT id FROM organizations WHERE code = 'DEFAULT');
+ALTER TABLE exchange_rates ALTER COLUMN organization_id SET NOT NULL;
+ALTER TABLE exchange_rates DROP CONSTRAINT exchange_rates_pkey;
+ALTER TABLE exchange_rates ADD PRIMARY KEY (organization_id, currency);
+
+ALTER TABLE exchange_rates
+ADD CONSTRAINT fk_exchange_rates_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE department_budgets ADD COLUMN organization_id UUID;
+UPDATE department_budgets SET organization_id = (SELECT id FROM organizations WHERE code = 'DEFAULT');
+ALTER TABLE department_budgets ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE department_budgets
+ADD CONSTRAINT fk_department_budgets_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_department_budgets_department_year;
+CREATE UNIQUE INDEX idx_department_budgets_organization_department_year ON department_budgets(organization_id, department, year);
+
+-- Regras sem organização valem para todas.
+ALTER TABLE travel_policy_rules ADD COLUMN organization_id UUID;
+
+ALTER TABLE travel_policy_rules
+ADD CONSTRAINT fk_travel_policy_rules_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_policy_rules_organization_id ON travel_policy_rules(organization_id);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    topic VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    attempts INTEGER NOT NULL DEFAULT 0,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    locked_until TIMESTAMPTZ,
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ,
+    CONSTRAINT chk_outbox_messages_status CHECK (status IN ('PENDING', 'DELIVERED', 'DEAD'))
+);
+
+ALTER TABLE outbox_messages
+ADD CONSTRAINT fk_outbox_messages_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+-- O worker só procura mensagens pendentes; o índice parcial ignora o histórico entregue.
+CREATE INDEX idx_outbox_messages_available_at ON outbox_messages(available_at) WHERE status = 'PENDING';
+CREATE INDEX idx_outbox_messages_organization_id ON outbox_messages(organization_id, status, created_at DESC);
+
+CREATE TRIGGER update_outbox_messages_updated_at
+    BEFORE UPDATE ON outbox_messages
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
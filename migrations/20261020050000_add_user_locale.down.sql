@@ -0,0 +1,2 @@
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_locale;
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,4 @@
+ALTER TABLE users ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'pt-BR';
+
+ALTER TABLE users
+ADD CONSTRAINT chk_users_locale CHECK (locale IN ('pt-BR', 'en-US', 'es'));
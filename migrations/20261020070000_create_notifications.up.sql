@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    payload JSONB NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_notifications_type CHECK (type IN ('STATUS_CHANGE', 'COMMENT'))
+);
+
+ALTER TABLE notifications
+ADD CONSTRAINT fk_notifications_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE notifications
+ADD CONSTRAINT fk_notifications_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, created_at DESC);
+
+-- A contagem de não lidas é consultada a cada carregamento da tela; o índice parcial cobre só as pendentes.
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID NOT NULL,
+    type VARCHAR(30) NOT NULL,
+    email BOOLEAN NOT NULL DEFAULT TRUE,
+    in_app BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMPTZ,
+    PRIMARY KEY (user_id, type),
+    CONSTRAINT chk_notification_preferences_type CHECK (type IN ('STATUS_CHANGE', 'COMMENT'))
+);
+
+ALTER TABLE notification_preferences
+ADD CONSTRAINT fk_notification_preferences_user_id
+FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE TRIGGER update_notification_preferences_updated_at
+    BEFORE UPDATE ON notification_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_travelers_organization_employee_id;
+CREATE UNIQUE INDEX idx_travelers_employee_id ON travelers(employee_id) WHERE employee_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_travelers_organization_id;
+ALTER TABLE travelers
+    DROP CONSTRAINT IF EXISTS fk_travelers_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
@@ -0,0 +1,14 @@
+-- Os perfis de viajante pertencem à organização de quem os cadastrou.
+ALTER TABLE travelers ADD COLUMN organization_id UUID;
+UPDATE travelers t SET organization_id = u.organization_id FROM users u WHERE u.id = t.created_by;
+ALTER TABLE travelers ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE travelers
+ADD CONSTRAINT fk_travelers_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travelers_organization_id ON travelers(organization_id, name);
+
+-- A matrícula é única dentro de cada organização.
+DROP INDEX IF EXISTS idx_travelers_employee_id;
+CREATE UNIQUE INDEX idx_travelers_organization_employee_id ON travelers(organization_id, employee_id) WHERE employee_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_travel_groups_organization_id;
+ALTER TABLE travel_groups
+    DROP CONSTRAINT IF EXISTS fk_travel_groups_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
@@ -0,0 +1,9 @@
+ALTER TABLE travel_groups ADD COLUMN organization_id UUID;
+UPDATE travel_groups g SET organization_id = u.organization_id FROM users u WHERE u.id = g.user_id;
+ALTER TABLE travel_groups ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE travel_groups
+ADD CONSTRAINT fk_travel_groups_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_groups_organization_id ON travel_groups(organization_id);
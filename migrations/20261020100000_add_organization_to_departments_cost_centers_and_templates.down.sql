@@ -0,0 +1,20 @@
+DROP INDEX IF EXISTS idx_travel_policy_rules_global_name;
+DROP INDEX IF EXISTS idx_travel_policy_rules_organization_name;
+CREATE UNIQUE INDEX idx_travel_policy_rules_name ON travel_policy_rules(name);
+
+DROP INDEX IF EXISTS idx_travel_templates_organization_id;
+ALTER TABLE travel_templates
+    DROP CONSTRAINT IF EXISTS fk_travel_templates_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+
+DROP INDEX IF EXISTS idx_cost_centers_organization_code;
+ALTER TABLE cost_centers ADD CONSTRAINT cost_centers_code_key UNIQUE (code);
+ALTER TABLE cost_centers
+    DROP CONSTRAINT IF EXISTS fk_cost_centers_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
+
+DROP INDEX IF EXISTS idx_departments_organization_code;
+ALTER TABLE departments ADD CONSTRAINT departments_code_key UNIQUE (code);
+ALTER TABLE departments
+    DROP CONSTRAINT IF EXISTS fk_departments_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
@@ -0,0 +1,54 @@
+-- Departamentos herdam a organização do responsável; sem responsável, a de um integrante.
+ALTER TABLE departments ADD COLUMN organization_id UUID;
+UPDATE departments d SET organization_id = COALESCE(
+    (SELECT u.organization_id FROM users u WHERE u.id = d.head_user_id),
+    (SELECT u.organization_id FROM users u WHERE u.department_id = d.id ORDER BY u.created_at LIMIT 1),
+    (SELECT id FROM organizations WHERE code = 'DEFAULT')
+);
+ALTER TABLE departments ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE departments
+ADD CONSTRAINT fk_departments_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE departments DROP CONSTRAINT IF EXISTS departments_code_key;
+CREATE UNIQUE INDEX idx_departments_organization_code ON departments(organization_id, code);
+
+-- Vínculos que atravessavam organizações deixam de ser válidos.
+UPDATE users u SET department_id = NULL
+FROM departments d
+WHERE d.id = u.department_id AND d.organization_id <> u.organization_id;
+
+UPDATE departments d SET head_user_id = NULL
+FROM users u
+WHERE u.id = d.head_user_id AND u.organization_id <> d.organization_id;
+
+-- Centros de custo herdam a organização das solicitações que os usam.
+ALTER TABLE cost_centers ADD COLUMN organization_id UUID;
+UPDATE cost_centers c SET organization_id = COALESCE(
+    (SELECT tr.organization_id FROM travel_requests tr WHERE tr.cost_center_id = c.id ORDER BY tr.created_at LIMIT 1),
+    (SELECT id FROM organizations WHERE code = 'DEFAULT')
+);
+ALTER TABLE cost_centers ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE cost_centers
+ADD CONSTRAINT fk_cost_centers_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+ALTER TABLE cost_centers DROP CONSTRAINT IF EXISTS cost_centers_code_key;
+CREATE UNIQUE INDEX idx_cost_centers_organization_code ON cost_centers(organization_id, code);
+
+ALTER TABLE travel_templates ADD COLUMN organization_id UUID;
+UPDATE travel_templates t SET organization_id = u.organization_id FROM users u WHERE u.id = t.user_id;
+ALTER TABLE travel_templates ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE travel_templates
+ADD CONSTRAINT fk_travel_templates_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_templates_organization_id ON travel_templates(organization_id);
+
+-- Nomes de regra são únicos por organização; regras globais mantêm a unicidade entre si.
+DROP INDEX IF EXISTS idx_travel_policy_rules_name;
+CREATE UNIQUE INDEX idx_travel_policy_rules_organization_name ON travel_policy_rules(organization_id, name);
+CREATE UNIQUE INDEX idx_travel_policy_rules_global_name ON travel_policy_rules(name) WHERE organization_id IS NULL;
@@ -0,0 +1 @@
+-- Os códigos normalizados não são revertidos: o texto livre original não foi preservado.
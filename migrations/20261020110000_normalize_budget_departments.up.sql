@@ -0,0 +1,23 @@
+-- O orçamento consumido pela solicitação passa a ser o do departamento do solicitante.
+UPDATE travel_requests tr SET department = d.code
+FROM users u
+JOIN departments d ON d.id = u.department_id
+WHERE u.id = tr.user_id;
+
+-- Sem departamento no cadastro, textos livres que correspondem a um departamento viram o código.
+UPDATE travel_requests tr SET department = d.code
+FROM users u, departments d
+WHERE u.id = tr.user_id
+  AND u.department_id IS NULL
+  AND d.organization_id = tr.organization_id
+  AND (UPPER(TRIM(tr.department)) = d.code OR LOWER(TRIM(tr.department)) = LOWER(d.name));
+
+UPDATE department_budgets b SET department = d.code
+FROM departments d
+WHERE d.organization_id = b.organization_id
+  AND b.department <> d.code
+  AND (UPPER(TRIM(b.department)) = d.code OR LOWER(TRIM(b.department)) = LOWER(d.name))
+  AND NOT EXISTS (
+      SELECT 1 FROM department_budgets other
+      WHERE other.organization_id = b.organization_id AND other.department = d.code AND other.year = b.year
+  );
@@ -0,0 +1 @@
+ALTER TABLE travel_requests ALTER COLUMN currency SET DEFAULT '';
@@ -0,0 +1,6 @@
+-- Solicitações anteriores aos custos ficaram sem moeda; o total delas está na moeda base da organização.
+UPDATE travel_requests tr SET currency = o.base_currency
+FROM organizations o
+WHERE o.id = tr.organization_id AND tr.currency = '';
+
+ALTER TABLE travel_requests ALTER COLUMN currency DROP DEFAULT;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_travel_requests_active_period;
+
+CREATE INDEX idx_travel_requests_user_period ON travel_requests
+USING gist (user_id, tstzrange(departure_date, COALESCE(return_date, departure_date), '[]'))
+WHERE status IN ('SOLICITED', 'APPROVED');
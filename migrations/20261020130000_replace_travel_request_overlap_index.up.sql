@@ -0,0 +1,7 @@
+-- As consultas de sobreposição sempre filtram a organização, e a busca por viajantes não filtra o
+-- usuário; o índice cobre os dois casos.
+DROP INDEX IF EXISTS idx_travel_requests_user_period;
+
+CREATE INDEX idx_travel_requests_active_period ON travel_requests
+USING gist (organization_id, user_id, tstzrange(departure_date, COALESCE(return_date, departure_date), '[]'))
+WHERE status IN ('SOLICITED', 'APPROVED');
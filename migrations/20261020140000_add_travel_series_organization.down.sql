@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_travel_series_organization_status;
+ALTER TABLE travel_series
+    DROP CONSTRAINT IF EXISTS fk_travel_series_organization_id,
+    DROP COLUMN IF EXISTS organization_id;
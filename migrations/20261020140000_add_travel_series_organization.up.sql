@@ -0,0 +1,10 @@
+-- Séries herdam a organização do usuário que as cadastrou.
+ALTER TABLE travel_series ADD COLUMN organization_id UUID;
+UPDATE travel_series s SET organization_id = u.organization_id FROM users u WHERE u.id = s.user_id;
+ALTER TABLE travel_series ALTER COLUMN organization_id SET NOT NULL;
+
+ALTER TABLE travel_series
+ADD CONSTRAINT fk_travel_series_organization_id
+FOREIGN KEY (organization_id) REFERENCES organizations(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_travel_series_organization_status ON travel_series(organization_id, status);
@@ -0,0 +1,2 @@
+-- Idioma das notificações enviadas a viajantes sem conta na aplicação.
+ALTER TABLE organizations ADD COLUMN default_locale VARCHAR(10) NOT NULL DEFAULT 'pt-BR';
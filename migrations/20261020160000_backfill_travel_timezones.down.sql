@@ -0,0 +1,7 @@
+ALTER TABLE travel_groups
+    ALTER COLUMN departure_timezone SET DEFAULT '',
+    ALTER COLUMN return_timezone SET DEFAULT '';
+
+ALTER TABLE travel_requests
+    ALTER COLUMN departure_timezone SET DEFAULT '',
+    ALTER COLUMN return_timezone SET DEFAULT '';
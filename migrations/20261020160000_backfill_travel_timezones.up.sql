@@ -0,0 +1,29 @@
+-- Solicitações e grupos anteriores aos fusos por trecho ficaram sem fuso. Como na criação, a ida
+-- usa o fuso padrão da organização e a volta, o fuso do destino do catálogo ou o mesmo da ida.
+UPDATE travel_requests tr SET departure_timezone = o.default_timezone
+FROM organizations o
+WHERE o.id = tr.organization_id AND tr.departure_timezone = '';
+
+UPDATE travel_requests tr SET return_timezone = COALESCE(
+    (SELECT NULLIF(d.timezone, '') FROM destinations d WHERE d.id = tr.destination_id),
+    tr.departure_timezone
+)
+WHERE tr.return_timezone = '';
+
+UPDATE travel_groups g SET departure_timezone = o.default_timezone
+FROM organizations o
+WHERE o.id = g.organization_id AND g.departure_timezone = '';
+
+UPDATE travel_groups g SET return_timezone = COALESCE(
+    (SELECT NULLIF(d.timezone, '') FROM destinations d WHERE d.id = g.destination_id),
+    g.departure_timezone
+)
+WHERE g.return_timezone = '';
+
+ALTER TABLE travel_requests
+    ALTER COLUMN departure_timezone DROP DEFAULT,
+    ALTER COLUMN return_timezone DROP DEFAULT;
+
+ALTER TABLE travel_groups
+    ALTER COLUMN departure_timezone DROP DEFAULT,
+    ALTER COLUMN return_timezone DROP DEFAULT;
@@ -0,0 +1,3 @@
+-- O PostgreSQL não remove valores de um tipo enum; 'DRAFT' permanece no tipo, e os rascunhos
+-- nunca enviados são descartados com seus viajantes, custos e anexos.
+DELETE FROM travel_requests WHERE status = 'DRAFT';
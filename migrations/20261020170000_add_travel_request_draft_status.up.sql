@@ -0,0 +1,2 @@
+-- Rascunhos, como as cópias de solicitações, ficam fora da aprovação até serem enviados.
+ALTER TYPE travel_request_status ADD VALUE IF NOT EXISTS 'DRAFT' BEFORE 'SOLICITED';